@@ -11,6 +11,7 @@ func RegisterRoutes(handlersManager *handlers.Manager) {
 	adminGroup.GET("/stats", handlers.AsAdmin(stats))
 
 	// tx
+	adminGroup.GET("/transactions/export", handlers.AsAdmin(adminExportTxs))
 	adminGroup.GET("/transactions/:id", handlers.AsAdmin(adminGetTxByID))
 	adminGroup.GET("/transactions", handlers.AsAdmin(adminSearchTxs))
 
@@ -15,8 +15,9 @@ func (ts *TestSuite) TestXPubRegisterRoutes() {
 			url    string
 		}{
 			// tx
-			{"GET", "/api/" + config.APIVersion + "/admin/transactions/:id"}, // get tx by id
-			{"GET", "/api/" + config.APIVersion + "/admin/transactions"},     // search
+			{"GET", "/api/" + config.APIVersion + "/admin/transactions/:id"},    // get tx by id
+			{"GET", "/api/" + config.APIVersion + "/admin/transactions"},        // search
+			{"GET", "/api/" + config.APIVersion + "/admin/transactions/export"}, // export
 
 			// contacts
 			{"POST", "/api/" + config.APIVersion + "/admin/invitations/:id"},   // accept
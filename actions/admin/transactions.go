@@ -5,6 +5,7 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/actions/common"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/internal/export"
 	"github.com/bitcoin-sv/spv-wallet/internal/query"
 	"github.com/bitcoin-sv/spv-wallet/mappings"
 	"github.com/bitcoin-sv/spv-wallet/models/filter"
@@ -87,3 +88,32 @@ func adminSearchTxs(c *gin.Context, _ *reqctx.AdminContext) {
 
 	c.JSON(http.StatusOK, result)
 }
+
+// adminExportTxs will stream the history of transactions with running balances of a single xpub or of all xpubs
+// Export transactions history godoc
+// @Summary		Export transactions history
+// @Description	Streams the history of transactions (with value, fee, counterparty and running balance) of a single xpub or of all xpubs in CSV or JSON-lines format
+// @Tags		Admin
+// @Produce		text/csv
+// @Produce		application/x-ndjson
+// @Param		xpubId query string false "Export only the transactions of this xpub ID"
+// @Param		format query string false "Export format" Enums(csv, jsonl) default(csv)
+// @Param		from query string false "Export transactions created at or after this time (RFC3339)"
+// @Param		to query string false "Export transactions created at or before this time (RFC3339)"
+// @Success		200 {string} string "Transactions history"
+// @Failure		400 "Bad request - Invalid format or time range"
+// @Failure		500 "Internal server error - Error while fetching transactions"
+// @Router		/api/v1/admin/transactions/export [get]
+// @Security	x-auth-xpub
+func adminExportTxs(c *gin.Context, _ *reqctx.AdminContext) {
+	logger := reqctx.Logger(c)
+
+	params, format, err := export.ParseParams(c)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	entries := reqctx.Engine(c).TransactionsHistory(c.Request.Context(), c.Query("xpubId"), params.From, params.To)
+	export.Stream(c, format, "transactions", export.Rows(entries, mappings.MapToTransactionHistoryRow), logger)
+}
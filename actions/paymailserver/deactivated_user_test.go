@@ -0,0 +1,157 @@
+package paymailserver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymailOfDeactivatedUser(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithDomainValidationDisabled(),
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	var testState struct {
+		reference     string
+		lockingScript *script.Script
+	}
+
+	// given:
+	given, then := testabilities.NewOf(givenForAllTests, t)
+	client := given.HttpClient().ForAnonymous()
+	adminClient := given.HttpClient().ForAdmin()
+
+	// and:
+	user := fixtures.RecipientInternal
+	recipientPaymail := user.DefaultPaymail()
+	satoshis := uint64(1000)
+
+	getPKI := func() *resty.Response {
+		res, _ := client.R().Get(fmt.Sprintf("https://example.com/v1/bsvalias/id/%s", recipientPaymail))
+		return res
+	}
+
+	requestDestination := func() *resty.Response {
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{"satoshis": satoshis}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-payment-destination/%s", recipientPaymail))
+		return res
+	}
+
+	receiveTransaction := func() *resty.Response {
+		txSpec := given.Tx().
+			WithInput(satoshis+1).
+			WithOutputScript(satoshis, testState.lockingScript)
+		given.SourceTxs().WillProvide(txSpec.InputSourceTX(0))
+		given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+			TxID:     txSpec.ID(),
+			TXStatus: chainmodels.SeenOnNetwork,
+		})
+
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"hex":       txSpec.RawTX(),
+				"reference": testState.reference,
+				"metadata": map[string]any{
+					"sender": fixtures.SenderExternal.DefaultPaymail(),
+				},
+			}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/receive-transaction/%s", recipientPaymail))
+		return res
+	}
+
+	t.Run("step 1 - call p2p-payment-destination of active user", func(t *testing.T) {
+		// when:
+		res := requestDestination()
+
+		// then:
+		then.Response(res).IsOK()
+
+		// update:
+		getter := then.Response(res).JSONValue()
+		testState.reference = getter.GetString("reference")
+
+		// and:
+		lockingScript, err := script.NewFromHex(getter.GetString("outputs[0]/script"))
+		require.NoError(t, err)
+		testState.lockingScript = lockingScript
+	})
+
+	t.Run("step 2 - deactivate user", func(t *testing.T) {
+		// when:
+		res, _ := adminClient.R().
+			SetPathParam("id", user.ID()).
+			Post("/api/v2/admin/users/{id}/deactivate")
+
+		// then:
+		then.Response(res).IsOK()
+	})
+
+	t.Run("step 3 - paymail capabilities of deactivated user are not found", func(t *testing.T) {
+		// when:
+		res := getPKI()
+
+		// then:
+		then.Response(res).HasStatus(404)
+
+		// when:
+		res = requestDestination()
+
+		// then:
+		then.Response(res).HasStatus(404)
+
+		// when:
+		res = receiveTransaction()
+
+		// then:
+		then.Response(res).HasStatus(404)
+	})
+
+	t.Run("step 4 - reactivate user", func(t *testing.T) {
+		// when:
+		res, _ := adminClient.R().
+			SetPathParam("id", user.ID()).
+			Post("/api/v2/admin/users/{id}/reactivate")
+
+		// then:
+		then.Response(res).IsOK()
+	})
+
+	t.Run("step 5 - paymail capabilities of reactivated user work again", func(t *testing.T) {
+		// when:
+		res := getPKI()
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"bsvalias": "1.0",
+			"handle": "{{ .paymail }}",
+			"pubkey": "{{ matchHexWithLength 66 }}"
+		}`, map[string]any{
+			"paymail": recipientPaymail,
+		})
+
+		// when:
+		res = requestDestination()
+
+		// then:
+		then.Response(res).IsOK()
+
+		// when:
+		res = receiveTransaction()
+
+		// then:
+		then.Response(res).IsOK()
+	})
+}
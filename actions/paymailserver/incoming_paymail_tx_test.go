@@ -15,8 +15,6 @@ import (
 )
 
 func TestIncomingPaymailRawTX(t *testing.T) {
-	t.Skip("Raw TX is not supported yet")
-
 	givenForAllTests := testabilities.Given(t)
 	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
 		testengine.WithDomainValidationDisabled(),
@@ -87,6 +85,9 @@ func TestIncomingPaymailRawTX(t *testing.T) {
 			WithInput(satoshis+1).
 			WithOutputScript(satoshis, testState.lockingScript)
 
+		// and:
+		given.SourceTxs().WillProvide(txSpec.InputSourceTX(0))
+
 		// and:
 		requestBody := map[string]any{
 			"hex":       txSpec.RawTX(),
@@ -136,7 +137,9 @@ func TestIncomingPaymailRawTX(t *testing.T) {
 
 		// then:
 		then.Response(res).IsOK().WithJSONf(`{
-			"currentBalance": %d
+			"currentBalance": %d,
+			"confirmedBalance": 0,
+			"requiredConfirmations": 6
 		}`, satoshis)
 	})
 
@@ -298,7 +301,9 @@ func TestIncomingPaymailBeef(t *testing.T) {
 
 		// then:
 		then.Response(res).IsOK().WithJSONf(`{
-			"currentBalance": %d
+			"currentBalance": %d,
+			"confirmedBalance": 0,
+			"requiredConfirmations": 6
 		}`, satoshis)
 	})
 
@@ -32,9 +32,11 @@ func TestOldStatelessCapabilities(t *testing.T) {
 		  "bsvalias": "1.0",
 		  "capabilities": {
 			"2a40af698840": "https://example.com/v1/bsvalias/p2p-payment-destination/{alias}@{domain.tld}",
+			"2f2a781ab7ff": "https://example.com/v1/bsvalias/transfer-intent/{alias}@{domain.tld}",
 			"5c55a7fdb7bb": "https://example.com/v1/bsvalias/beef/{alias}@{domain.tld}",
 			"5f1323cddf31": "https://example.com/v1/bsvalias/receive-transaction/{alias}@{domain.tld}",
 			"6745385c3fc0": false,
+			"7049d044a11f": "https://example.com/v1/bsvalias/transfer/{alias}@{domain.tld}",
 			"a9f510c16bde": "https://example.com/v1/bsvalias/verify-pubkey/{alias}@{domain.tld}/{pubkey}",
 			"f12f968c92d6": "https://example.com/v1/bsvalias/public-profile/{alias}@{domain.tld}",
 			"paymentDestination": "https://example.com/v1/bsvalias/address/{alias}@{domain.tld}",
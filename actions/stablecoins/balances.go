@@ -0,0 +1,53 @@
+package stablecoins
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/internal/query"
+	"github.com/bitcoin-sv/spv-wallet/mappings"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/bitcoin-sv/spv-wallet/models/response"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// balances will fetch the balances of the stablecoins held by the user
+// Get stablecoin balances godoc
+// @Summary		Get stablecoin balances
+// @Description	Get the balances of the stablecoins held by the user, aggregated from the unspent banknotes
+// @Tags		Stablecoins
+// @Produce		json
+// @Param		StablecoinParams query filter.StablecoinFilter false "Supports filtering by stablecoin"
+// @Success		200 {object} []response.StablecoinBalance "List of stablecoin balances"
+// @Failure		400	"Bad request - Error while parsing query params"
+// @Failure 	500	"Internal server error - Error while calculating the balances"
+// @Router		/api/v1/stablecoins/balances [get]
+// @Security	x-auth-xpub
+func balances(c *gin.Context, userContext *reqctx.UserContext) {
+	logger := reqctx.Logger(c)
+	engineInstance := reqctx.Engine(c)
+	searchParams, err := query.ParseSearchParams[filter.StablecoinFilter](c)
+	if err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotParseQueryParams, logger)
+		return
+	}
+
+	result, err := engineInstance.StablecoinBalanceService().GetBalances(
+		c.Request.Context(),
+		engineInstance,
+		userContext.GetXPubID(),
+		stablecoinIDFromFilter(&searchParams.Conditions),
+	)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	contracts := make([]*response.StablecoinBalance, 0, len(result))
+	for _, balance := range result {
+		contracts = append(contracts, mappings.MapToStablecoinBalanceContract(balance))
+	}
+
+	c.JSON(http.StatusOK, contracts)
+}
@@ -0,0 +1,68 @@
+package stablecoins
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/common"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/internal/query"
+	"github.com/bitcoin-sv/spv-wallet/mappings"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/bitcoin-sv/spv-wallet/models/response"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// banknotes will fetch a list of unspent stablecoin banknotes of the user
+// Search stablecoin banknotes godoc
+// @Summary		Search stablecoin banknotes
+// @Description	Search unspent stablecoin banknotes (BSV-21 token outputs) of the user
+// @Tags		Stablecoins
+// @Produce		json
+// @Param		SwaggerCommonParams query swagger.CommonFilteringQueryParams false "Supports options for pagination and sorting to streamline data exploration and analysis"
+// @Param		StablecoinParams query filter.StablecoinFilter false "Supports filtering by stablecoin"
+// @Success		200 {object} response.PageModel[response.StablecoinBanknote] "List of banknotes"
+// @Failure		400	"Bad request - Error while parsing query params"
+// @Failure 	500	"Internal server error - Error while searching for banknotes"
+// @Router		/api/v1/stablecoins/banknotes [get]
+// @Security	x-auth-xpub
+func banknotes(c *gin.Context, userContext *reqctx.UserContext) {
+	logger := reqctx.Logger(c)
+	engineInstance := reqctx.Engine(c)
+	searchParams, err := query.ParseSearchParams[filter.StablecoinFilter](c)
+	if err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotParseQueryParams, logger)
+		return
+	}
+
+	pageOptions := mappings.MapToDbQueryParams(&searchParams.Page)
+
+	result, count, err := engineInstance.StablecoinBalanceService().GetBanknotes(
+		c.Request.Context(),
+		engineInstance,
+		userContext.GetXPubID(),
+		stablecoinIDFromFilter(&searchParams.Conditions),
+		pageOptions,
+	)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	contracts := make([]*response.StablecoinBanknote, 0, len(result))
+	for _, banknote := range result {
+		contracts = append(contracts, mappings.MapToStablecoinBanknoteContract(banknote))
+	}
+
+	c.JSON(http.StatusOK, response.PageModel[response.StablecoinBanknote]{
+		Content: contracts,
+		Page:    common.GetPageDescriptionFromSearchParams(pageOptions, count),
+	})
+}
+
+func stablecoinIDFromFilter(f *filter.StablecoinFilter) string {
+	if f == nil || f.StablecoinID == nil {
+		return ""
+	}
+	return *f.StablecoinID
+}
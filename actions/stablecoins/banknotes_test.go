@@ -0,0 +1,82 @@
+package stablecoins_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestUserStablecoinBanknotes(t *testing.T) {
+	t.Run("return banknotes for user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+
+		// and:
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().Get("/api/v1/stablecoins/banknotes")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONf(`{
+				"content": [],
+				"page": {
+					"number": 1,
+					"size": 50,
+					"totalElements": 0,
+					"totalPages": 0
+				}
+			}`)
+	})
+
+	t.Run("return balances for user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+
+		// and:
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().Get("/api/v1/stablecoins/balances?stablecoinId=abc")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONf(`[]`)
+	})
+
+	t.Run("try to return banknotes for admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().Get("/api/v1/stablecoins/banknotes")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+
+	t.Run("try to return balances for anonymous", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().Get("/api/v1/stablecoins/balances")
+
+		// then:
+		then.Response(res).IsUnauthorized()
+	})
+}
@@ -0,0 +1,26 @@
+package stablecoins
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bitcoin-sv/go-paymail/server"
+	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
+)
+
+// RegisterPaymailCapabilities adds the stablecoin transfer capabilities to the paymail server configuration,
+// so the senders can discover the transfer endpoints of this wallet instead of assuming their location
+func RegisterPaymailCapabilities(configuration *server.Configuration) {
+	server.WithCapabilities(map[string]any{
+		paymailclient.BRFCStablecoinTransferIntent: server.CallableCapability{
+			Path:    fmt.Sprintf("/transfer-intent/%s", server.PaymailAddressTemplate),
+			Method:  http.MethodPost,
+			Handler: stablecoinTransferIntent,
+		},
+		paymailclient.BRFCStablecoinTransfer: server.CallableCapability{
+			Path:    fmt.Sprintf("/transfer/%s", server.PaymailAddressTemplate),
+			Method:  http.MethodPost,
+			Handler: stablecoinTransfer,
+		},
+	})(configuration)
+}
@@ -1,8 +1,11 @@
 package stablecoins
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/go-paymail/server"
 	"github.com/bitcoin-sv/spv-wallet/engine"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
@@ -18,9 +21,37 @@ import (
 // @Param		StablecoinTransferIntent body Intent true "Transfer intent use to create outputs and validate transfer"
 // @Success		200 {object} ValidationResponse "Transfer intent validation response"
 // @Failure		400	"Bad request - Error while parsing SearchPaymails from request body"
+// @Failure		400	"Bad request - Receiver of the transfer intent does not match the paymail address"
+// @Failure		401	"Unauthorized - Transfer intent is not signed or the signature does not match the sender's PKI"
+// @Failure		403	"Forbidden - Sender is not allowed or the daily transfer limit is exceeded"
+// @Failure		503	"Service unavailable - Sender policy service is unavailable"
 // @Failure 	500	"Internal server error - Error while searching for paymail addresses"
-// @Router		/bsvalias/transfer-intent [post]
+// @Router		/v1/bsvalias/transfer-intent/{paymailAddress} [post]
 func stablecoinTransferIntent(c *gin.Context) {
+	handleTransferIntent(c, checkPathReceiver)
+}
+
+// legacyStablecoinTransferIntent validate transfer intent sent to the endpoint without the paymail address in the path
+// Deprecated: the senders should use the transfer intent capability of the receiver's paymail, this endpoint will be removed in the next release
+// @Summary		Validate transfer intent (deprecated)
+// @Description	Validate transfer intent, deprecated in favour of the transfer intent paymail capability
+// @Tags		Stablecoin Transfer
+// @Produce		json
+// @Param		StablecoinTransferIntent body Intent true "Transfer intent use to create outputs and validate transfer"
+// @Success		200 {object} ValidationResponse "Transfer intent validation response"
+// @Failure		400	"Bad request - Error while parsing SearchPaymails from request body"
+// @Failure		400	"Bad request - Receiver of the transfer intent is not a paymail of this wallet"
+// @Failure		401	"Unauthorized - Transfer intent is not signed or the signature does not match the sender's PKI"
+// @Failure		403	"Forbidden - Sender is not allowed or the daily transfer limit is exceeded"
+// @Failure		503	"Service unavailable - Sender policy service is unavailable"
+// @Failure 	500	"Internal server error - Error while searching for paymail addresses"
+// @Router		/bsvalias/transfer-intent [post]
+// @Deprecated
+func legacyStablecoinTransferIntent(c *gin.Context) {
+	handleTransferIntent(c, checkHostedReceiver)
+}
+
+func handleTransferIntent(c *gin.Context, checkReceiver receiverCheck) {
 	logger := reqctx.Logger(c)
 	engineInstance := reqctx.Engine(c)
 
@@ -31,6 +62,17 @@ func stablecoinTransferIntent(c *gin.Context) {
 		return
 	}
 
+	if err = checkReceiver(c, requestBody.ReceiverID); err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	err = engineInstance.StablecoinTransferService().VerifyIntentSignature(c.Request.Context(), engineInstance, requestBody)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
 	resp, err := engineInstance.StablecoinTransferService().ValidateIntent(c.Request.Context(), engineInstance, requestBody)
 	if err != nil {
 		spverrors.ErrorResponse(c, err, logger)
@@ -39,3 +81,31 @@ func stablecoinTransferIntent(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// receiverCheck checks if the receiver of the transfer intent can be served by the endpoint
+type receiverCheck func(c *gin.Context, receiverID string) error
+
+// checkPathReceiver checks if the receiver is the paymail address from the path of the capability endpoint
+func checkPathReceiver(c *gin.Context, receiverID string) error {
+	_, _, pathAddress := paymail.SanitizePaymail(c.Param(server.PaymailAddressParamName))
+	_, _, receiverAddress := paymail.SanitizePaymail(receiverID)
+	if pathAddress == "" || pathAddress != receiverAddress {
+		return spverrors.ErrStablecoinReceiverMismatch
+	}
+	return nil
+}
+
+// checkHostedReceiver checks if the receiver is a paymail address of this wallet, it is used by the legacy endpoints without the paymail in the path
+func checkHostedReceiver(c *gin.Context, receiverID string) error {
+	engineInstance := reqctx.Engine(c)
+	_, _, receiverAddress := paymail.SanitizePaymail(receiverID)
+	if receiverAddress == "" {
+		return spverrors.ErrStablecoinReceiverMismatch
+	}
+
+	_, err := engineInstance.GetPaymailAddress(c.Request.Context(), receiverAddress)
+	if errors.Is(err, spverrors.ErrCouldNotFindPaymail) {
+		return spverrors.ErrStablecoinReceiverMismatch
+	}
+	return err
+}
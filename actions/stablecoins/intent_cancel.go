@@ -0,0 +1,41 @@
+package stablecoins
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// cancelTransferIntent will cancel the pending transfer intent addressed to the user
+// Cancel transfer intent godoc
+// @Summary		Cancel transfer intent
+// @Description	Cancel the pending transfer intent addressed to the paymail of the user, so the transfer referencing it is rejected
+// @Tags		Stablecoins
+// @Produce		json
+// @Param		refId path string true "Reference ID of the transfer intent"
+// @Success		200
+// @Failure		400	"Bad request - Transfer intent has expired or has been canceled"
+// @Failure		404	"Not found - Transfer intent not found"
+// @Failure		409	"Conflict - Transfer intent has already been consumed or the transfer is being processed"
+// @Failure 	500	"Internal Server Error - Error while canceling the transfer intent"
+// @Router		/api/v1/stablecoins/transfer-intents/{refId}/cancel [post]
+// @Security	x-auth-xpub
+func cancelTransferIntent(c *gin.Context, userContext *reqctx.UserContext) {
+	logger := reqctx.Logger(c)
+	engineInstance := reqctx.Engine(c)
+
+	err := engineInstance.StablecoinTransferService().CancelTransferIntent(
+		c.Request.Context(),
+		engineInstance,
+		userContext.GetXPubID(),
+		c.Param("refId"),
+	)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,59 @@
+package stablecoins_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestCancelTransferIntent(t *testing.T) {
+	t.Run("return not found for unknown transfer intent", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+
+		// and:
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().Post("/api/v1/stablecoins/transfer-intents/b356f7fa00cd3f20cce6c21d704cd13e871d28d714a5ebd0532f5a0e0cde63f7/cancel")
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(`{
+				"code": "error-transfer-intent-not-found",
+				"message": "transfer intent not found"
+			}`)
+	})
+
+	t.Run("try to cancel transfer intent as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().Post("/api/v1/stablecoins/transfer-intents/b356f7fa00cd3f20cce6c21d704cd13e871d28d714a5ebd0532f5a0e0cde63f7/cancel")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+
+	t.Run("try to cancel transfer intent as anonymous", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().Post("/api/v1/stablecoins/transfer-intents/b356f7fa00cd3f20cce6c21d704cd13e871d28d714a5ebd0532f5a0e0cde63f7/cancel")
+
+		// then:
+		then.Response(res).IsUnauthorized()
+	})
+}
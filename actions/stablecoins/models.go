@@ -0,0 +1,25 @@
+package stablecoins
+
+import "github.com/bitcoin-sv/spv-wallet/engine"
+
+// IssueStablecoin is the model for issuing the stablecoin by its emitter
+type IssueStablecoin struct {
+	// StablecoinID is the identifier of the stablecoin to issue
+	StablecoinID string `json:"stablecoinId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"`
+	// Amount is the amount of the stablecoin to issue
+	Amount uint64 `json:"amount" example:"1000000"`
+	// To is the paymail of the receiver of the issued stablecoin
+	To string `json:"to" example:"test@example.com"`
+	// Accepts a JSON object for embedding custom metadata, enabling arbitrary additional information to be associated with the resource
+	Metadata engine.Metadata `json:"metadata" swaggertype:"object,string" example:"key:value,key2:value2"`
+}
+
+// RedeemStablecoin is the model for redeeming the stablecoin at its emitter
+type RedeemStablecoin struct {
+	// StablecoinID is the identifier of the stablecoin to redeem
+	StablecoinID string `json:"stablecoinId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"`
+	// Amount is the amount of the stablecoin to redeem
+	Amount uint64 `json:"amount" example:"1000000"`
+	// Accepts a JSON object for embedding custom metadata, enabling arbitrary additional information to be associated with the resource
+	Metadata engine.Metadata `json:"metadata" swaggertype:"object,string" example:"key:value,key2:value2"`
+}
@@ -0,0 +1,145 @@
+package stablecoins
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/mappings"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// issue will create the issue operation of the stablecoin
+// Issue stablecoin godoc
+// @Summary		Issue stablecoin
+// @Description	Create the draft transaction sending the stablecoin from its emitter to the receiver. Allowed only for the emitter from the gateway rules.
+// @Tags		Stablecoins
+// @Produce		json
+// @Param		IssueStablecoin body IssueStablecoin true "Stablecoin, amount and receiver of the issue"
+// @Success		201 {object} response.StablecoinOperation "Created issue operation with its draft transaction"
+// @Failure		400	"Bad request - Error while parsing IssueStablecoin from request body"
+// @Failure		403	"Forbidden - The user is not the emitter of the stablecoin"
+// @Failure		503	"Service unavailable - Stablecoin rules are unavailable"
+// @Failure 	500	"Internal Server Error - Error while creating the issue operation"
+// @Router		/api/v1/stablecoins/issue [post]
+// @Security	x-auth-xpub
+func issue(c *gin.Context, userContext *reqctx.UserContext) {
+	logger := reqctx.Logger(c)
+	engineInstance := reqctx.Engine(c)
+
+	xpub, err := userContext.ShouldGetXPub()
+	if err != nil {
+		spverrors.AbortWithErrorResponse(c, err, logger)
+		return
+	}
+
+	var requestBody IssueStablecoin
+	if err = c.Bind(&requestBody); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest, logger)
+		return
+	}
+
+	operation, draft, err := engineInstance.StablecoinOperationService().Issue(
+		c.Request.Context(),
+		engineInstance,
+		xpub,
+		requestBody.StablecoinID,
+		requestBody.Amount,
+		requestBody.To,
+		operationOptions(engineInstance, requestBody.Metadata)...,
+	)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	contract := mappings.MapToStablecoinOperationContract(operation)
+	contract.DraftTransaction = mappings.MapToDraftTransactionContract(draft)
+	c.JSON(http.StatusCreated, contract)
+}
+
+// redeem will create the redeem operation of the stablecoin
+// Redeem stablecoin godoc
+// @Summary		Redeem stablecoin
+// @Description	Create the draft transaction sending the stablecoin of the user back to its emitter
+// @Tags		Stablecoins
+// @Produce		json
+// @Param		RedeemStablecoin body RedeemStablecoin true "Stablecoin and amount of the redeem"
+// @Success		201 {object} response.StablecoinOperation "Created redeem operation with its draft transaction"
+// @Failure		400	"Bad request - Error while parsing RedeemStablecoin from request body or not enough banknotes"
+// @Failure		503	"Service unavailable - Stablecoin rules are unavailable"
+// @Failure 	500	"Internal Server Error - Error while creating the redeem operation"
+// @Router		/api/v1/stablecoins/redeem [post]
+// @Security	x-auth-xpub
+func redeem(c *gin.Context, userContext *reqctx.UserContext) {
+	logger := reqctx.Logger(c)
+	engineInstance := reqctx.Engine(c)
+
+	xpub, err := userContext.ShouldGetXPub()
+	if err != nil {
+		spverrors.AbortWithErrorResponse(c, err, logger)
+		return
+	}
+
+	var requestBody RedeemStablecoin
+	if err = c.Bind(&requestBody); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest, logger)
+		return
+	}
+
+	operation, draft, err := engineInstance.StablecoinOperationService().Redeem(
+		c.Request.Context(),
+		engineInstance,
+		xpub,
+		requestBody.StablecoinID,
+		requestBody.Amount,
+		operationOptions(engineInstance, requestBody.Metadata)...,
+	)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	contract := mappings.MapToStablecoinOperationContract(operation)
+	contract.DraftTransaction = mappings.MapToDraftTransactionContract(draft)
+	c.JSON(http.StatusCreated, contract)
+}
+
+// operation will fetch the issue or redeem operation of the user
+// Get stablecoin operation godoc
+// @Summary		Get stablecoin operation
+// @Description	Get the issue or redeem operation of the user together with its status
+// @Tags		Stablecoins
+// @Produce		json
+// @Param		id path string true "ID of the operation"
+// @Success		200 {object} response.StablecoinOperation "Stablecoin operation"
+// @Failure		404	"Not found - Stablecoin operation not found"
+// @Failure 	500	"Internal Server Error - Error while fetching the operation"
+// @Router		/api/v1/stablecoins/operations/{id} [get]
+// @Security	x-auth-xpub
+func operation(c *gin.Context, userContext *reqctx.UserContext) {
+	logger := reqctx.Logger(c)
+	engineInstance := reqctx.Engine(c)
+
+	result, err := engineInstance.StablecoinOperationService().GetOperation(
+		c.Request.Context(),
+		engineInstance,
+		userContext.GetXPubID(),
+		c.Param("id"),
+	)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings.MapToStablecoinOperationContract(result))
+}
+
+func operationOptions(engineInstance engine.ClientInterface, metadata engine.Metadata) []engine.ModelOps {
+	opts := engineInstance.DefaultModelOptions()
+	if metadata != nil {
+		opts = append(opts, engine.WithMetadatas(metadata))
+	}
+	return opts
+}
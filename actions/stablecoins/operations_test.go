@@ -0,0 +1,63 @@
+package stablecoins_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestUserStablecoinOperations(t *testing.T) {
+	t.Run("return not found for unknown operation", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+
+		// and:
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().Get("/api/v1/stablecoins/operations/b356f7fa00cd3f20cce6c21d704cd13e871d28d714a5ebd0532f5a0e0cde63f7")
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(`{
+				"code": "error-stablecoin-operation-not-found",
+				"message": "stablecoin operation not found"
+			}`)
+	})
+
+	t.Run("try to issue stablecoin as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{"stablecoinId": "abc", "amount": 100, "to": "test@example.com"}).
+			Post("/api/v1/stablecoins/issue")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+
+	t.Run("try to redeem stablecoin as anonymous", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{"stablecoinId": "abc", "amount": 100}).
+			Post("/api/v1/stablecoins/redeem")
+
+		// then:
+		then.Response(res).IsUnauthorized()
+	})
+}
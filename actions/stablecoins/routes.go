@@ -1,12 +1,22 @@
 package stablecoins
 
 import (
-	routes "github.com/bitcoin-sv/spv-wallet/server/handlers"
+	"github.com/bitcoin-sv/spv-wallet/server/handlers"
 )
 
 // RegisterRoutes creates the specific package routes in RESTful style
-func RegisterRoutes(handlersManager *routes.Manager) {
-	group := handlersManager.Group(routes.GroupRoot, "/bsvalias")
-	group.POST("/transfer-intent", stablecoinTransferIntent)
-	group.POST("/transfer", stablecoinTransfer)
+// The transfer intent and the transfer endpoints are served by the paymail server, see RegisterPaymailCapabilities.
+// The legacy endpoints without the paymail address in the path are deprecated and will be removed in the next release.
+func RegisterRoutes(handlersManager *handlers.Manager) {
+	legacyGroup := handlersManager.Group(handlers.GroupRoot, "/bsvalias")
+	legacyGroup.POST("/transfer-intent", legacyStablecoinTransferIntent)
+	legacyGroup.POST("/transfer", legacyStablecoinTransfer)
+
+	userGroup := handlersManager.Group(handlers.GroupAPI, "/stablecoins")
+	userGroup.GET("/banknotes", handlers.AsUser(banknotes))
+	userGroup.GET("/balances", handlers.AsUser(balances))
+	userGroup.POST("/issue", handlers.AsUser(issue))
+	userGroup.POST("/redeem", handlers.AsUser(redeem))
+	userGroup.GET("/operations/:id", handlers.AsUser(operation))
+	userGroup.POST("/transfer-intents/:refId/cancel", handlers.AsUser(cancelTransferIntent))
 }
@@ -18,9 +18,39 @@ import (
 // @Param		TransferData body Transfer true "Transfer info"
 // @Success		200 {object} ValidationResponse "Transfer intent validation response"
 // @Failure		400	"Bad request - Error while parsing SearchPaymails from request body"
+// @Failure		400	"Bad request - Transfer intent has expired or has been canceled, or the transfer outputs do not match the intent"
+// @Failure		400	"Bad request - Receiver of the transfer intent does not match the paymail address"
+// @Failure		401	"Unauthorized - Transfer is not signed or the signature does not match the sender's PKI"
+// @Failure		404	"Not found - Transfer intent not found"
+// @Failure		409	"Conflict - Transfer intent has already been consumed"
 // @Failure 	500	"Internal server error - Error while searching for paymail addresses"
-// @Router		/bsvalias/transfer [post]
+// @Router		/v1/bsvalias/transfer/{paymailAddress} [post]
 func stablecoinTransfer(c *gin.Context) {
+	handleTransfer(c, checkPathReceiver)
+}
+
+// legacyStablecoinTransfer incoming stablecoin transfer sent to the endpoint without the paymail address in the path
+// Deprecated: the senders should use the transfer paymail capability of the receiver's paymail, this endpoint will be removed in the next release
+// @Summary		Incoming stablecoin transfer (deprecated)
+// @Description	Incoming stablecoin transfer, deprecated in favour of the transfer paymail capability
+// @Tags		Stablecoin Transfer
+// @Produce		json
+// @Param		TransferData body Transfer true "Transfer info"
+// @Success		200 {object} ValidationResponse "Transfer intent validation response"
+// @Failure		400	"Bad request - Error while parsing SearchPaymails from request body"
+// @Failure		400	"Bad request - Transfer intent has expired or has been canceled, or the transfer outputs do not match the intent"
+// @Failure		400	"Bad request - Receiver of the transfer intent is not a paymail of this wallet"
+// @Failure		401	"Unauthorized - Transfer is not signed or the signature does not match the sender's PKI"
+// @Failure		404	"Not found - Transfer intent not found"
+// @Failure		409	"Conflict - Transfer intent has already been consumed"
+// @Failure 	500	"Internal server error - Error while searching for paymail addresses"
+// @Router		/bsvalias/transfer [post]
+// @Deprecated
+func legacyStablecoinTransfer(c *gin.Context) {
+	handleTransfer(c, checkHostedReceiver)
+}
+
+func handleTransfer(c *gin.Context, checkReceiver receiverCheck) {
 	logger := reqctx.Logger(c)
 	engineInstance := reqctx.Engine(c)
 
@@ -31,6 +61,23 @@ func stablecoinTransfer(c *gin.Context) {
 		return
 	}
 
+	// the transfer sent to the capability endpoint must reference the intent addressed to the paymail from the path
+	receiverID, err := engineInstance.StablecoinTransferService().GetTransferReceiver(c.Request.Context(), engineInstance, requestBody)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+	if err = checkReceiver(c, receiverID); err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	err = engineInstance.StablecoinTransferService().VerifyTransferSignature(c.Request.Context(), engineInstance, requestBody)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
 	tx, err := engineInstance.StablecoinTransferService().IncomingTransfer(c.Request.Context(), engineInstance, *requestBody)
 	if err != nil {
 		spverrors.ErrorResponse(c, err, logger)
@@ -0,0 +1,186 @@
+package stablecoins_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+const testStablecoinID = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"
+
+func TestStablecoinTransferReceiver(t *testing.T) {
+	t.Run("reject intent addressed to other paymail than in the path", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"senderId":     fixtures.SenderExternal.DefaultPaymail(),
+				"receiverId":   fixtures.Sender.DefaultPaymail(),
+				"stablecoinId": testStablecoinID,
+				"amount":       100,
+			}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/transfer-intent/%s", fixtures.RecipientInternal.DefaultPaymail()))
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(`{
+				"code": "error-stablecoin-receiver-mismatch",
+				"message": "receiver of the transfer intent does not match the paymail address"
+			}`)
+	})
+
+	t.Run("reject transfer referencing intent addressed to other paymail than in the path", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// and:
+		engineInstance := given.Engine()
+		sti, err := engine.CreateStablecoinTransferIntent(&engine.Intent{
+			SenderID:     string(fixtures.SenderExternal.DefaultPaymail()),
+			ReceiverID:   string(fixtures.Sender.DefaultPaymail()),
+			Nonce:        "nonce",
+			StablecoinID: testStablecoinID,
+			Amount:       100,
+		}, nil, nil, time.Minute, engineInstance.DefaultModelOptions(engine.New())...)
+		require.NoError(t, err)
+		require.NoError(t, sti.Save(context.Background()))
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"refId": sti.ID,
+				"txHex": "0100000000000000000000",
+			}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/transfer/%s", fixtures.RecipientInternal.DefaultPaymail()))
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(`{
+				"code": "error-stablecoin-receiver-mismatch",
+				"message": "receiver of the transfer intent does not match the paymail address"
+			}`)
+	})
+
+	t.Run("return not found for transfer referencing unknown intent", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"refId": "b356f7fa00cd3f20cce6c21d704cd13e871d28d714a5ebd0532f5a0e0cde63f7",
+				"txHex": "0100000000000000000000",
+			}).
+			Post(fmt.Sprintf("https://example.com/v1/bsvalias/transfer/%s", fixtures.RecipientInternal.DefaultPaymail()))
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(`{
+				"code": "error-transfer-intent-not-found",
+				"message": "transfer intent not found"
+			}`)
+	})
+
+	t.Run("reject intent sent to legacy endpoint addressed to paymail of other wallet", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"senderId":     fixtures.SenderExternal.DefaultPaymail(),
+				"receiverId":   fixtures.RecipientExternal.DefaultPaymail(),
+				"stablecoinId": testStablecoinID,
+				"amount":       100,
+			}).
+			Post("/bsvalias/transfer-intent")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(`{
+				"code": "error-stablecoin-receiver-mismatch",
+				"message": "receiver of the transfer intent does not match the paymail address"
+			}`)
+	})
+
+	t.Run("accept receiver of intent sent to legacy endpoint addressed to paymail of this wallet", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"senderId":     fixtures.SenderExternal.DefaultPaymail(),
+				"receiverId":   fixtures.RecipientInternal.DefaultPaymail(),
+				"stablecoinId": testStablecoinID,
+				"amount":       100,
+			}).
+			Post("/bsvalias/transfer-intent")
+
+		// then: the receiver check passes, the validation stops on the stablecoin rules which are not configured in the test
+		then.Response(res).HasStatus(503)
+	})
+
+	t.Run("reject transfer sent to legacy endpoint referencing intent addressed to paymail of other wallet", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWallet()
+		defer cleanup()
+		client := given.HttpClient().ForAnonymous()
+
+		// and:
+		engineInstance := given.Engine()
+		sti, err := engine.CreateStablecoinTransferIntent(&engine.Intent{
+			SenderID:     string(fixtures.SenderExternal.DefaultPaymail()),
+			ReceiverID:   string(fixtures.RecipientExternal.DefaultPaymail()),
+			Nonce:        "nonce",
+			StablecoinID: testStablecoinID,
+			Amount:       100,
+		}, nil, nil, time.Minute, engineInstance.DefaultModelOptions(engine.New())...)
+		require.NoError(t, err)
+		require.NoError(t, sti.Save(context.Background()))
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"refId": sti.ID,
+				"txHex": "0100000000000000000000",
+			}).
+			Post("/bsvalias/transfer")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(`{
+				"code": "error-stablecoin-receiver-mismatch",
+				"message": "receiver of the transfer intent does not match the paymail address"
+			}`)
+	})
+}
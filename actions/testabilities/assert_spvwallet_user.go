@@ -18,6 +18,7 @@ type BalanceAssertions interface {
 	IsEqualTo(expected bsv.Satoshis)
 	IsGreaterThanOrEqualTo(expected bsv.Satoshis)
 	IsZero()
+	IsConfirmedEqualTo(expected bsv.Satoshis)
 }
 
 type OperationsAssertions interface {
@@ -33,6 +34,8 @@ type LastOperationAssertions interface {
 	WithTxStatus(txStatus string) LastOperationAssertions
 	WithBlockHeight(blockHeight int64) LastOperationAssertions
 	WithBlockHash(blockHash string) LastOperationAssertions
+	WithNoBlock() LastOperationAssertions
+	WithConfirmations(confirmations uint32) LastOperationAssertions
 }
 
 type userAssertions struct {
@@ -66,12 +69,17 @@ func (u *userAssertions) Last() LastOperationAssertions {
 
 func (u *userAssertions) balance() bsv.Satoshis {
 	u.t.Helper()
+	return bsv.Satoshis(u.userInfo().CurrentBalance)
+}
+
+func (u *userAssertions) userInfo() api.ModelsUserInfo {
+	u.t.Helper()
 
 	var userInfo api.ModelsUserInfo
 	_, err := u.userClient.R().SetResult(&userInfo).Get("/api/v2/users/current")
 	u.require.NoError(err)
 
-	return bsv.Satoshis(userInfo.CurrentBalance)
+	return userInfo
 }
 
 func (u *userAssertions) IsEqualTo(expected bsv.Satoshis) {
@@ -92,6 +100,12 @@ func (u *userAssertions) IsZero() {
 	require.Zero(u.t, actual)
 }
 
+func (u *userAssertions) IsConfirmedEqualTo(expected bsv.Satoshis) {
+	u.t.Helper()
+	actual := bsv.Satoshis(u.userInfo().ConfirmedBalance)
+	require.Equal(u.t, expected, actual)
+}
+
 type lastOperationAssertions struct {
 	t       testing.TB
 	require *require.Assertions
@@ -147,3 +161,18 @@ func (l *lastOperationAssertions) WithBlockHash(blockHash string) LastOperationA
 	l.require.Equal(blockHash, *l.content.BlockHash)
 	return l
 }
+
+func (l *lastOperationAssertions) WithNoBlock() LastOperationAssertions {
+	l.t.Helper()
+	l.require.Nil(l.content.BlockHeight)
+	l.require.Nil(l.content.BlockHash)
+	l.require.Nil(l.content.Confirmations)
+	return l
+}
+
+func (l *lastOperationAssertions) WithConfirmations(confirmations uint32) LastOperationAssertions {
+	l.t.Helper()
+	l.require.NotNil(l.content.Confirmations)
+	l.require.Equal(confirmations, *l.content.Confirmations)
+	return l
+}
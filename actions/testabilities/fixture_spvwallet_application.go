@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine"
 	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	testpaymail "github.com/bitcoin-sv/spv-wallet/engine/paymail/testabilities"
 	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
@@ -36,6 +37,9 @@ type SPVWalletApplicationFixture interface {
 
 	Paymail() testpaymail.PaymailClientFixture
 
+	// SourceTxs creates a new test fixture for the source transactions which are not stored in the database
+	SourceTxs() testengine.SourceTxsFixture
+
 	Faucet(user fixtures.User) testengine.FaucetFixture
 
 	EngineFixture() testengine.EngineFixture
@@ -44,6 +48,9 @@ type SPVWalletApplicationFixture interface {
 	Tx() txtestability.TransactionSpec
 
 	Config() *config.AppConfig
+
+	// Engine returns the engine of the started SPV Wallet application
+	Engine() engine.ClientInterface
 }
 
 type BlockHeadersServiceFixture interface {
@@ -54,6 +61,9 @@ type BlockHeadersServiceFixture interface {
 	// WillRespondForMerkleRootsVerify returns a MerkleRootsConfirmations response for get merkleroot/verify endpoint with
 	// provided httpCode
 	WillRespondForMerkleRootsVerify(httpCode int, response *chainmodels.MerkleRootsConfirmations)
+
+	// WillRespondForChainTip returns a chain tip response with provided height for get chain tip endpoint
+	WillRespondForChainTip(height int64)
 }
 
 type ARCFixture interface {
@@ -158,6 +168,10 @@ func (f *appFixture) Paymail() testpaymail.PaymailClientFixture {
 	return testpaymail.GivenWithMockClient(f.t, f.engineFixture.PaymailClient())
 }
 
+func (f *appFixture) SourceTxs() testengine.SourceTxsFixture {
+	return f.engineFixture.SourceTxs()
+}
+
 func (f *appFixture) Faucet(user fixtures.User) testengine.FaucetFixture {
 	return f.engineFixture.Faucet(user)
 }
@@ -173,3 +187,7 @@ func (f *appFixture) EngineFixture() testengine.EngineFixture {
 func (f *appFixture) Config() *config.AppConfig {
 	return &f.engineWithConfig.Config
 }
+
+func (f *appFixture) Engine() engine.ClientInterface {
+	return f.engineWithConfig.Engine
+}
@@ -0,0 +1,37 @@
+package transactions
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/internal/export"
+	"github.com/bitcoin-sv/spv-wallet/mappings"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// exportTransactions will stream the history of the xpub transactions with running balance
+// Export transactions godoc
+// @Summary		Export transactions history
+// @Description	Streams the history of transactions (with value, fee, counterparty and running balance) in CSV or JSON-lines format
+// @Tags		Transactions
+// @Produce		text/csv
+// @Produce		application/x-ndjson
+// @Param		format query string false "Export format" Enums(csv, jsonl) default(csv)
+// @Param		from query string false "Export transactions created at or after this time (RFC3339)"
+// @Param		to query string false "Export transactions created at or before this time (RFC3339)"
+// @Success		200 {string} string "Transactions history"
+// @Failure		400	"Bad request - Invalid format or time range"
+// @Failure 	500	"Internal server error - Error while fetching transactions"
+// @Router		/api/v1/transactions/export [get]
+// @Security	x-auth-xpub
+func exportTransactions(c *gin.Context, userContext *reqctx.UserContext) {
+	logger := reqctx.Logger(c)
+
+	params, format, err := export.ParseParams(c)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, logger)
+		return
+	}
+
+	entries := reqctx.Engine(c).TransactionsHistory(c.Request.Context(), userContext.GetXPubID(), params.From, params.To)
+	export.Stream(c, format, "transactions", export.Rows(entries, mappings.MapToTransactionHistoryRow), logger)
+}
@@ -12,6 +12,7 @@ func RegisterRoutes(handlersManager *handlers.Manager) {
 	group.GET(":id", handlers.AsUser(getByID))
 	group.PATCH(":id", handlers.AsUser(updateTransactionMetadata))
 	group.GET("", handlers.AsUser(transactions))
+	group.GET("/export", handlers.AsUser(exportTransactions))
 
 	group.POST("/drafts", handlers.AsUser(newTransactionDraft))
 	group.POST("", handlers.AsUser(recordTransaction))
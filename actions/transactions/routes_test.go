@@ -16,6 +16,7 @@ func (ts *TestSuite) TestTransactionRegisterRoutes() {
 			{"GET", "/api/" + config.APIVersion + "/transactions/:id"},
 			{"PATCH", "/api/" + config.APIVersion + "/transactions/:id"},
 			{"GET", "/api/" + config.APIVersion + "/transactions"},
+			{"GET", "/api/" + config.APIVersion + "/transactions/export"},
 			{"POST", "/api/" + config.APIVersion + "/transactions/drafts"},
 			{"POST", "/api/" + config.APIVersion + "/transactions"},
 		}
@@ -53,6 +53,14 @@ func RequestAddPaymailToNewPaymailModel(r *api.RequestsAddPaymail, userID string
 	return newPaymail, nil
 }
 
+// RequestUpdatePaymailToPaymailUpdateModel maps an update paymail request to paymail update model
+func RequestUpdatePaymailToPaymailUpdateModel(r *api.RequestsUpdatePaymail) *paymailsmodels.PaymailUpdate {
+	return &paymailsmodels.PaymailUpdate{
+		PublicName: r.PublicName,
+		Avatar:     r.AvatarURL,
+	}
+}
+
 type addPaymailRequest struct {
 	*api.RequestsAddPaymail
 }
@@ -4,17 +4,32 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/api"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/users/usersmodels"
 	"github.com/bitcoin-sv/spv-wallet/lox"
+	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/samber/lo"
 )
 
 // UserToResponse maps a user to a response
 func UserToResponse(u *usersmodels.User) api.ModelsUser {
 	return api.ModelsUser{
-		Id:        u.ID,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
-		PublicKey: u.PublicKey,
-		Paymails:  lo.Map(u.Paymails, lox.MappingFn(UsersPaymailToResponse)),
+		Id:            u.ID,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
+		DeactivatedAt: u.DeactivatedAt,
+		PublicKey:     u.PublicKey,
+		Paymails:      lo.Map(u.Paymails, lox.MappingFn(UsersPaymailToResponse)),
+	}
+}
+
+// UsersPagedResponse maps a paged result of users to a response
+func UsersPagedResponse(users *models.PagedResult[usersmodels.User]) api.ModelsUsersSearchResult {
+	return api.ModelsUsersSearchResult{
+		Page: api.ModelsSearchPage{
+			Size:          users.PageDescription.Size,
+			Number:        users.PageDescription.Number,
+			TotalElements: users.PageDescription.TotalElements,
+			TotalPages:    users.PageDescription.TotalPages,
+		},
+		Content: lo.Map(users.Content, lox.MappingFn(UserToResponse)),
 	}
 }
 
@@ -0,0 +1,28 @@
+package operations
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/internal/export"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// AdminExportOperations streams the history of operations of a single user or of all users with their running balances
+func (s *APIAdminOperations) AdminExportOperations(c *gin.Context, params api.AdminExportOperationsParams) {
+	format, err := export.ParseFormat(string(lo.FromPtr(params.Format)))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+	if err = export.ValidateTimeRange(params.From, params.To); err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	createdRange := &filter.TimeRange{From: params.From, To: params.To}
+	entries := s.engine.OperationsService().History(c.Request.Context(), lo.FromPtr(params.UserId), createdRange)
+	export.Stream(c, format, "operations", export.Rows(entries, mapping.OperationsHistoryRow), s.logger)
+}
@@ -0,0 +1,100 @@
+package operations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/jsonrequire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminExportOperations(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	senderTx := givenForAllTests.Faucet(fixtures.Sender).TopUp(1000)
+	recipientTx := givenForAllTests.Faucet(fixtures.RecipientInternal).TopUp(500)
+
+	t.Run("export operations of all users", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("format", "jsonl").
+			Get("/api/v2/admin/operations/export")
+
+		// then:
+		then.Response(res).IsOK()
+
+		// and:
+		lines := strings.Split(strings.TrimSpace(res.String()), "\n")
+		require.Len(t, lines, 2)
+		jsonrequire.Match(t, `{
+			"createdAt": "{{ matchTimestamp }}",
+			"owner": "{{ .userID }}",
+			"txID": "{{ .txID }}",
+			"type": "incoming",
+			"counterparty": "",
+			"value": 1000,
+			"balance": 1000,
+			"txStatus": "MINED"
+		}`, map[string]any{
+			"userID": fixtures.Sender.ID(),
+			"txID":   senderTx.ID(),
+		}, lines[0])
+		jsonrequire.Match(t, `{
+			"createdAt": "{{ matchTimestamp }}",
+			"owner": "{{ .userID }}",
+			"txID": "{{ .txID }}",
+			"type": "incoming",
+			"counterparty": "",
+			"value": 500,
+			"balance": 500,
+			"txStatus": "MINED"
+		}`, map[string]any{
+			"userID": fixtures.RecipientInternal.ID(),
+			"txID":   recipientTx.ID(),
+		}, lines[1])
+	})
+
+	t.Run("export operations of a single user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("format", "jsonl").
+			SetQueryParam("userId", fixtures.RecipientInternal.ID()).
+			Get("/api/v2/admin/operations/export")
+
+		// then:
+		then.Response(res).IsOK()
+
+		// and:
+		lines := strings.Split(strings.TrimSpace(res.String()), "\n")
+		require.Len(t, lines, 1)
+		require.Contains(t, lines[0], recipientTx.ID())
+	})
+
+	t.Run("try to export operations as user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/admin/operations/export")
+
+		// then:
+		then.Response(res).IsUnauthorizedForUser()
+	})
+}
@@ -0,0 +1,20 @@
+package operations
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	"github.com/rs/zerolog"
+)
+
+// APIAdminOperations represents server with admin API endpoints
+type APIAdminOperations struct {
+	engine engine.ClientInterface
+	logger *zerolog.Logger
+}
+
+// NewAPIAdminOperations creates a new APIAdminOperations
+func NewAPIAdminOperations(engine engine.ClientInterface, logger *zerolog.Logger) APIAdminOperations {
+	return APIAdminOperations{
+		engine: engine,
+		logger: logger,
+	}
+}
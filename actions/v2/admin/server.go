@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/admin/operations"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/admin/users"
 	"github.com/bitcoin-sv/spv-wallet/engine"
 	"github.com/rs/zerolog"
@@ -9,11 +10,13 @@ import (
 // APIAdmin represents server with API endpoints
 type APIAdmin struct {
 	users.APIAdminUsers
+	operations.APIAdminOperations
 }
 
 // NewAPIAdmin creates a new APIAdmin
 func NewAPIAdmin(spvWalletEngine engine.ClientInterface, logger *zerolog.Logger) APIAdmin {
 	return APIAdmin{
 		users.NewAPIAdminUsers(spvWalletEngine, logger),
+		operations.NewAPIAdminOperations(spvWalletEngine, logger),
 	}
 }
@@ -0,0 +1,31 @@
+package users
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/admin/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/gin-gonic/gin"
+)
+
+// DeactivateUser deactivates the user, so it can no longer authenticate and its paymails are not resolvable
+func (s *APIAdminUsers) DeactivateUser(c *gin.Context, id string) {
+	user, err := s.engine.UsersService().Deactivate(c.Request.Context(), id)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.UserToResponse(user))
+}
+
+// ReactivateUser reactivates the previously deactivated user
+func (s *APIAdminUsers) ReactivateUser(c *gin.Context, id string) {
+	user, err := s.engine.UsersService().Reactivate(c.Request.Context(), id)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.UserToResponse(user))
+}
@@ -0,0 +1,132 @@
+package users_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeactivateAndReactivateUser(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	user := fixtures.Sender
+
+	t.Run("Deactivate a user as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("id", user.ID()).
+			Post("/api/v2/admin/users/{id}/deactivate")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"id": "{{ .id }}",
+				"createdAt": "{{ matchTimestamp }}",
+				"updatedAt": "{{ matchTimestamp }}",
+				"deactivatedAt": "{{ matchTimestamp }}",
+				"publicKey": "{{ .publicKey }}",
+				"paymails": {{ anything }}
+			}`, map[string]any{
+				"id":        user.ID(),
+				"publicKey": user.PublicKey().ToDERHex(),
+			})
+	})
+
+	t.Run("Deactivated user cannot authenticate", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(user)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/users/current")
+
+		// then:
+		then.Response(res).
+			HasStatus(401).
+			WithJSONf(apierror.ExpectedJSON("error-unauthorized", "unauthorized"))
+	})
+
+	t.Run("Search deactivated users as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("active", "false").
+			Get("/api/v2/admin/users")
+
+		// then:
+		then.Response(res).IsOK()
+		getter := then.Response(res).JSONValue()
+		require.Equal(t, user.ID(), getter.GetString("content[0]/id"))
+	})
+
+	t.Run("Reactivate a user as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("id", user.ID()).
+			Post("/api/v2/admin/users/{id}/reactivate")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"id": "{{ .id }}",
+				"createdAt": "{{ matchTimestamp }}",
+				"updatedAt": "{{ matchTimestamp }}",
+				"publicKey": "{{ .publicKey }}",
+				"paymails": {{ anything }}
+			}`, map[string]any{
+				"id":        user.ID(),
+				"publicKey": user.PublicKey().ToDERHex(),
+			})
+	})
+
+	t.Run("Reactivated user can authenticate again", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(user)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/users/current")
+
+		// then:
+		then.Response(res).IsOK()
+	})
+
+	t.Run("Try to deactivate not existing user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("id", "not-existing-user-id").
+			Post("/api/v2/admin/users/{id}/deactivate")
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(apierror.ExpectedJSON("error-user-not-found", "user not found"))
+	})
+}
@@ -92,7 +92,9 @@ func TestCreateUserWithoutPaymail(t *testing.T) {
 		then.Response(res).
 			IsOK().
 			WithJSONMatching(`{
-				"currentBalance": 0
+				"currentBalance": 0,
+				"confirmedBalance": 0,
+				"requiredConfirmations": 6
 			}`, nil)
 	})
 }
@@ -0,0 +1,44 @@
+package users
+
+import (
+	"net/http"
+
+	adminerrors "github.com/bitcoin-sv/spv-wallet/actions/v2/admin/errors"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/admin/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailerrors"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateUserPaymail updates the public name and/or avatar of the user's paymail
+func (s *APIAdminUsers) UpdateUserPaymail(c *gin.Context, id string, paymailID uint) {
+	var request api.RequestsUpdatePaymail
+	if err := c.Bind(&request); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrCannotBindRequest.Wrap(err), s.logger)
+		return
+	}
+
+	update := mapping.RequestUpdatePaymailToPaymailUpdateModel(&request)
+	updatedPaymail, err := s.engine.PaymailsService().Update(c.Request.Context(), id, paymailID, update)
+	if err != nil {
+		spverrors.MapResponse(c, err, s.logger).
+			If(paymailerrors.ErrInvalidAvatarURL).Then(adminerrors.ErrInvalidAvatarURL).
+			If(paymailerrors.ErrPaymailNotFound).Then(paymailerrors.ErrPaymailNotFound).
+			Finalize()
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.PaymailToAdminResponse(updatedPaymail))
+}
+
+// DeleteUserPaymail removes the user's paymail; its alias stays reserved, so it cannot be taken over by another user
+func (s *APIAdminUsers) DeleteUserPaymail(c *gin.Context, id string, paymailID uint) {
+	err := s.engine.PaymailsService().Delete(c.Request.Context(), id, paymailID)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
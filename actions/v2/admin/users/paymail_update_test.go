@@ -0,0 +1,179 @@
+package users_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestUpdateAndDeletePaymail(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	user := fixtures.Sender
+
+	// and:
+	avatarURL := "https://address-to-avatar.com"
+
+	var testState struct {
+		paymailID string
+	}
+
+	t.Run("Get paymail of the user as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("id", user.ID()).
+			Get("/api/v2/admin/users/{id}")
+
+		// then:
+		then.Response(res).IsOK()
+
+		// update:
+		var paymailID uint
+		then.Response(res).JSONValue().GetAsType("paymails[0]/id", &paymailID)
+		testState.paymailID = fmt.Sprintf("%d", paymailID)
+	})
+
+	t.Run("Update public name and avatar of the paymail as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"publicName": "New Name",
+				"avatarURL":  avatarURL,
+			}).
+			SetPathParam("id", user.ID()).
+			SetPathParam("paymailId", testState.paymailID).
+			Patch("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+			  "alias": "{{ .alias }}",
+			  "avatar": "{{ .avatar }}",
+			  "domain": "example.com",
+			  "id": {{ .id }},
+			  "paymail": "{{ .paymail }}",
+			  "publicName": "New Name"
+			}`, map[string]any{
+				"paymail": user.DefaultPaymail(),
+				"alias":   user.DefaultPaymail().Alias(),
+				"avatar":  avatarURL,
+				"id":      testState.paymailID,
+			})
+	})
+
+	t.Run("Try to update the paymail with wrong url avatar", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"avatarURL": "/User/path/to/avatar",
+			}).
+			SetPathParam("id", user.ID()).
+			SetPathParam("paymailId", testState.paymailID).
+			Patch("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).
+			HasStatus(422).
+			WithJSONf(apierror.ExpectedJSON("error-user-invalid-avatar-url", "invalid avatar url"))
+	})
+
+	t.Run("Try to update the paymail of another user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetBody(map[string]any{
+				"publicName": "Hijacked",
+			}).
+			SetPathParam("id", fixtures.RecipientInternal.ID()).
+			SetPathParam("paymailId", testState.paymailID).
+			Patch("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(apierror.ExpectedJSON("error-user-paymail-not-found", "paymail not found"))
+	})
+
+	t.Run("Delete the paymail as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("id", user.ID()).
+			SetPathParam("paymailId", testState.paymailID).
+			Delete("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).HasStatus(204)
+	})
+
+	t.Run("Get user without the deleted paymail as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("id", user.ID()).
+			Get("/api/v2/admin/users/{id}")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"id": "{{ .id }}",
+				"createdAt": "{{ matchTimestamp }}",
+				"updatedAt": "{{ matchTimestamp }}",
+				"publicKey": "{{ .publicKey }}",
+				"paymails": []
+			}`, map[string]any{
+				"id":        user.ID(),
+				"publicKey": user.PublicKey().ToDERHex(),
+			})
+	})
+
+	t.Run("Try to delete the already deleted paymail", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetPathParam("id", user.ID()).
+			SetPathParam("paymailId", testState.paymailID).
+			Delete("/api/v2/admin/users/{id}/paymails/{paymailId}")
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(apierror.ExpectedJSON("error-user-paymail-not-found", "paymail not found"))
+	})
+}
@@ -0,0 +1,64 @@
+package users
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/admin/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/gin-gonic/gin"
+)
+
+// SearchUsers returns users based on given filter parameters
+func (s *APIAdminUsers) SearchUsers(c *gin.Context, params api.SearchUsersParams) {
+	conditions := mapToUserFilter(params)
+	if err := conditions.Validate(); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrInvalidConditions.WithTrace(err), s.logger)
+		return
+	}
+
+	pagedResult, err := s.engine.UsersService().Search(c.Request.Context(), mapToFilter(params), conditions)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.UsersPagedResponse(pagedResult))
+}
+
+func mapToFilter(params api.SearchUsersParams) filter.Page {
+	page := filter.Page{}
+
+	if params.Page != nil {
+		page.Number = *params.Page
+	}
+	if params.Size != nil {
+		page.Size = *params.Size
+	}
+	if params.Sort != nil {
+		page.Sort = *params.Sort
+	}
+	if params.SortBy != nil {
+		page.SortBy = *params.SortBy
+	}
+
+	return page
+}
+
+func mapToUserFilter(params api.SearchUsersParams) *filter.UserFilter {
+	conditions := &filter.UserFilter{
+		PublicKey: params.PublicKey,
+		Paymail:   params.Paymail,
+		Active:    params.Active,
+	}
+
+	if params.CreatedFrom != nil || params.CreatedTo != nil {
+		conditions.CreatedRange = &filter.TimeRange{
+			From: params.CreatedFrom,
+			To:   params.CreatedTo,
+		}
+	}
+
+	return conditions
+}
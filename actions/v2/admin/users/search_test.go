@@ -0,0 +1,139 @@
+package users_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchUsers(t *testing.T) {
+	// given:
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	user := fixtures.Sender
+
+	t.Run("Search users by paymail as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("paymail", string(user.DefaultPaymail())).
+			Get("/api/v2/admin/users")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"content": [
+					{
+						"id": "{{ .id }}",
+						"createdAt": "{{ matchTimestamp }}",
+						"updatedAt": "{{ matchTimestamp }}",
+						"publicKey": "{{ .publicKey }}",
+						"paymails": [
+							{
+								"alias": "{{ .alias }}",
+								"avatar": "{{ matchURL | orEmpty }}",
+								"domain": "example.com",
+								"id": "{{ matchNumber }}",
+								"paymail": "{{ .paymail }}",
+								"publicName": "{{ .publicName }}"
+							}
+						]
+					}
+				],
+				"page": {
+					"number": 1,
+					"size": 1,
+					"totalElements": 1,
+					"totalPages": 1
+				}
+			}`, map[string]any{
+				"id":         user.ID(),
+				"publicKey":  user.PublicKey().ToDERHex(),
+				"paymail":    user.DefaultPaymail(),
+				"alias":      user.DefaultPaymail().Alias(),
+				"publicName": user.DefaultPaymail().PublicName(),
+			})
+	})
+
+	t.Run("Search users by public key as admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("publicKey", user.PublicKey().ToDERHex()).
+			Get("/api/v2/admin/users")
+
+		// then:
+		then.Response(res).IsOK()
+		getter := then.Response(res).JSONValue()
+		require.Equal(t, user.ID(), getter.GetString("content[0]/id"))
+	})
+
+	t.Run("Search only deactivated users as admin when there are none", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("active", "false").
+			Get("/api/v2/admin/users")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"content": [],
+				"page": {
+					"number": 1,
+					"size": 0,
+					"totalElements": 0,
+					"totalPages": 0
+				}
+			}`, nil)
+	})
+
+	t.Run("Try to search users with createdFrom after createdTo", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("createdFrom", "2024-03-01T00:00:00Z").
+			SetQueryParam("createdTo", "2024-02-01T00:00:00Z").
+			Get("/api/v2/admin/users")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(apierror.ExpectedJSON("error-bind-conditions-invalid", "invalid conditions"))
+	})
+
+	t.Run("Try to search users as user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/admin/users")
+
+		// then:
+		then.Response(res).IsUnauthorizedForUser()
+	})
+}
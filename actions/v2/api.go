@@ -6,6 +6,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/data"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/merkleroots"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/operations"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/stablecoins"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/transactions"
 	"github.com/bitcoin-sv/spv-wallet/actions/v2/users"
 	"github.com/bitcoin-sv/spv-wallet/api"
@@ -21,6 +22,7 @@ type apiV2 struct {
 	data.APIData
 	users.APIUsers
 	operations.APIOperations
+	stablecoins.APIStablecoins
 	transactions.APITransactions
 	merkleroots.APIMerkleRoots
 }
@@ -33,6 +35,7 @@ func NewV2API(config *config.AppConfig, engine engine.ClientInterface, logger *z
 		data.NewAPIData(engine, logger),
 		users.NewAPIUsers(engine, logger),
 		operations.NewAPIOperations(engine, logger),
+		stablecoins.NewAPIStablecoins(engine, logger),
 		transactions.NewAPITransactions(engine, logger),
 		merkleroots.NewAPIMerkleRoots(engine, logger),
 	}
@@ -0,0 +1,98 @@
+package integrationtests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/internal/integrationtests/testabilities"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+)
+
+const minedBlockHeight = 885803
+
+func TestSyncConfirmations(t *testing.T) {
+	// given:
+	given, when, then := testabilities.New(t)
+	cleanup := given.StartedSPVWalletV2()
+	defer cleanup()
+
+	// and:
+	receiveTxID := when.Alice().ReceivesFromExternal(10)
+	when.ARC().SendsCallback(minedTxInfo(t, receiveTxID))
+
+	// and:
+	given.BHS().WillRespondForChainTip(minedBlockHeight + 2)
+	given.BHS().WillRespondForMerkleRootsVerify(200, &chainmodels.MerkleRootsConfirmations{
+		ConfirmationState: chainmodels.MRConfirmed,
+	})
+
+	// when:
+	when.TxSync().SyncsConfirmations()
+
+	// then:
+	then.Alice().Operations().Last().
+		WithTxID(receiveTxID).
+		WithTxStatus(string(txmodels.TxStatusMined)).
+		WithConfirmations(3)
+
+	// and:
+	then.Alice().Balance().IsEqualTo(10)
+	then.Alice().Balance().IsConfirmedEqualTo(0)
+
+	// given:
+	given.BHS().WillRespondForChainTip(minedBlockHeight + 100)
+
+	// when:
+	when.TxSync().SyncsConfirmations()
+
+	// then:
+	then.Alice().Operations().Last().
+		WithTxID(receiveTxID).
+		WithConfirmations(6)
+
+	// and:
+	then.Alice().Balance().IsConfirmedEqualTo(10)
+}
+
+func TestSyncConfirmationsOnReorg(t *testing.T) {
+	// given:
+	given, when, then := testabilities.New(t)
+	cleanup := given.StartedSPVWalletV2()
+	defer cleanup()
+
+	// and:
+	receiveTxID := when.Alice().ReceivesFromExternal(10)
+	when.ARC().SendsCallback(minedTxInfo(t, receiveTxID))
+
+	// and:
+	given.BHS().WillRespondForChainTip(minedBlockHeight + 1)
+	given.BHS().WillRespondForMerkleRootsVerify(200, &chainmodels.MerkleRootsConfirmations{
+		ConfirmationState: chainmodels.MRInvalid,
+	})
+
+	// when:
+	when.TxSync().SyncsConfirmations()
+
+	// then:
+	then.Alice().Operations().Last().
+		WithTxID(receiveTxID).
+		WithTxStatus(string(txmodels.TxStatusBroadcasted)).
+		WithNoBlock()
+
+	// and:
+	then.Alice().Balance().IsEqualTo(10)
+	then.Alice().Balance().IsConfirmedEqualTo(0)
+}
+
+func minedTxInfo(t *testing.T, txID string) chainmodels.TXInfo {
+	txInfo := chainmodels.TXInfo{
+		TxID:        txID,
+		TXStatus:    chainmodels.Mined,
+		BlockHeight: minedBlockHeight,
+		BlockHash:   "00000000000000000f0905597b6cac80031f0f56834e74dce1a714c682a9ed38",
+		Timestamp:   time.Now().Add(10 * time.Minute),
+	}
+	calcBump(t, &txInfo)
+	return txInfo
+}
@@ -37,6 +37,7 @@ type IntegrationTestAction interface {
 	Bob() ActorsActions
 	Charlie() ActorsActions
 	ARC() ARCActions
+	TxSync() TxSyncActions
 }
 
 type ActorsActions interface {
@@ -51,6 +52,10 @@ type ARCActions interface {
 	SendsCallback(txInfo chainmodels.TXInfo)
 }
 
+type TxSyncActions interface {
+	SyncsConfirmations()
+}
+
 type actions struct {
 	t       testing.TB
 	fixture *fixture
@@ -74,6 +79,13 @@ func (a *actions) ARC() ARCActions {
 	}
 }
 
+func (a *actions) TxSync() TxSyncActions {
+	return &txSyncActions{
+		t:       a.t,
+		fixture: a.fixture,
+	}
+}
+
 func (a *actions) Bob() ActorsActions {
 	return a.fixture.bob
 }
@@ -157,12 +169,14 @@ func (u *user) SignsAndRecord() string {
 		WithJSONMatching(`{
           "format": "BEEF",
           "hex": "{{ matchBEEF }}",
-          "annotations": {{ anything }}
+          "annotations": {{ anything }},
+          "reservationID": "{{ matchUUID }}"
        }`, nil)
 
 	getter := then.Response(outlineRes).JSONValue()
 
 	hex := getter.GetString("hex")
+	reservationID := getter.GetString("reservationID")
 	annotations := make(map[string]any)
 	getter.GetAsType("annotations", &annotations)
 
@@ -202,9 +216,10 @@ func (u *user) SignsAndRecord() string {
 	recordRes, _ := recordClient.R().
 		SetHeader("Content-Type", "application/json").
 		SetBody(map[string]any{
-			"hex":         signedHex,
-			"format":      "BEEF",
-			"annotations": annotations,
+			"hex":           signedHex,
+			"format":        "BEEF",
+			"annotations":   annotations,
+			"reservationID": reservationID,
 		}).
 		Post(transactionRecordURL)
 
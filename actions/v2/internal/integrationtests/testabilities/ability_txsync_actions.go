@@ -0,0 +1,18 @@
+package testabilities
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type txSyncActions struct {
+	t       testing.TB
+	fixture *fixture
+}
+
+func (a *txSyncActions) SyncsConfirmations() {
+	err := a.fixture.Engine().TxSyncService().SyncConfirmations(context.Background())
+	require.NoError(a.t, err)
+}
@@ -13,6 +13,7 @@ import (
 type IntegrationTestFixtures interface {
 	StartedSPVWalletV2(opts ...testengine.ConfigOpts) (cleanup func())
 	Paymail() testpaymail.PaymailClientFixture
+	BHS() testabilities.BlockHeadersServiceFixture
 
 	Alice() *fixtures.User
 	Bob() *fixtures.User
@@ -0,0 +1,28 @@
+package mapping
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations/operationsmodels"
+	"github.com/bitcoin-sv/spv-wallet/internal/export"
+	"github.com/samber/lo"
+)
+
+// OperationsHistoryRow maps an entry of the operations history to a row of the history export.
+func OperationsHistoryRow(entry *operationsmodels.HistoryEntry) *export.Row {
+	row := &export.Row{
+		CreatedAt:    entry.CreatedAt,
+		Owner:        entry.UserID,
+		TxID:         entry.TxID,
+		Type:         entry.Type,
+		Counterparty: entry.Counterparty,
+		Value:        entry.Value,
+		Balance:      entry.Balance,
+		TxStatus:     entry.TxStatus,
+	}
+	if entry.Fee != nil {
+		row.Fee = lo.ToPtr(uint64(*entry.Fee))
+	}
+	if entry.BlockHeight != nil {
+		row.BlockHeight = lo.ToPtr(uint64(*entry.BlockHeight))
+	}
+	return row
+}
@@ -0,0 +1,36 @@
+package operations
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/internal/export"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// ExportOperations streams the history of the user's operations with the running balance
+func (s *APIOperations) ExportOperations(c *gin.Context, params api.ExportOperationsParams) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.AbortWithErrorResponse(c, err, s.logger)
+		return
+	}
+
+	format, err := export.ParseFormat(string(lo.FromPtr(params.Format)))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+	if err = export.ValidateTimeRange(params.From, params.To); err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	createdRange := &filter.TimeRange{From: params.From, To: params.To}
+	entries := s.engine.OperationsService().History(c.Request.Context(), userID, createdRange)
+	export.Stream(c, format, "operations", export.Rows(entries, mapping.OperationsHistoryRow), s.logger)
+}
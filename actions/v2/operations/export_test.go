@@ -0,0 +1,235 @@
+package operations_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/jsonrequire"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportOperations(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	sender := fixtures.Sender
+	topUpTx := givenForAllTests.Faucet(sender).TopUp(1000)
+	dataTx, _ := givenForAllTests.Faucet(sender).StoreData("hello world")
+
+	// and: outgoing transaction paying 100 satoshis of fee
+	changeInstructions := bsv.CustomInstructions{{Type: "type42", Instruction: "1-destination-1output4d06387d3be7bd26cfe2b5996"}}
+	outgoingTx := givenForAllTests.Tx().
+		WithSender(sender).
+		WithInputFromUTXO(topUpTx.TX(), 0).
+		WithOPReturn("hello, world").
+		WithOutputScript(900, sender.P2PKHLockingScript(changeInstructions...))
+	givenForAllTests.ARC().WillRespondForBroadcastWithSeenOnNetwork(outgoingTx.ID())
+
+	res, _ := givenForAllTests.HttpClient().ForGivenUser(sender).R().
+		SetBody(map[string]any{
+			"hex":    outgoingTx.BEEF(),
+			"format": "BEEF",
+			"annotations": map[string]any{
+				"outputs": map[string]any{
+					"0": map[string]any{"bucket": "data"},
+					"1": map[string]any{"bucket": "bsv", "customInstructions": changeInstructions},
+				},
+			},
+		}).
+		Post("/api/v2/transactions")
+	require.Equal(t, http.StatusCreated, res.StatusCode())
+
+	// and: operations created at fixed times, so the time range of the export can be set between them
+	db := givenForAllTests.Engine().Datastore().DB()
+	for txID, createdAt := range map[string]string{
+		topUpTx.ID():    "2025-03-10T10:00:00Z",
+		dataTx.ID():     "2025-03-10T11:00:00Z",
+		outgoingTx.ID(): "2025-03-10T12:00:00Z",
+	} {
+		at, err := time.Parse(time.RFC3339, createdAt)
+		require.NoError(t, err)
+		require.NoError(t, db.Model(&database.Operation{}).Where("tx_id = ?", txID).Update("created_at", at).Error)
+	}
+
+	t.Run("export operations as JSON lines", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("format", "jsonl").
+			Get("/api/v2/operations/export")
+
+		// then:
+		then.Response(res).IsOK()
+		require.Equal(t, "application/x-ndjson", res.Header().Get("Content-Type"))
+		require.Equal(t, `attachment; filename="operations.jsonl"`, res.Header().Get("Content-Disposition"))
+
+		// and:
+		lines := strings.Split(strings.TrimSpace(res.String()), "\n")
+		require.Len(t, lines, 3)
+		jsonrequire.Match(t, `{
+			"createdAt": "2025-03-10T10:00:00Z",
+			"owner": "{{ .userID }}",
+			"txID": "{{ .txID }}",
+			"type": "incoming",
+			"counterparty": "",
+			"value": 1000,
+			"balance": 1000,
+			"txStatus": "MINED"
+		}`, map[string]any{
+			"userID": fixtures.Sender.ID(),
+			"txID":   topUpTx.ID(),
+		}, lines[0])
+		jsonrequire.Match(t, `{
+			"createdAt": "2025-03-10T11:00:00Z",
+			"owner": "{{ .userID }}",
+			"txID": "{{ .txID }}",
+			"type": "data",
+			"counterparty": "",
+			"value": 0,
+			"balance": 1000,
+			"txStatus": "MINED"
+		}`, map[string]any{
+			"userID": fixtures.Sender.ID(),
+			"txID":   dataTx.ID(),
+		}, lines[1])
+		jsonrequire.Match(t, `{
+			"createdAt": "2025-03-10T12:00:00Z",
+			"owner": "{{ .userID }}",
+			"txID": "{{ .txID }}",
+			"type": "outgoing",
+			"counterparty": "",
+			"value": -100,
+			"fee": 100,
+			"balance": 900,
+			"txStatus": "BROADCASTED"
+		}`, map[string]any{
+			"userID": fixtures.Sender.ID(),
+			"txID":   outgoingTx.ID(),
+		}, lines[2])
+	})
+
+	t.Run("export operations from the given time with the opening balance", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("format", "jsonl").
+			SetQueryParam("from", "2025-03-10T11:30:00Z").
+			Get("/api/v2/operations/export")
+
+		// then:
+		then.Response(res).IsOK()
+
+		// and:
+		lines := strings.Split(strings.TrimSpace(res.String()), "\n")
+		require.Len(t, lines, 1)
+		jsonrequire.Match(t, `{
+			"createdAt": "2025-03-10T12:00:00Z",
+			"owner": "{{ .userID }}",
+			"txID": "{{ .txID }}",
+			"type": "outgoing",
+			"counterparty": "",
+			"value": -100,
+			"fee": 100,
+			"balance": 900,
+			"txStatus": "BROADCASTED"
+		}`, map[string]any{
+			"userID": fixtures.Sender.ID(),
+			"txID":   outgoingTx.ID(),
+		}, lines[0])
+	})
+
+	t.Run("export operations as CSV by default", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/operations/export")
+
+		// then:
+		then.Response(res).IsOK()
+		require.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+
+		// and:
+		lines := strings.Split(strings.TrimSpace(res.String()), "\n")
+		require.Len(t, lines, 4)
+		require.Equal(t, "created_at,owner,tx_id,type,counterparty,value,fee,balance,tx_status,block_height", lines[0])
+		require.Equal(t, "2025-03-10T12:00:00Z,"+fixtures.Sender.ID()+","+outgoingTx.ID()+",outgoing,,-100,100,900,BROADCASTED,", lines[3])
+	})
+
+	t.Run("export operations of other user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/operations/export")
+
+		// then:
+		then.Response(res).IsOK()
+		require.Equal(t, "created_at,owner,tx_id,type,counterparty,value,fee,balance,tx_status,block_height", res.String())
+	})
+
+	t.Run("try to export operations in unsupported format", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("format", "xml").
+			Get("/api/v2/operations/export")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(apierror.ExpectedJSON("error-export-format-invalid", "invalid export format, supported formats are csv and jsonl"))
+	})
+
+	t.Run("try to export operations with invalid time range", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("from", "2024-03-01T00:00:00Z").
+			SetQueryParam("to", "2024-02-01T00:00:00Z").
+			Get("/api/v2/operations/export")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(apierror.ExpectedJSON("error-export-time-range-invalid", "invalid export time range, from cannot be after to"))
+	})
+
+	t.Run("try to export operations for admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/operations/export")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+}
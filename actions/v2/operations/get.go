@@ -0,0 +1,42 @@
+package operations
+
+import (
+	"encoding/hex"
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/operations/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const txIDHexLength = 64
+
+// OperationByTxID returns the operation of the user on the given transaction with the breakdown of the transaction
+func (s *APIOperations) OperationByTxID(c *gin.Context, txID string, params api.OperationByTxIDParams) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.AbortWithErrorResponse(c, err, s.logger)
+		return
+	}
+
+	if _, err = hex.DecodeString(txID); err != nil || len(txID) != txIDHexLength {
+		spverrors.ErrorResponse(c, spverrors.ErrInvalidTransactionID, s.logger)
+		return
+	}
+
+	details, err := s.engine.OperationsService().FindForUser(c.Request.Context(), userID, txID)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+	if details == nil {
+		spverrors.ErrorResponse(c, spverrors.ErrOperationNotFound, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.OperationDetailsResponse(details, lo.FromPtr(params.IncludeHex)))
+}
@@ -0,0 +1,161 @@
+package operations_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestGetOperation(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	topUpTx := givenForAllTests.Faucet(fixtures.Sender).TopUp(1000)
+	dataTx, dataID := givenForAllTests.Faucet(fixtures.Sender).StoreData("hello world")
+
+	t.Run("get incoming operation with received outputs and fee", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/operations/" + topUpTx.ID())
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"txID": "{{ .txID }}",
+			"createdAt": "{{ matchTimestamp }}",
+			"value": 1000,
+			"type": "incoming",
+			"counterparty": "",
+			"txStatus": "MINED",
+			"inputs": [],
+			"outputs": [
+				{
+					"txID": "{{ .txID }}",
+					"vout": 0,
+					"satoshis": 1000
+				}
+			],
+			"data": [],
+			"fee": 1
+		}`, map[string]any{
+			"txID": topUpTx.ID(),
+		})
+	})
+
+	t.Run("get operation with transaction hex", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("includeHex", "true").
+			Get("/api/v2/operations/" + topUpTx.ID())
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"txID": "{{ .txID }}",
+			"createdAt": "{{ matchTimestamp }}",
+			"value": 1000,
+			"type": "incoming",
+			"counterparty": "",
+			"txStatus": "MINED",
+			"inputs": [],
+			"outputs": [
+				{
+					"txID": "{{ .txID }}",
+					"vout": 0,
+					"satoshis": 1000
+				}
+			],
+			"data": [],
+			"fee": 1,
+			"transaction": {
+				"hex": "{{ .beef }}",
+				"format": "BEEF"
+			}
+		}`, map[string]any{
+			"txID": topUpTx.ID(),
+			"beef": topUpTx.BEEF(),
+		})
+	})
+
+	t.Run("get data operation", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/operations/" + dataTx.ID())
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"txID": "{{ .txID }}",
+			"createdAt": "{{ matchTimestamp }}",
+			"value": 0,
+			"type": "data",
+			"counterparty": "",
+			"txStatus": "MINED",
+			"inputs": [],
+			"outputs": [],
+			"data": [
+				{
+					"id": "{{ .dataID }}",
+					"blob": "hello world"
+				}
+			]
+		}`, map[string]any{
+			"txID":   dataTx.ID(),
+			"dataID": dataID,
+		})
+	})
+
+	t.Run("try to get operation of other user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/operations/" + topUpTx.ID())
+
+		// then:
+		then.Response(res).
+			HasStatus(404).
+			WithJSONf(apierror.ExpectedJSON("error-operation-not-found", "operation not found"))
+	})
+
+	t.Run("try to get operation with invalid transaction id", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/operations/not-a-tx-id")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(apierror.ExpectedJSON("error-transaction-id-invalid", "invalid transaction id"))
+	})
+
+	t.Run("try to get operation for admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/operations/" + topUpTx.ID())
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+}
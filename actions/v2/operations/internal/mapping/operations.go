@@ -24,13 +24,71 @@ func OperationsPagedResponse(operations *models.PagedResult[operationsmodels.Ope
 // OperationsResponse maps an operation to a response.
 func OperationsResponse(operation *operationsmodels.Operation) api.ModelsOperation {
 	return api.ModelsOperation{
-		CreatedAt:    operation.CreatedAt,
-		Value:        operation.Value,
-		TxID:         operation.TxID,
-		Type:         api.ModelsOperationType(operation.Type),
-		Counterparty: operation.Counterparty,
-		TxStatus:     api.ModelsOperationTxStatus(operation.TxStatus),
-		BlockHeight:  operation.BlockHeight,
-		BlockHash:    operation.BlockHash,
+		CreatedAt:     operation.CreatedAt,
+		Value:         operation.Value,
+		TxID:          operation.TxID,
+		Type:          api.ModelsOperationType(operation.Type),
+		Counterparty:  operation.Counterparty,
+		TxStatus:      api.ModelsOperationTxStatus(operation.TxStatus),
+		BlockHeight:   operation.BlockHeight,
+		BlockHash:     operation.BlockHash,
+		Confirmations: lo.If(operation.BlockHeight != nil, &operation.Confirmations).Else(nil),
+	}
+}
+
+// OperationDetailsResponse maps an operation with the breakdown of its transaction to a response.
+// The hex of the transaction is included only if requested.
+func OperationDetailsResponse(details *operationsmodels.OperationDetails, includeHex bool) api.ModelsOperationDetails {
+	operation := OperationsResponse(&details.Operation)
+	response := api.ModelsOperationDetails{
+		CreatedAt:     operation.CreatedAt,
+		Value:         operation.Value,
+		TxID:          operation.TxID,
+		Type:          api.ModelsOperationDetailsType(operation.Type),
+		Counterparty:  operation.Counterparty,
+		TxStatus:      api.ModelsOperationDetailsTxStatus(operation.TxStatus),
+		BlockHeight:   operation.BlockHeight,
+		BlockHash:     operation.BlockHash,
+		Confirmations: operation.Confirmations,
+		Inputs:        lo.Map(details.Inputs, lox.MappingFn(operationOutputResponse)),
+		Outputs:       lo.Map(details.Outputs, lox.MappingFn(operationOutputResponse)),
+		Data: lo.Map(details.Data, func(data *operationsmodels.DataOutput, _ int) api.ModelsData {
+			return api.ModelsData{
+				Id:   data.ID(),
+				Blob: string(data.Blob),
+			}
+		}),
+		Fee: (*uint64)(details.Fee),
+	}
+
+	if includeHex {
+		response.Transaction = transactionHexResponse(details)
+	}
+
+	return response
+}
+
+func operationOutputResponse(output *operationsmodels.TrackedOutput) api.ModelsOperationOutput {
+	return api.ModelsOperationOutput{
+		TxID:     output.TxID,
+		Vout:     output.Vout,
+		Satoshis: uint64(output.Satoshis),
+	}
+}
+
+func transactionHexResponse(details *operationsmodels.OperationDetails) *api.ModelsTransactionHex {
+	switch {
+	case details.BeefHex != nil:
+		return &api.ModelsTransactionHex{
+			Hex:    *details.BeefHex,
+			Format: api.ModelsTransactionHexFormatBEEF,
+		}
+	case details.RawHex != nil:
+		return &api.ModelsTransactionHex{
+			Hex:    *details.RawHex,
+			Format: api.ModelsTransactionHexFormatRAW,
+		}
+	default:
+		return nil
 	}
 }
@@ -20,8 +20,14 @@ func (s *APIOperations) SearchOperations(c *gin.Context, params api.SearchOperat
 		return
 	}
 
+	conditions := mapToOperationFilter(params)
+	if err = conditions.Validate(); err != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrInvalidConditions.WithTrace(err), s.logger)
+		return
+	}
+
 	page := mapToFilter(params)
-	pagedResult, err := s.engine.OperationsService().PaginatedForUser(c.Request.Context(), userID, page)
+	pagedResult, err := s.engine.OperationsService().PaginatedForUser(c.Request.Context(), userID, page, conditions)
 	if err != nil {
 		spverrors.ErrorResponse(c, err, s.logger)
 		return
@@ -48,3 +54,22 @@ func mapToFilter(params api.SearchOperationsParams) filter.Page {
 
 	return page
 }
+
+func mapToOperationFilter(params api.SearchOperationsParams) *filter.OperationFilter {
+	conditions := &filter.OperationFilter{
+		Counterparty: params.Counterparty,
+		MinValue:     params.MinValue,
+		MaxValue:     params.MaxValue,
+		Type:         (*string)(params.Type),
+		TxStatus:     (*string)(params.TxStatus),
+	}
+
+	if params.CreatedFrom != nil || params.CreatedTo != nil {
+		conditions.CreatedRange = &filter.TimeRange{
+			From: params.CreatedFrom,
+			To:   params.CreatedTo,
+		}
+	}
+
+	return conditions
+}
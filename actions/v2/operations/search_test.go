@@ -2,9 +2,14 @@ package operations_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
 	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUserOperations(t *testing.T) {
@@ -58,3 +63,187 @@ func TestUserOperations(t *testing.T) {
 		then.Response(res).IsUnauthorized()
 	})
 }
+
+func TestUserOperationsFiltering(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	topUpTx := givenForAllTests.Faucet(fixtures.Sender).TopUp(1000)
+	dataTx, _ := givenForAllTests.Faucet(fixtures.Sender).StoreData("some data")
+
+	// and:
+	err := givenForAllTests.Engine().Datastore().DB().
+		Model(&database.Operation{}).
+		Where("tx_id = ?", topUpTx.ID()).
+		Update("counterparty", "Faucet@Example.com").Error
+	require.NoError(t, err)
+
+	t.Run("filter operations by type", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("type", "data").
+			Get("/api/v2/operations/search")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [
+				{
+					"txID": "{{ .txID }}",
+					"createdAt": "{{ matchTimestamp }}",
+					"value": 0,
+					"type": "data",
+					"counterparty": "",
+					"txStatus": "MINED"
+				}
+			],
+			"page": {
+			    "number": 1,
+			    "size": 1,
+			    "totalElements": 1,
+			    "totalPages": 1
+			}
+		}`, map[string]any{
+			"txID": dataTx.ID(),
+		})
+	})
+
+	t.Run("filter operations by value range and tx status", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("minValue", "1").
+			SetQueryParam("maxValue", "1000").
+			SetQueryParam("txStatus", "MINED").
+			Get("/api/v2/operations/search")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [
+				{
+					"txID": "{{ .txID }}",
+					"createdAt": "{{ matchTimestamp }}",
+					"value": 1000,
+					"type": "incoming",
+					"counterparty": "Faucet@Example.com",
+					"txStatus": "MINED"
+				}
+			],
+			"page": {
+			    "number": 1,
+			    "size": 1,
+			    "totalElements": 1,
+			    "totalPages": 1
+			}
+		}`, map[string]any{
+			"txID": topUpTx.ID(),
+		})
+	})
+
+	t.Run("filter operations by counterparty case-insensitively", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("counterparty", "faucet@example.com").
+			Get("/api/v2/operations/search")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [
+				{
+					"txID": "{{ .txID }}",
+					"createdAt": "{{ matchTimestamp }}",
+					"value": 1000,
+					"type": "incoming",
+					"counterparty": "Faucet@Example.com",
+					"txStatus": "MINED"
+				}
+			],
+			"page": {
+			    "number": 1,
+			    "size": 1,
+			    "totalElements": 1,
+			    "totalPages": 1
+			}
+		}`, map[string]any{
+			"txID": topUpTx.ID(),
+		})
+	})
+
+	t.Run("return no operations matching only part of the counterparty", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("counterparty", "faucet").
+			Get("/api/v2/operations/search")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [],
+			"page": {
+			    "number": 1,
+			    "size": 0,
+			    "totalElements": 0,
+			    "totalPages": 0
+			}
+		}`, nil)
+	})
+
+	t.Run("return no operations not matching the filter", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("type", "incoming").
+			SetQueryParam("txStatus", "BROADCASTED").
+			SetQueryParam("counterparty", "alice@example.com").
+			SetQueryParam("createdFrom", time.Now().Add(time.Hour).Format(time.RFC3339)).
+			Get("/api/v2/operations/search")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [],
+			"page": {
+			    "number": 1,
+			    "size": 0,
+			    "totalElements": 0,
+			    "totalPages": 0
+			}
+		}`, nil)
+	})
+
+	t.Run("try to filter operations by invalid value range", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("minValue", "1000").
+			SetQueryParam("maxValue", "1").
+			Get("/api/v2/operations/search")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(apierror.ExpectedJSON("error-bind-conditions-invalid", "invalid conditions"))
+	})
+}
@@ -0,0 +1,30 @@
+package stablecoins
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/stablecoins/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// StablecoinBalances return balances of the stablecoins held by the user
+func (s *APIStablecoins) StablecoinBalances(c *gin.Context, params api.StablecoinBalancesParams) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.AbortWithErrorResponse(c, err, s.logger)
+		return
+	}
+
+	balances, err := s.engine.BanknotesService().BalancesForUser(c.Request.Context(), userID, lo.FromPtr(params.StablecoinId))
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.StablecoinBalancesResponse(balances))
+}
@@ -0,0 +1,45 @@
+package stablecoins
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/stablecoins/internal/mapping"
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// SearchStablecoinBanknotes return unspent stablecoin banknotes of the user based on given filter parameters
+func (s *APIStablecoins) SearchStablecoinBanknotes(c *gin.Context, params api.SearchStablecoinBanknotesParams) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.AbortWithErrorResponse(c, err, s.logger)
+		return
+	}
+
+	pagedResult, err := s.engine.BanknotesService().PaginatedForUser(
+		c.Request.Context(),
+		userID,
+		lo.FromPtr(params.StablecoinId),
+		mapToPage(params),
+	)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping.StablecoinBanknotesPagedResponse(pagedResult))
+}
+
+func mapToPage(params api.SearchStablecoinBanknotesParams) filter.Page {
+	return filter.Page{
+		Number: lo.FromPtr(params.Page),
+		Size:   lo.FromPtr(params.Size),
+		Sort:   lo.FromPtr(params.Sort),
+		SortBy: lo.FromPtr(params.SortBy),
+	}
+}
@@ -0,0 +1,321 @@
+package stablecoins_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/4chain-AG/gateway-overlay/pkg/token_engine/bsv21"
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testStablecoinID      = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"
+	testOtherStablecoinID = "a0f5c6f4a3a2b7b3e5d3b6a1c1e9b5f3e8d2c1b0a9f8e7d6c5b4a3f2e1d0c9b8_0"
+	testBanknotesTxID     = "1b52eac9d1eb0adf3ce6a56dee1c4768780b8126e288aca65dd1db32f173b853"
+)
+
+func TestUserStablecoins(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	t.Run("return empty banknotes list for user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/stablecoins/banknotes")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [],
+			"page": {
+			    "number": 1,
+			    "size": 0,
+			    "totalElements": 0,
+			    "totalPages": 0
+			}
+		}`, nil)
+	})
+
+	t.Run("return empty balances for user", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/stablecoins/balances")
+
+		// then:
+		then.Response(res).IsOK().WithJSONf(`[]`)
+	})
+
+	t.Run("try return banknotes for admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/stablecoins/banknotes")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+
+	t.Run("try return balances for anonymous", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAnonymous()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/stablecoins/balances")
+
+		// then:
+		then.Response(res).IsUnauthorized()
+	})
+}
+
+func TestUserStablecoinsWithBanknotes(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// given banknotes of the user (one of them already spent) and a banknote of other user:
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	banknote := func(user fixtures.User, vout uint32, stablecoinID string, amount uint64, unspent bool) {
+		db := givenForAllTests.Engine().Datastore().DB()
+		require.NoError(t, db.Create(&database.UserBanknote{
+			TxID:         testBanknotesTxID,
+			Vout:         vout,
+			UserID:       user.ID(),
+			StablecoinID: stablecoinID,
+			Serial:       stablecoinID,
+			Amount:       amount,
+			CreatedAt:    createdAt.Add(time.Duration(vout) * time.Minute),
+		}).Error)
+		if unspent {
+			require.NoError(t, db.Create(&database.UserUTXO{
+				UserID:   user.ID(),
+				TxID:     testBanknotesTxID,
+				Vout:     vout,
+				Satoshis: 1,
+				Bucket:   string(bucket.Token),
+			}).Error)
+		}
+	}
+	banknote(fixtures.Sender, 0, testStablecoinID, 100, true)
+	banknote(fixtures.Sender, 1, testStablecoinID, 50, true)
+	banknote(fixtures.Sender, 2, testOtherStablecoinID, 7, true)
+	banknote(fixtures.Sender, 3, testStablecoinID, 1000, false)
+	banknote(fixtures.RecipientInternal, 4, testStablecoinID, 10, true)
+
+	t.Run("return unspent banknotes of the stablecoin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("stablecoinId", testStablecoinID).
+			SetQueryParam("size", "1").
+			SetQueryParam("page", "2").
+			Get("/api/v2/stablecoins/banknotes")
+
+		// then:
+		then.Response(res).IsOK().WithJSONf(`{
+			"content": [
+				{
+					"serial": "%s",
+					"amount": 100,
+					"stablecoinId": "%s",
+					"txID": "%s",
+					"vout": 0,
+					"createdAt": "2025-01-02T03:04:05Z"
+				}
+			],
+			"page": {
+			    "number": 2,
+			    "size": 1,
+			    "totalElements": 2,
+			    "totalPages": 2
+			}
+		}`, testStablecoinID, testStablecoinID, testBanknotesTxID)
+	})
+
+	t.Run("return balances of the unspent banknotes", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.Sender)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/stablecoins/balances")
+
+		// then:
+		then.Response(res).IsOK().WithJSONf(`[
+			{
+				"stablecoinId": "%s",
+				"amount": 150,
+				"banknotes": 2
+			},
+			{
+				"stablecoinId": "%s",
+				"amount": 7,
+				"banknotes": 1
+			}
+		]`, testStablecoinID, testOtherStablecoinID)
+	})
+
+	t.Run("return balance of the given stablecoin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(fixtures.RecipientInternal)
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("stablecoinId", testStablecoinID).
+			Get("/api/v2/stablecoins/balances")
+
+		// then:
+		then.Response(res).IsOK().WithJSONf(`[
+			{
+				"stablecoinId": "%s",
+				"amount": 10,
+				"banknotes": 1
+			}
+		]`, testStablecoinID)
+	})
+}
+
+func TestUserStablecoinsOfReceivedTokenTransaction(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithDomainValidationDisabled(),
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// given:
+	given, then := testabilities.NewOf(givenForAllTests, t)
+	client := given.HttpClient().ForAnonymous()
+	recipient := fixtures.RecipientInternal
+	recipientPaymail := recipient.DefaultPaymail()
+
+	// and:
+	res, _ := client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{"satoshis": 1}).
+		Post(fmt.Sprintf("https://example.com/v1/bsvalias/p2p-payment-destination/%s", recipientPaymail))
+	then.Response(res).IsOK()
+
+	destination := then.Response(res).JSONValue()
+	lockingScript, err := script.NewFromHex(destination.GetString("outputs[0]/script"))
+	require.NoError(t, err)
+
+	// and:
+	inscription, err := bsv21.NewBsv21Transfer(testStablecoinID, 100)
+	require.NoError(t, err)
+	tokenScript := script.NewFromBytes(append(*lockingScript, *inscription...))
+
+	txSpec := given.Tx().
+		WithInput(2).
+		WithOutputScript(1, tokenScript)
+	given.SourceTxs().WillProvide(txSpec.InputSourceTX(0))
+	given.ARC().WillRespondForBroadcast(200, &chainmodels.TXInfo{
+		TxID:     txSpec.ID(),
+		TXStatus: chainmodels.SeenOnNetwork,
+	})
+
+	// when:
+	res, _ = client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"hex":       txSpec.RawTX(),
+			"reference": destination.GetString("reference"),
+			"metadata": map[string]any{
+				"sender": fixtures.SenderExternal.DefaultPaymail(),
+			},
+		}).
+		Post(fmt.Sprintf("https://example.com/v1/bsvalias/receive-transaction/%s", recipientPaymail))
+
+	// then:
+	then.Response(res).IsOK()
+
+	t.Run("return the banknote of the received token", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(recipient)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/stablecoins/banknotes")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"content": [
+				{
+					"serial": "{{ .stablecoinID }}",
+					"amount": 100,
+					"stablecoinId": "{{ .stablecoinID }}",
+					"txID": "{{ .txID }}",
+					"vout": 0,
+					"createdAt": "{{ matchTimestamp }}"
+				}
+			],
+			"page": {
+			    "number": 1,
+			    "size": 1,
+			    "totalElements": 1,
+			    "totalPages": 1
+			}
+		}`, map[string]any{
+			"stablecoinID": testStablecoinID,
+			"txID":         txSpec.ID(),
+		})
+	})
+
+	t.Run("return balance of the received token", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(recipient)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/stablecoins/balances")
+
+		// then:
+		then.Response(res).IsOK().WithJSONf(`[
+			{
+				"stablecoinId": "%s",
+				"amount": 100,
+				"banknotes": 1
+			}
+		]`, testStablecoinID)
+	})
+
+	t.Run("don't count the token output into the balance of satoshis", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForGivenUser(recipient)
+
+		// when:
+		res, _ := client.R().Get("/api/v2/users/current")
+
+		// then:
+		then.Response(res).IsOK().WithJSONMatching(`{
+			"currentBalance": 0,
+			"confirmedBalance": 0,
+			"requiredConfirmations": 6
+		}`, nil)
+	})
+}
@@ -0,0 +1,48 @@
+package mapping
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/banknotes/banknotesmodels"
+	"github.com/bitcoin-sv/spv-wallet/lox"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/samber/lo"
+)
+
+// StablecoinBanknotesPagedResponse maps a paged result of banknotes to a response.
+func StablecoinBanknotesPagedResponse(banknotes *models.PagedResult[banknotesmodels.Banknote]) api.ModelsStablecoinBanknotesSearchResult {
+	return api.ModelsStablecoinBanknotesSearchResult{
+		Page: api.ModelsSearchPage{
+			Size:          banknotes.PageDescription.Size,
+			Number:        banknotes.PageDescription.Number,
+			TotalElements: banknotes.PageDescription.TotalElements,
+			TotalPages:    banknotes.PageDescription.TotalPages,
+		},
+		Content: lo.Map(banknotes.Content, lox.MappingFn(StablecoinBanknoteResponse)),
+	}
+}
+
+// StablecoinBanknoteResponse maps a banknote to a response.
+func StablecoinBanknoteResponse(banknote *banknotesmodels.Banknote) api.ModelsStablecoinBanknote {
+	return api.ModelsStablecoinBanknote{
+		Serial:       banknote.Serial,
+		Amount:       banknote.Amount,
+		StablecoinId: banknote.StablecoinID,
+		TxID:         banknote.TxID,
+		Vout:         banknote.Vout,
+		CreatedAt:    banknote.CreatedAt,
+	}
+}
+
+// StablecoinBalancesResponse maps the balances of the stablecoins to a response.
+func StablecoinBalancesResponse(balances []*banknotesmodels.Balance) api.ModelsStablecoinBalances {
+	return lo.Map(balances, lox.MappingFn(StablecoinBalanceResponse))
+}
+
+// StablecoinBalanceResponse maps a balance of the stablecoin to a response.
+func StablecoinBalanceResponse(balance *banknotesmodels.Balance) api.ModelsStablecoinBalance {
+	return api.ModelsStablecoinBalance{
+		StablecoinId: balance.StablecoinID,
+		Amount:       balance.Amount,
+		Banknotes:    balance.Banknotes,
+	}
+}
@@ -0,0 +1,22 @@
+package stablecoins
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	"github.com/rs/zerolog"
+)
+
+// APIStablecoins represents server with API endpoints
+type APIStablecoins struct {
+	engine engine.ClientInterface
+	logger *zerolog.Logger
+}
+
+// NewAPIStablecoins creates a new server with API endpoints
+func NewAPIStablecoins(engine engine.ClientInterface, log *zerolog.Logger) APIStablecoins {
+	logger := log.With().Str("api", "stablecoins").Logger()
+
+	return APIStablecoins{
+		engine: engine,
+		logger: &logger,
+	}
+}
@@ -29,6 +29,7 @@ func RequestsTransactionOutlineToOutline(req *api.RequestsTransactionOutline) (*
 					}),
 				).Else(nil),
 		},
+		ReservationID: lo.FromPtr(req.ReservationID),
 	}, errorCollector.Error()
 }
 
@@ -20,6 +20,8 @@ func TransactionSpecificationRequestToOutline(tx *api.RequestsTransactionSpecifi
 
 	return &outlines.TransactionSpec{
 		UserID: userID,
+		Inputs: inputsSpecFromRequest(tx.Inputs),
+		Change: changeSpecFromRequest(tx.Change),
 		Outputs: outlines.OutputsSpec{
 			Outputs: lo.Map(
 				tx.Outputs,
@@ -44,6 +46,7 @@ func TransactionOutlineToResponse(tx *outlines.Transaction) (api.ModelsAnnotated
 				}),
 			Outputs: lo.MapEntries(tx.Annotations.Outputs, outlineOutputEntryToResponse),
 		},
+		ReservationID: lo.EmptyableToPtr(tx.ReservationID),
 	}, errorCollector.Error()
 }
 
@@ -102,6 +105,41 @@ func outlineOutputToResponse(from *transaction.OutputAnnotation) api.ModelsOutpu
 	}
 }
 
+func inputsSpecFromRequest(req *api.RequestsTransactionOutlineInputsSpecification) outlines.InputsSpec {
+	if req == nil {
+		return outlines.InputsSpec{}
+	}
+
+	return outlines.InputsSpec{
+		Outpoints:       outpointsFromRequest(req.Outpoints),
+		SelectRemaining: lo.FromPtr(req.SelectRemaining),
+		Exclude:         outpointsFromRequest(req.Exclude),
+		Strategy:        outlines.UTXOSelectionStrategy(lo.FromPtr(req.Strategy)),
+	}
+}
+
+func changeSpecFromRequest(req *api.RequestsTransactionOutlineChangeSpecification) outlines.ChangeSpec {
+	if req == nil {
+		return outlines.ChangeSpec{}
+	}
+
+	return outlines.ChangeSpec{
+		Disabled:        lo.FromPtr(req.Disabled),
+		Outputs:         lo.FromPtr(req.Outputs),
+		Strategy:        outlines.ChangeStrategy(lo.FromPtr(req.Strategy)),
+		MinimumSatoshis: bsv.Satoshis(lo.FromPtr(req.MinimumSatoshis)),
+	}
+}
+
+func outpointsFromRequest(req *[]api.RequestsOutpoint) []bsv.Outpoint {
+	return lo.Map(lo.FromPtr(req), func(outpoint api.RequestsOutpoint, _ int) bsv.Outpoint {
+		return bsv.Outpoint{
+			TxID: outpoint.TxID,
+			Vout: outpoint.Vout,
+		}
+	})
+}
+
 func outputSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
 	outputType, err := req.Discriminator()
 	if err != nil {
@@ -113,6 +151,12 @@ func outputSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification
 		return opReturnSpecFromRequest(req)
 	case "paymail":
 		return paymailSpecFromRequest(req)
+	case "sweep":
+		return sweepSpecFromRequest(req)
+	case "address":
+		return addressSpecFromRequest(req)
+	case "script":
+		return scriptSpecFromRequest(req)
 	default:
 		return nil, spverrors.ErrCannotBindRequest.Wrap(spverrors.Newf("unsupported output type"))
 	}
@@ -132,6 +176,44 @@ func paymailSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecificatio
 	}, nil
 }
 
+func sweepSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsSweepOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.Sweep{
+		To:          specification.To,
+		From:        specification.From,
+		MinSatoshis: bsv.Satoshis(lo.FromPtr(specification.MinSatoshis)),
+		MaxSatoshis: bsv.Satoshis(lo.FromPtr(specification.MaxSatoshis)),
+	}, nil
+}
+
+func addressSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsAddressOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.Address{
+		To:       specification.To,
+		Satoshis: bsv.Satoshis(specification.Satoshis),
+	}, nil
+}
+
+func scriptSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
+	specification, err := req.AsRequestsScriptOutputSpecification()
+	if err != nil {
+		return nil, spverrors.ErrCannotBindRequest.Wrap(err)
+	}
+
+	return &outlines.Script{
+		Script:   specification.Script,
+		Satoshis: bsv.Satoshis(lo.FromPtr(specification.Satoshis)),
+	}, nil
+}
+
 func opReturnSpecFromRequest(req api.RequestsTransactionOutlineOutputSpecification) (outlines.OutputSpec, error) {
 	specification, err := req.AsRequestsOpReturnOutputSpecification()
 	if err != nil {
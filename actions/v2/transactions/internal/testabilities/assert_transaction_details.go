@@ -14,6 +14,7 @@ import (
 
 type TransactionDetailsAssertions interface {
 	WithOutputValues(values ...bsv.Satoshis) TransactionDetailsAssertions
+	WithInputOutpoints(outpoints ...bsv.Outpoint) TransactionDetailsAssertions
 	OutputUnlockableBy(vout uint32, user fixtures.User) TransactionDetailsAssertions
 }
 
@@ -34,6 +35,16 @@ func (a *transactionAssertions) WithOutputValues(values ...bsv.Satoshis) Transac
 	return a
 }
 
+func (a *transactionAssertions) WithInputOutpoints(outpoints ...bsv.Outpoint) TransactionDetailsAssertions {
+	a.t.Helper()
+	a.require.Lenf(a.tx.Inputs, len(outpoints), "Tx has different number of inputs then expected outpoints")
+	for i, outpoint := range outpoints {
+		a.assert.Equal(outpoint.TxID, a.tx.Inputs[i].SourceTXID.String(), "input source transaction ID mismatch")
+		a.assert.Equal(outpoint.Vout, a.tx.Inputs[i].SourceTxOutIndex, "input source output index mismatch")
+	}
+	return a
+}
+
 func (a *transactionAssertions) OutputUnlockableBy(vout uint32, user fixtures.User) TransactionDetailsAssertions {
 	a.t.Helper()
 	a.assert.Less(vout, len(a.tx.Outputs), "there is no vout to unlock in transaction outputs")
@@ -0,0 +1,72 @@
+package transactions_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/transactions/internal/testabilities"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+func TestPOSTTransactionOutlinesWithChange(t *testing.T) {
+	tests := map[string]struct {
+		change         string
+		expectedValues []bsv.Satoshis
+	}{
+		"split change into multiple outputs": {
+			change: `{
+			  "outputs": 2
+			}`,
+			expectedValues: []bsv.Satoshis{0, 499, 500},
+		},
+		"fold change lower than minimum into the fee": {
+			change: `{
+			  "minimumSatoshis": 1000
+			}`,
+			expectedValues: []bsv.Satoshis{0},
+		},
+		"suppress change": {
+			change: `{
+			  "disabled": true
+			}`,
+			expectedValues: []bsv.Satoshis{0},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, then := testabilities.New(t)
+			cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+			defer cleanup()
+
+			// and:
+			given.Faucet(fixtures.Sender).TopUp(1000)
+
+			// and:
+			client := given.HttpClient().ForUser()
+
+			// when:
+			res, _ := client.R().
+				SetHeader("Content-Type", "application/json").
+				SetBody(`{
+				  "change": ` + test.change + `,
+				  "outputs": [
+					{
+					  "type": "op_return",
+					  "data": [ "some data" ]
+					}
+				  ]
+				}`).
+				Post(transactionsOutlinesURL)
+
+			// then:
+			thenResponse := then.Response(res)
+
+			thenResponse.IsOK()
+
+			thenResponse.ContainsValidTransaction("BEEF").
+				WithOutputValues(test.expectedValues...)
+		})
+	}
+}
@@ -33,6 +33,7 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			responseTemplate: `{
 			  "hex": "{{ matchTxByFormat .Format }}",
 			  "format": "{{ .Format }}",
+			  "reservationID": "{{ matchUUID }}",
 			  "annotations": {
 				"outputs": {
 					"0": {
@@ -70,6 +71,7 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			responseTemplate: `{
 			  "hex": "{{ matchTxByFormat .Format }}",
 			  "format": "{{ .Format }}",
+			  "reservationID": "{{ matchUUID }}",
 			  "annotations": {
 				"outputs": {
 					"0": {
@@ -107,6 +109,7 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			responseTemplate: `{
 			  "hex": "{{ matchTxByFormat .Format }}",
 			  "format": "{{ .Format }}",
+			  "reservationID": "{{ matchUUID }}",
 			  "annotations": {
 				"outputs": {
 					"0": {
@@ -144,6 +147,7 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			responseTemplate: `{
 			  "hex": "{{ matchTxByFormat .Format }}",
 			  "format": "{{ .Format }}",
+			  "reservationID": "{{ matchUUID }}",
 			  "annotations": {
 				"outputs": {
 				  "0": {
@@ -187,6 +191,7 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			responseTemplate: `{
 			  "hex": "{{ matchTxByFormat .Format }}",
 			  "format": "{{ .Format }}",
+			  "reservationID": "{{ matchUUID }}",
 			  "annotations": {
 				"outputs": {
 				  "0": {
@@ -240,6 +245,7 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			responseTemplate: `{
 			  "hex": "{{ matchTxByFormat .Format }}",
 			  "format": "{{ .Format }}",
+			  "reservationID": "{{ matchUUID }}",
 			  "annotations": {
 				"outputs": {
 				  "0": {
@@ -289,6 +295,7 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			responseTemplate: `{
 			  "hex": "{{ matchTxByFormat .Format }}",
 			  "format": "{{ .Format }}",
+			  "reservationID": "{{ matchUUID }}",
 			  "annotations": {
 				"outputs": {
 				  "0": {
@@ -338,6 +345,7 @@ func TestPOSTTransactionOutlines(t *testing.T) {
 			responseTemplate: `{
 			  "hex": "{{ matchTxByFormat .Format }}",
 			  "format": "{{ .Format }}",
+			  "reservationID": "{{ matchUUID }}",
 			  "annotations": {
 				"outputs": {
 				  "0": {
@@ -671,6 +679,32 @@ func TestPOSTTransactionOutlinesErrors(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedErr:    apierror.ExpectedJSON("error-paymail-address-invalid-sender", "sender paymail address is invalid"),
 		},
+		"Bad Request: Address output with invalid address": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "address",
+				  "to": "invalid address",
+				  "satoshis": 1
+				}
+			  ]
+			}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-address-invalid", "invalid P2PKH address of the output"),
+		},
+		"Bad Request: Script output without script": {
+			json: `{
+			  "outputs": [
+				{
+				  "type": "script",
+				  "script": "",
+				  "satoshis": 1
+				}
+			  ]
+			}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-script-required", "locking script is required for script output"),
+		},
 		"Unprocessable: User has not enough funds": {
 			json: `{
 			  "outputs": [
@@ -0,0 +1,288 @@
+package transactions_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/transactions/internal/testabilities"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+func TestPOSTTransactionOutlinesWithInputs(t *testing.T) {
+	t.Run("spend only the provided outpoint", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		given.Faucet(fixtures.Sender).TopUp(1000)
+		pinned := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(2000).ID(), Vout: 0}
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(fmt.Sprintf(`{
+			  "inputs": {
+			    "outpoints": [ { "txID": "%s", "vout": %d } ]
+			  },
+			  "outputs": [
+				{
+				  "type": "op_return",
+				  "data": [ "some data" ]
+				}
+			  ]
+			}`, pinned.TxID, pinned.Vout)).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse := then.Response(res)
+
+		thenResponse.IsOK()
+
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithInputOutpoints(pinned).
+			WithOutputValues(0, 1999)
+	})
+
+	t.Run("spend the provided outpoint and select the remaining inputs", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		selectable := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(1000).ID(), Vout: 0}
+		pinned := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(500).ID(), Vout: 0}
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(fmt.Sprintf(`{
+			  "inputs": {
+			    "outpoints": [ { "txID": "%s", "vout": %d } ],
+			    "selectRemaining": true
+			  },
+			  "outputs": [
+				{
+				  "type": "paymail",
+				  "to": "%s",
+				  "satoshis": 1200
+				}
+			  ]
+			}`, pinned.TxID, pinned.Vout, fixtures.RecipientExternal.DefaultPaymail())).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse := then.Response(res)
+
+		thenResponse.IsOK()
+
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithInputOutpoints(pinned, selectable).
+			WithOutputValues(1200, 299)
+	})
+
+	t.Run("select inputs without the excluded outpoint", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		excluded := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(1000).ID(), Vout: 0}
+		selectable := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(2000).ID(), Vout: 0}
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(fmt.Sprintf(`{
+			  "inputs": {
+			    "exclude": [ { "txID": "%s", "vout": %d } ]
+			  },
+			  "outputs": [
+				{
+				  "type": "op_return",
+				  "data": [ "some data" ]
+				}
+			  ]
+			}`, excluded.TxID, excluded.Vout)).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse := then.Response(res)
+
+		thenResponse.IsOK()
+
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithInputOutpoints(selectable).
+			WithOutputValues(0, 1999)
+	})
+
+	t.Run("select inputs with requested strategy", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		given.Faucet(fixtures.Sender).TopUp(1000)
+		largest := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(2000).ID(), Vout: 0}
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(`{
+			  "inputs": {
+			    "strategy": "largest_first"
+			  },
+			  "outputs": [
+				{
+				  "type": "op_return",
+				  "data": [ "some data" ]
+				}
+			  ]
+			}`).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse := then.Response(res)
+
+		thenResponse.IsOK()
+
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithInputOutpoints(largest).
+			WithOutputValues(0, 1999)
+	})
+
+	t.Run("Unprocessable: provided outpoint doesn't cover the outputs", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		given.Faucet(fixtures.Sender).TopUp(2000)
+		pinned := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(500).ID(), Vout: 0}
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(fmt.Sprintf(`{
+			  "inputs": {
+			    "outpoints": [ { "txID": "%s", "vout": %d } ]
+			  },
+			  "outputs": [
+				{
+				  "type": "paymail",
+				  "to": "%s",
+				  "satoshis": 1200
+				}
+			  ]
+			}`, pinned.TxID, pinned.Vout, fixtures.RecipientExternal.DefaultPaymail())).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(http.StatusUnprocessableEntity).
+			WithJSONf(apierror.ExpectedJSON("tx-outline-not-enough-funds", "not enough funds to make the transaction"))
+	})
+}
+
+func TestPOSTTransactionOutlinesWithInputsErrors(t *testing.T) {
+	notOwnedOutpoint := bsv.Outpoint{TxID: "bb8593f85ef8056a77026ad415f02128f3768906de53e9e8bf8749fe2d66cf50", Vout: 0}
+
+	badRequestTestCases := map[string]struct {
+		json           string
+		expectedStatus int
+		expectedErr    string
+	}{
+		"Bad Request: outpoint not owned by the user": {
+			json: fmt.Sprintf(`{
+			  "inputs": {
+			    "outpoints": [ { "txID": "%s", "vout": %d } ]
+			  },
+			  "outputs": [
+				{
+				  "type": "op_return",
+				  "data": [ "some data" ]
+				}
+			  ]
+			}`, notOwnedOutpoint.TxID, notOwnedOutpoint.Vout),
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-input-unavailable", "provided outpoint is not an unspent output of the user"),
+		},
+		"Bad Request: outpoint both included and excluded": {
+			json: fmt.Sprintf(`{
+			  "inputs": {
+			    "outpoints": [ { "txID": "%[1]s", "vout": %[2]d } ],
+			    "exclude": [ { "txID": "%[1]s", "vout": %[2]d } ]
+			  },
+			  "outputs": [
+				{
+				  "type": "op_return",
+				  "data": [ "some data" ]
+				}
+			  ]
+			}`, notOwnedOutpoint.TxID, notOwnedOutpoint.Vout),
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-input-included-and-excluded", "outpoint cannot be both included and excluded in inputs specification"),
+		},
+		"Bad Request: unsupported selection strategy": {
+			json: `{
+			  "inputs": {
+			    "strategy": "first_come_first_served"
+			  },
+			  "outputs": [
+				{
+				  "type": "op_return",
+				  "data": [ "some data" ]
+				}
+			  ]
+			}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    apierror.ExpectedJSON("tx-outline-inputs-unsupported-strategy", "unsupported UTXO selection strategy"),
+		},
+	}
+	for name, test := range badRequestTestCases {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, then := testabilities.New(t)
+			cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+			defer cleanup()
+
+			// and:
+			client := given.HttpClient().ForUser()
+
+			// when:
+			res, _ := client.R().
+				SetHeader("Content-Type", "application/json").
+				SetBody(test.json).
+				Post(transactionsOutlinesURL)
+
+			// then:
+			then.Response(res).
+				HasStatus(test.expectedStatus).
+				WithJSONf(test.expectedErr)
+		})
+	}
+}
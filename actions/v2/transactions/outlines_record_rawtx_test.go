@@ -0,0 +1,134 @@
+package transactions_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestOutlinesRecordRawTx(t *testing.T) {
+	t.Run("Record raw tx spending stored transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		ownedTransaction := given.Faucet(fixtures.Sender).TopUp(1000)
+
+		// and:
+		txSpec := given.Tx().
+			WithSender(fixtures.Sender).
+			WithInputFromUTXO(ownedTransaction.TX(), 0).
+			WithOPReturn(dataOfOpReturnTx)
+
+		// and:
+		given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(`{
+				"hex": "` + txSpec.RawTX() + `",
+				"annotations": {
+					"outputs": {
+						"0": {
+							"bucket": "data"
+						}
+					}
+				}
+			}`).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(201).
+			WithJSONMatching(`{
+				"txID": "{{ .txID }}"
+			}`, map[string]any{
+				"txID": txSpec.ID(),
+			})
+	})
+
+	t.Run("Record raw tx spending transaction provided by source transactions getter", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		txSpec := givenTXWithOpReturn(t)
+
+		// and:
+		given.SourceTxs().WillProvide(txSpec.InputSourceTX(0))
+
+		// and:
+		given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(`{
+				"hex": "` + txSpec.RawTX() + `",
+				"annotations": {
+					"outputs": {
+						"0": {
+							"bucket": "data"
+						}
+					}
+				}
+			}`).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(201).
+			WithJSONMatching(`{
+				"txID": "{{ .txID }}"
+			}`, map[string]any{
+				"txID": txSpec.ID(),
+			})
+	})
+
+	t.Run("Record raw tx with unknown source transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		txSpec := givenTXWithOpReturn(t)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(`{
+				"hex": "` + txSpec.RawTX() + `",
+				"annotations": {
+					"outputs": {
+						"0": {
+							"bucket": "data"
+						}
+					}
+				}
+			}`).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(422).
+			WithJSONf(apierror.ExpectedJSON("error-source-transaction-not-found", "source transaction of the input was not found"))
+	})
+}
@@ -0,0 +1,27 @@
+package transactions
+
+import (
+	"net/http"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+)
+
+// CancelTransactionOutlineReservation releases the UTXOs reserved for a transaction outline
+func (s *APITransactions) CancelTransactionOutlineReservation(c *gin.Context, reservationID string) {
+	userCtx := reqctx.GetUserContext(c)
+	userID, err := userCtx.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	err = s.engine.TransactionOutlinesService().CancelReservation(c, userID, reservationID)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,175 @@
+package transactions_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/transactions/internal/testabilities"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/stretchr/testify/require"
+)
+
+const transactionsOutlinesReservationsURL = "/api/v2/transactions/outlines/reservations/"
+
+const opReturnOutlineRequest = `{
+  "outputs": [
+	{
+	  "type": "op_return",
+	  "data": [ "some data" ]
+	}
+  ]
+}`
+
+func TestTransactionOutlinesReservation(t *testing.T) {
+	t.Run("don't select inputs reserved for previous transaction outline", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		first := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(1000).ID(), Vout: 0}
+		second := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(1000).ID(), Vout: 0}
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(opReturnOutlineRequest).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse := then.Response(res)
+		thenResponse.IsOK()
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithInputOutpoints(first)
+
+		// when:
+		res, _ = client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(opReturnOutlineRequest).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse = then.Response(res)
+		thenResponse.IsOK()
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithInputOutpoints(second)
+	})
+
+	t.Run("select inputs again after cancelling the reservation", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		utxo := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(1000).ID(), Vout: 0}
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// and:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(opReturnOutlineRequest).
+			Post(transactionsOutlinesURL)
+
+		reservationID := then.Response(res).IsOK().JSONValue().GetString("reservationID")
+
+		// when:
+		res, _ = client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(opReturnOutlineRequest).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(http.StatusUnprocessableEntity).
+			WithJSONf(apierror.ExpectedJSON("tx-outline-not-enough-funds", "not enough funds to make the transaction"))
+
+		// when:
+		res, _ = client.R().
+			Delete(transactionsOutlinesReservationsURL + reservationID)
+
+		// then:
+		then.Response(res).HasStatus(http.StatusNoContent)
+
+		// when:
+		res, _ = client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(opReturnOutlineRequest).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse := then.Response(res)
+		thenResponse.IsOK()
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithInputOutpoints(utxo)
+	})
+
+	t.Run("release only user's reservation after recording the transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		spent := given.Faucet(fixtures.Sender).TopUp(1000)
+		senderReserved := bsv.Outpoint{TxID: given.Faucet(fixtures.Sender).TopUp(1000).ID(), Vout: 0}
+		recipientReserved := bsv.Outpoint{TxID: given.Faucet(fixtures.RecipientInternal).TopUp(1000).ID(), Vout: 0}
+
+		// and:
+		const reservationID = "shared-reservation-id"
+		db := given.Engine().Datastore().DB()
+		err := db.Model(&database.UserUTXO{}).
+			Where("(tx_id, vout) in ?", [][]any{{senderReserved.TxID, senderReserved.Vout}, {recipientReserved.TxID, recipientReserved.Vout}}).
+			Updates(map[string]any{"reservation_id": reservationID, "reserved_until": time.Now().Add(time.Hour)}).
+			Error
+		require.NoError(t, err)
+
+		// and:
+		txSpec := given.Tx().
+			WithSender(fixtures.Sender).
+			WithInputFromUTXO(spent.TX(), 0).
+			WithOPReturn(dataOfOpReturnTx)
+
+		// and:
+		given.ARC().WillRespondForBroadcastWithSeenOnNetwork(txSpec.ID())
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{
+				"hex":           txSpec.BEEF(),
+				"reservationID": reservationID,
+				"annotations": map[string]any{
+					"outputs": map[string]any{
+						"0": map[string]any{"bucket": "data"},
+					},
+				},
+			}).
+			Post(transactionsOutlinesRecordURL)
+
+		// then:
+		then.Response(res).HasStatus(http.StatusCreated)
+
+		// and:
+		var utxos []*database.UserUTXO
+		err = db.Where("reservation_id = ?", reservationID).Find(&utxos).Error
+		require.NoError(t, err)
+		require.Len(t, utxos, 1)
+		require.Equal(t, fixtures.RecipientInternal.ID(), utxos[0].UserID)
+		require.Equal(t, recipientReserved.TxID, utxos[0].TxID)
+	})
+}
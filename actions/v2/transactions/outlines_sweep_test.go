@@ -0,0 +1,115 @@
+package transactions_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	"github.com/bitcoin-sv/spv-wallet/actions/v2/transactions/internal/testabilities"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+)
+
+func TestPOSTTransactionOutlinesWithSweep(t *testing.T) {
+	t.Run("sweep all funds to paymail", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		given.Faucet(fixtures.Sender).TopUp(1000)
+		given.Faucet(fixtures.Sender).TopUp(500)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(fmt.Sprintf(`{
+			  "outputs": [
+				{
+				  "type": "sweep",
+				  "to": "%s"
+				}
+			  ]
+			}`, fixtures.RecipientExternal.DefaultPaymail())).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse := then.Response(res)
+
+		thenResponse.IsOK()
+
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithOutputValues(1499)
+	})
+
+	t.Run("sweep funds left after other outputs to address", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		given.Faucet(fixtures.Sender).TopUp(1000)
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(fmt.Sprintf(`{
+			  "outputs": [
+				{
+				  "type": "op_return",
+				  "data": [ "some data" ]
+				},
+				{
+				  "type": "sweep",
+				  "to": "%s"
+				}
+			  ]
+			}`, fixtures.RecipientExternal.Address().AddressString)).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		thenResponse := then.Response(res)
+
+		thenResponse.IsOK()
+
+		thenResponse.ContainsValidTransaction("BEEF").
+			WithOutputValues(0, 999)
+	})
+
+	t.Run("Unprocessable: no funds to sweep", func(t *testing.T) {
+		// given:
+		given, then := testabilities.New(t)
+		cleanup := given.StartedSPVWalletWithConfiguration(testengine.WithV2())
+		defer cleanup()
+
+		// and:
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetHeader("Content-Type", "application/json").
+			SetBody(fmt.Sprintf(`{
+			  "outputs": [
+				{
+				  "type": "sweep",
+				  "to": "%s"
+				}
+			  ]
+			}`, fixtures.RecipientExternal.Address().AddressString)).
+			Post(transactionsOutlinesURL)
+
+		// then:
+		then.Response(res).
+			HasStatus(http.StatusUnprocessableEntity).
+			WithJSONf(apierror.ExpectedJSON("tx-outline-not-enough-funds", "not enough funds to make the transaction"))
+	})
+}
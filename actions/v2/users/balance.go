@@ -0,0 +1,79 @@
+package users
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/api"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/users/usersmodels"
+	"github.com/bitcoin-sv/spv-wallet/server/reqctx"
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+)
+
+// CurrentUserBalance returns the balance of the current user as of the given time or block height, optionally with the daily balance history
+func (s *APIUsers) CurrentUserBalance(c *gin.Context, params api.CurrentUserBalanceParams) {
+	userContext := reqctx.GetUserContext(c)
+	userID, err := userContext.ShouldGetUserID()
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+
+	if params.At != nil && params.BlockHeight != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrBalancePointAmbiguous, s.logger)
+		return
+	}
+	if params.HistoryFrom == nil && params.HistoryTo != nil {
+		spverrors.ErrorResponse(c, spverrors.ErrBalanceHistoryRangeInvalid, s.logger)
+		return
+	}
+
+	usersService := s.engine.UsersService()
+	ctx := c.Request.Context()
+	now := time.Now()
+
+	response := api.ModelsUserBalance{}
+	var balance usersmodels.Balance
+	if params.BlockHeight != nil {
+		response.BlockHeight = params.BlockHeight
+		balance, err = usersService.GetBalanceAtBlockHeight(ctx, userID, *params.BlockHeight)
+	} else {
+		response.At = lo.ToPtr(lo.FromPtrOr(params.At, now))
+		balance, err = usersService.GetBalanceAt(ctx, userID, *response.At)
+	}
+	if err != nil {
+		spverrors.ErrorResponse(c, err, s.logger)
+		return
+	}
+	response.Total = balance.Total()
+	response.Confirmed = balance.Confirmed
+	response.Unconfirmed = balance.Unconfirmed
+
+	if params.HistoryFrom != nil {
+		historyTo := now
+		if params.HistoryTo != nil {
+			historyTo = params.HistoryTo.Time
+		}
+
+		dailyBalances, err := usersService.GetDailyBalances(ctx, userID, params.HistoryFrom.Time, historyTo)
+		if err != nil {
+			spverrors.ErrorResponse(c, err, s.logger)
+			return
+		}
+		response.History = lo.ToPtr(lo.Map(dailyBalances, mapToDailyBalanceResponse))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func mapToDailyBalanceResponse(dailyBalance *usersmodels.DailyBalance, _ int) api.ModelsDailyBalance {
+	return api.ModelsDailyBalance{
+		Date:        openapi_types.Date{Time: dailyBalance.Date},
+		Total:       dailyBalance.Total(),
+		Confirmed:   dailyBalance.Confirmed,
+		Unconfirmed: dailyBalance.Unconfirmed,
+	}
+}
@@ -0,0 +1,208 @@
+package users_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/actions/testabilities/apierror"
+	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrentUserBalance(t *testing.T) {
+	givenForAllTests := testabilities.Given(t)
+	cleanup := givenForAllTests.StartedSPVWalletWithConfiguration(
+		testengine.WithV2(),
+	)
+	defer cleanup()
+
+	// and:
+	minedTx := givenForAllTests.Faucet(fixtures.Sender).TopUp(1000)
+	notMinedTx := givenForAllTests.Faucet(fixtures.Sender).TopUp(200)
+
+	// and:
+	db := givenForAllTests.Engine().Datastore().DB()
+	err := db.Model(&database.TrackedTransaction{}).Where("id = ?", minedTx.ID()).Update("block_height", 885800).Error
+	require.NoError(t, err)
+	err = db.Model(&database.TrackedTransaction{}).Where("id = ?", notMinedTx.ID()).Update("tx_status", txmodels.TxStatusBroadcasted).Error
+	require.NoError(t, err)
+
+	// and: operations created at a fixed time, so the daily history doesn't depend on the time of the test run
+	err = db.Model(&database.Operation{}).Where("user_id = ?", fixtures.Sender.ID()).Update("created_at", time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)).Error
+	require.NoError(t, err)
+
+	t.Run("return current balance", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/users/current/balance")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"at": "{{ matchTimestamp }}",
+				"total": 1200,
+				"confirmed": 1000,
+				"unconfirmed": 200
+			}`, nil)
+	})
+
+	t.Run("return balance at time before any operation", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("at", "2020-01-01T00:00:00Z").
+			Get("/api/v2/users/current/balance")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"at": "2020-01-01T00:00:00Z",
+				"total": 0,
+				"confirmed": 0,
+				"unconfirmed": 0
+			}`, nil)
+	})
+
+	t.Run("return balance at block height of the mined transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("blockHeight", "885803").
+			Get("/api/v2/users/current/balance")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"blockHeight": 885803,
+				"total": 1000,
+				"confirmed": 1000,
+				"unconfirmed": 0
+			}`, nil)
+	})
+
+	t.Run("return balance at block height below the mined transaction", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("blockHeight", "885799").
+			Get("/api/v2/users/current/balance")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"blockHeight": 885799,
+				"total": 0,
+				"confirmed": 0,
+				"unconfirmed": 0
+			}`, nil)
+	})
+
+	t.Run("return balance with daily history", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("at", "2025-03-11T00:00:00Z").
+			SetQueryParam("historyFrom", "2025-03-09").
+			SetQueryParam("historyTo", "2025-03-11").
+			Get("/api/v2/users/current/balance")
+
+		// then:
+		then.Response(res).
+			IsOK().
+			WithJSONMatching(`{
+				"at": "2025-03-11T00:00:00Z",
+				"total": 1200,
+				"confirmed": 1000,
+				"unconfirmed": 200,
+				"history": [
+					{
+						"date": "2025-03-09",
+						"total": 0,
+						"confirmed": 0,
+						"unconfirmed": 0
+					},
+					{
+						"date": "2025-03-10",
+						"total": 1200,
+						"confirmed": 1000,
+						"unconfirmed": 200
+					},
+					{
+						"date": "2025-03-11",
+						"total": 1200,
+						"confirmed": 1000,
+						"unconfirmed": 200
+					}
+				]
+			}`, nil)
+	})
+
+	t.Run("try to get balance at both time and block height", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("at", "2020-01-01T00:00:00Z").
+			SetQueryParam("blockHeight", "885803").
+			Get("/api/v2/users/current/balance")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(apierror.ExpectedJSON("error-balance-point-ambiguous", "only one of at and blockHeight can be given"))
+	})
+
+	t.Run("try to get balance history with reversed range", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForUser()
+
+		// when:
+		res, _ := client.R().
+			SetQueryParam("historyFrom", "2024-03-01").
+			SetQueryParam("historyTo", "2024-02-01").
+			Get("/api/v2/users/current/balance")
+
+		// then:
+		then.Response(res).
+			HasStatus(400).
+			WithJSONf(apierror.ExpectedJSON("error-balance-history-range-invalid", "invalid balance history range, historyFrom is required, cannot be after historyTo and the range cannot exceed 366 days"))
+	})
+
+	t.Run("try to get balance for admin", func(t *testing.T) {
+		// given:
+		given, then := testabilities.NewOf(givenForAllTests, t)
+		client := given.HttpClient().ForAdmin()
+
+		// when:
+		res, _ := client.R().Get("/api/v2/users/current/balance")
+
+		// then:
+		then.Response(res).IsUnauthorizedForAdmin()
+	})
+}
@@ -18,13 +18,23 @@ func (s *APIUsers) CurrentUser(c *gin.Context) {
 		return
 	}
 
-	satoshis, err := reqctx.Engine(c).UsersService().GetBalance(c.Request.Context(), userID)
+	usersService := reqctx.Engine(c).UsersService()
+
+	satoshis, err := usersService.GetBalance(c.Request.Context(), userID)
+	if err != nil {
+		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
+		return
+	}
+
+	confirmedSatoshis, err := usersService.GetConfirmedBalance(c.Request.Context(), userID)
 	if err != nil {
 		spverrors.ErrorResponse(c, err, reqctx.Logger(c))
 		return
 	}
 
 	c.JSON(http.StatusOK, &api.ModelsUserInfo{
-		CurrentBalance: uint64(satoshis),
+		CurrentBalance:        uint64(satoshis),
+		ConfirmedBalance:      uint64(confirmedSatoshis),
+		RequiredConfirmations: usersService.ConfirmationDepth(),
 	})
 }
@@ -26,7 +26,9 @@ func TestUserCurrent(t *testing.T) {
 		then.Response(res).
 			IsOK().
 			WithJSONMatching(`{
-				"currentBalance": 0
+				"currentBalance": 0,
+				"confirmedBalance": 0,
+				"requiredConfirmations": 6
 			}`, nil)
 	})
 
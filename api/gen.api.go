@@ -13,18 +13,36 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Export operations history
+	// (GET /api/v2/admin/operations/export)
+	AdminExportOperations(c *gin.Context, params AdminExportOperationsParams)
 	// Get admin status
 	// (GET /api/v2/admin/status)
 	AdminStatus(c *gin.Context)
+	// Search users
+	// (GET /api/v2/admin/users)
+	SearchUsers(c *gin.Context, params SearchUsersParams)
 	// Create user
 	// (POST /api/v2/admin/users)
 	CreateUser(c *gin.Context)
 	// Get user by id
 	// (GET /api/v2/admin/users/{id})
 	UserById(c *gin.Context, id string)
+	// Deactivate user
+	// (POST /api/v2/admin/users/{id}/deactivate)
+	DeactivateUser(c *gin.Context, id string)
 	// Add paymails to user
 	// (POST /api/v2/admin/users/{id}/paymails)
 	AddPaymailToUser(c *gin.Context, id string)
+	// Delete paymail of user
+	// (DELETE /api/v2/admin/users/{id}/paymails/{paymailId})
+	DeleteUserPaymail(c *gin.Context, id string, paymailId uint)
+	// Update paymail of user
+	// (PATCH /api/v2/admin/users/{id}/paymails/{paymailId})
+	UpdateUserPaymail(c *gin.Context, id string, paymailId uint)
+	// Reactivate user
+	// (POST /api/v2/admin/users/{id}/reactivate)
+	ReactivateUser(c *gin.Context, id string)
 	// Get shared config
 	// (GET /api/v2/configs/shared)
 	SharedConfig(c *gin.Context)
@@ -34,18 +52,36 @@ type ServerInterface interface {
 	// Get Merkleroots
 	// (GET /api/v2/merkleroots)
 	MerkleRoots(c *gin.Context, params MerkleRootsParams)
+	// Export operations history for user
+	// (GET /api/v2/operations/export)
+	ExportOperations(c *gin.Context, params ExportOperationsParams)
 	// Get operations for user
 	// (GET /api/v2/operations/search)
 	SearchOperations(c *gin.Context, params SearchOperationsParams)
+	// Get operation for user
+	// (GET /api/v2/operations/{txID})
+	OperationByTxID(c *gin.Context, txid string, params OperationByTxIDParams)
+	// Get stablecoin balances for user
+	// (GET /api/v2/stablecoins/balances)
+	StablecoinBalances(c *gin.Context, params StablecoinBalancesParams)
+	// Get stablecoin banknotes for user
+	// (GET /api/v2/stablecoins/banknotes)
+	SearchStablecoinBanknotes(c *gin.Context, params SearchStablecoinBanknotesParams)
 	// Record transaction outline
 	// (POST /api/v2/transactions)
 	RecordTransactionOutline(c *gin.Context)
 	// Create transaction outline
 	// (POST /api/v2/transactions/outlines)
 	CreateTransactionOutline(c *gin.Context, params CreateTransactionOutlineParams)
+	// Cancel transaction outline reservation
+	// (DELETE /api/v2/transactions/outlines/reservations/{reservationID})
+	CancelTransactionOutlineReservation(c *gin.Context, reservationID string)
 	// Get current user
 	// (GET /api/v2/users/current)
 	CurrentUser(c *gin.Context)
+	// Get balance of current user
+	// (GET /api/v2/users/current/balance)
+	CurrentUserBalance(c *gin.Context, params CurrentUserBalanceParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -57,6 +93,58 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// AdminExportOperations operation middleware
+func (siw *ServerInterfaceWrapper) AdminExportOperations(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdminExportOperationsParams
+
+	// ------------- Optional query parameter "userId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userId", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AdminExportOperations(c, params)
+}
+
 // AdminStatus operation middleware
 func (siw *ServerInterfaceWrapper) AdminStatus(c *gin.Context) {
 
@@ -72,6 +160,98 @@ func (siw *ServerInterfaceWrapper) AdminStatus(c *gin.Context) {
 	siw.Handler.AdminStatus(c)
 }
 
+// SearchUsers operation middleware
+func (siw *ServerInterfaceWrapper) SearchUsers(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchUsersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "publicKey" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "publicKey", c.Request.URL.Query(), &params.PublicKey)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter publicKey: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "paymail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paymail", c.Request.URL.Query(), &params.Paymail)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter paymail: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", c.Request.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdFrom: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", c.Request.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdTo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", c.Request.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter active: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SearchUsers(c, params)
+}
+
 // CreateUser operation middleware
 func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
 
@@ -113,6 +293,32 @@ func (siw *ServerInterfaceWrapper) UserById(c *gin.Context) {
 	siw.Handler.UserById(c, id)
 }
 
+// DeactivateUser operation middleware
+func (siw *ServerInterfaceWrapper) DeactivateUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeactivateUser(c, id)
+}
+
 // AddPaymailToUser operation middleware
 func (siw *ServerInterfaceWrapper) AddPaymailToUser(c *gin.Context) {
 
@@ -139,6 +345,102 @@ func (siw *ServerInterfaceWrapper) AddPaymailToUser(c *gin.Context) {
 	siw.Handler.AddPaymailToUser(c, id)
 }
 
+// DeleteUserPaymail operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserPaymail(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "paymailId" -------------
+	var paymailId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "paymailId", c.Param("paymailId"), &paymailId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter paymailId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUserPaymail(c, id, paymailId)
+}
+
+// UpdateUserPaymail operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserPaymail(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "paymailId" -------------
+	var paymailId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "paymailId", c.Param("paymailId"), &paymailId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter paymailId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateUserPaymail(c, id, paymailId)
+}
+
+// ReactivateUser operation middleware
+func (siw *ServerInterfaceWrapper) ReactivateUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReactivateUser(c, id)
+}
+
 // SharedConfig operation middleware
 func (siw *ServerInterfaceWrapper) SharedConfig(c *gin.Context) {
 
@@ -216,6 +518,50 @@ func (siw *ServerInterfaceWrapper) MerkleRoots(c *gin.Context) {
 	siw.Handler.MerkleRoots(c, params)
 }
 
+// ExportOperations operation middleware
+func (siw *ServerInterfaceWrapper) ExportOperations(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportOperationsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportOperations(c, params)
+}
+
 // SearchOperations operation middleware
 func (siw *ServerInterfaceWrapper) SearchOperations(c *gin.Context) {
 
@@ -258,6 +604,62 @@ func (siw *ServerInterfaceWrapper) SearchOperations(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "counterparty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "counterparty", c.Request.URL.Query(), &params.Counterparty)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter counterparty: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minValue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minValue", c.Request.URL.Query(), &params.MinValue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minValue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "maxValue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxValue", c.Request.URL.Query(), &params.MaxValue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter maxValue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", c.Request.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdFrom: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", c.Request.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdTo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "txStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "txStatus", c.Request.URL.Query(), &params.TxStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter txStatus: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -268,6 +670,131 @@ func (siw *ServerInterfaceWrapper) SearchOperations(c *gin.Context) {
 	siw.Handler.SearchOperations(c, params)
 }
 
+// OperationByTxID operation middleware
+func (siw *ServerInterfaceWrapper) OperationByTxID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "txID" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txID", c.Param("txID"), &txid, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter txID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OperationByTxIDParams
+
+	// ------------- Optional query parameter "includeHex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeHex", c.Request.URL.Query(), &params.IncludeHex)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeHex: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OperationByTxID(c, txid, params)
+}
+
+// StablecoinBalances operation middleware
+func (siw *ServerInterfaceWrapper) StablecoinBalances(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StablecoinBalancesParams
+
+	// ------------- Optional query parameter "stablecoinId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stablecoinId", c.Request.URL.Query(), &params.StablecoinId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter stablecoinId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StablecoinBalances(c, params)
+}
+
+// SearchStablecoinBanknotes operation middleware
+func (siw *ServerInterfaceWrapper) SearchStablecoinBanknotes(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchStablecoinBanknotesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "stablecoinId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stablecoinId", c.Request.URL.Query(), &params.StablecoinId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter stablecoinId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SearchStablecoinBanknotes(c, params)
+}
+
 // RecordTransactionOutline operation middleware
 func (siw *ServerInterfaceWrapper) RecordTransactionOutline(c *gin.Context) {
 
@@ -311,6 +838,32 @@ func (siw *ServerInterfaceWrapper) CreateTransactionOutline(c *gin.Context) {
 	siw.Handler.CreateTransactionOutline(c, params)
 }
 
+// CancelTransactionOutlineReservation operation middleware
+func (siw *ServerInterfaceWrapper) CancelTransactionOutlineReservation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "reservationID" -------------
+	var reservationID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationID", c.Param("reservationID"), &reservationID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reservationID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CancelTransactionOutlineReservation(c, reservationID)
+}
+
 // CurrentUser operation middleware
 func (siw *ServerInterfaceWrapper) CurrentUser(c *gin.Context) {
 
@@ -326,6 +879,58 @@ func (siw *ServerInterfaceWrapper) CurrentUser(c *gin.Context) {
 	siw.Handler.CurrentUser(c)
 }
 
+// CurrentUserBalance operation middleware
+func (siw *ServerInterfaceWrapper) CurrentUserBalance(c *gin.Context) {
+
+	var err error
+
+	c.Set(XPubAuthScopes, []string{"user"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CurrentUserBalanceParams
+
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", c.Request.URL.Query(), &params.At)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter at: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "blockHeight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "blockHeight", c.Request.URL.Query(), &params.BlockHeight)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter blockHeight: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "historyFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "historyFrom", c.Request.URL.Query(), &params.HistoryFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter historyFrom: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "historyTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "historyTo", c.Request.URL.Query(), &params.HistoryTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter historyTo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CurrentUserBalance(c, params)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -353,15 +958,27 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/api/v2/admin/operations/export", wrapper.AdminExportOperations)
 	router.GET(options.BaseURL+"/api/v2/admin/status", wrapper.AdminStatus)
+	router.GET(options.BaseURL+"/api/v2/admin/users", wrapper.SearchUsers)
 	router.POST(options.BaseURL+"/api/v2/admin/users", wrapper.CreateUser)
 	router.GET(options.BaseURL+"/api/v2/admin/users/:id", wrapper.UserById)
+	router.POST(options.BaseURL+"/api/v2/admin/users/:id/deactivate", wrapper.DeactivateUser)
 	router.POST(options.BaseURL+"/api/v2/admin/users/:id/paymails", wrapper.AddPaymailToUser)
+	router.DELETE(options.BaseURL+"/api/v2/admin/users/:id/paymails/:paymailId", wrapper.DeleteUserPaymail)
+	router.PATCH(options.BaseURL+"/api/v2/admin/users/:id/paymails/:paymailId", wrapper.UpdateUserPaymail)
+	router.POST(options.BaseURL+"/api/v2/admin/users/:id/reactivate", wrapper.ReactivateUser)
 	router.GET(options.BaseURL+"/api/v2/configs/shared", wrapper.SharedConfig)
 	router.GET(options.BaseURL+"/api/v2/data/:id", wrapper.DataById)
 	router.GET(options.BaseURL+"/api/v2/merkleroots", wrapper.MerkleRoots)
+	router.GET(options.BaseURL+"/api/v2/operations/export", wrapper.ExportOperations)
 	router.GET(options.BaseURL+"/api/v2/operations/search", wrapper.SearchOperations)
+	router.GET(options.BaseURL+"/api/v2/operations/:txID", wrapper.OperationByTxID)
+	router.GET(options.BaseURL+"/api/v2/stablecoins/balances", wrapper.StablecoinBalances)
+	router.GET(options.BaseURL+"/api/v2/stablecoins/banknotes", wrapper.SearchStablecoinBanknotes)
 	router.POST(options.BaseURL+"/api/v2/transactions", wrapper.RecordTransactionOutline)
 	router.POST(options.BaseURL+"/api/v2/transactions/outlines", wrapper.CreateTransactionOutline)
+	router.DELETE(options.BaseURL+"/api/v2/transactions/outlines/reservations/:reservationID", wrapper.CancelTransactionOutlineReservation)
 	router.GET(options.BaseURL+"/api/v2/users/current", wrapper.CurrentUser)
+	router.GET(options.BaseURL+"/api/v2/users/current/balance", wrapper.CurrentUserBalance)
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -23,22 +24,37 @@ const (
 
 // Defines values for ModelsDataAnnotationBucket.
 const (
-	Data ModelsDataAnnotationBucket = "data"
+	ModelsDataAnnotationBucketData ModelsDataAnnotationBucket = "data"
 )
 
 // Defines values for ModelsOperationTxStatus.
 const (
-	BROADCASTED ModelsOperationTxStatus = "BROADCASTED"
-	CREATED     ModelsOperationTxStatus = "CREATED"
-	MINED       ModelsOperationTxStatus = "MINED"
-	PROBLEMATIC ModelsOperationTxStatus = "PROBLEMATIC"
-	REVERTED    ModelsOperationTxStatus = "REVERTED"
+	ModelsOperationTxStatusBROADCASTED ModelsOperationTxStatus = "BROADCASTED"
+	ModelsOperationTxStatusCREATED     ModelsOperationTxStatus = "CREATED"
+	ModelsOperationTxStatusMINED       ModelsOperationTxStatus = "MINED"
+	ModelsOperationTxStatusPROBLEMATIC ModelsOperationTxStatus = "PROBLEMATIC"
+	ModelsOperationTxStatusREVERTED    ModelsOperationTxStatus = "REVERTED"
 )
 
 // Defines values for ModelsOperationType.
 const (
-	Incoming ModelsOperationType = "incoming"
-	Outgoing ModelsOperationType = "outgoing"
+	ModelsOperationTypeIncoming ModelsOperationType = "incoming"
+	ModelsOperationTypeOutgoing ModelsOperationType = "outgoing"
+)
+
+// Defines values for ModelsOperationDetailsTxStatus.
+const (
+	ModelsOperationDetailsTxStatusBROADCASTED ModelsOperationDetailsTxStatus = "BROADCASTED"
+	ModelsOperationDetailsTxStatusCREATED     ModelsOperationDetailsTxStatus = "CREATED"
+	ModelsOperationDetailsTxStatusMINED       ModelsOperationDetailsTxStatus = "MINED"
+	ModelsOperationDetailsTxStatusPROBLEMATIC ModelsOperationDetailsTxStatus = "PROBLEMATIC"
+	ModelsOperationDetailsTxStatusREVERTED    ModelsOperationDetailsTxStatus = "REVERTED"
+)
+
+// Defines values for ModelsOperationDetailsType.
+const (
+	ModelsOperationDetailsTypeIncoming ModelsOperationDetailsType = "incoming"
+	ModelsOperationDetailsTypeOutgoing ModelsOperationDetailsType = "outgoing"
 )
 
 // Defines values for ModelsOutputAnnotationBucket.
@@ -57,6 +73,11 @@ const (
 	ModelsTransactionHexFormatRAW  ModelsTransactionHexFormat = "RAW"
 )
 
+// Defines values for RequestsAddressOutputSpecificationType.
+const (
+	Address RequestsAddressOutputSpecificationType = "address"
+)
+
 // Defines values for RequestsOpReturnOutputSpecificationDataType.
 const (
 	Hexes   RequestsOpReturnOutputSpecificationDataType = "hexes"
@@ -73,12 +94,71 @@ const (
 	Paymail RequestsPaymailOutputSpecificationType = "paymail"
 )
 
+// Defines values for RequestsScriptOutputSpecificationType.
+const (
+	Script RequestsScriptOutputSpecificationType = "script"
+)
+
+// Defines values for RequestsSweepOutputSpecificationType.
+const (
+	Sweep RequestsSweepOutputSpecificationType = "sweep"
+)
+
 // Defines values for RequestsTransactionOutlineFormat.
 const (
 	BEEF RequestsTransactionOutlineFormat = "BEEF"
 	RAW  RequestsTransactionOutlineFormat = "RAW"
 )
 
+// Defines values for RequestsTransactionOutlineChangeSpecificationStrategy.
+const (
+	RequestsTransactionOutlineChangeSpecificationStrategyEven   RequestsTransactionOutlineChangeSpecificationStrategy = "even"
+	RequestsTransactionOutlineChangeSpecificationStrategyRandom RequestsTransactionOutlineChangeSpecificationStrategy = "random"
+)
+
+// Defines values for RequestsTransactionOutlineInputsSpecificationStrategy.
+const (
+	RequestsTransactionOutlineInputsSpecificationStrategyBranchAndBound RequestsTransactionOutlineInputsSpecificationStrategy = "branch_and_bound"
+	RequestsTransactionOutlineInputsSpecificationStrategyLargestFirst   RequestsTransactionOutlineInputsSpecificationStrategy = "largest_first"
+	RequestsTransactionOutlineInputsSpecificationStrategyOldestFirst    RequestsTransactionOutlineInputsSpecificationStrategy = "oldest_first"
+	RequestsTransactionOutlineInputsSpecificationStrategyRandom         RequestsTransactionOutlineInputsSpecificationStrategy = "random"
+	RequestsTransactionOutlineInputsSpecificationStrategySmallestFirst  RequestsTransactionOutlineInputsSpecificationStrategy = "smallest_first"
+)
+
+// Defines values for RequestsExportFormat.
+const (
+	RequestsExportFormatCsv   RequestsExportFormat = "csv"
+	RequestsExportFormatJsonl RequestsExportFormat = "jsonl"
+)
+
+// Defines values for AdminExportOperationsParamsFormat.
+const (
+	AdminExportOperationsParamsFormatCsv   AdminExportOperationsParamsFormat = "csv"
+	AdminExportOperationsParamsFormatJsonl AdminExportOperationsParamsFormat = "jsonl"
+)
+
+// Defines values for ExportOperationsParamsFormat.
+const (
+	Csv   ExportOperationsParamsFormat = "csv"
+	Jsonl ExportOperationsParamsFormat = "jsonl"
+)
+
+// Defines values for SearchOperationsParamsType.
+const (
+	SearchOperationsParamsTypeData     SearchOperationsParamsType = "data"
+	SearchOperationsParamsTypeIncoming SearchOperationsParamsType = "incoming"
+	SearchOperationsParamsTypeOutgoing SearchOperationsParamsType = "outgoing"
+)
+
+// Defines values for SearchOperationsParamsTxStatus.
+const (
+	BROADCASTED SearchOperationsParamsTxStatus = "BROADCASTED"
+	CREATED     SearchOperationsParamsTxStatus = "CREATED"
+	MINED       SearchOperationsParamsTxStatus = "MINED"
+	PROBLEMATIC SearchOperationsParamsTxStatus = "PROBLEMATIC"
+	REVERTED    SearchOperationsParamsTxStatus = "REVERTED"
+)
+
 // Defines values for CreateTransactionOutlineParamsFormat.
 const (
 	Beef CreateTransactionOutlineParamsFormat = "beef"
@@ -161,12 +241,30 @@ type ErrorsBHSUnreachable struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsBalanceHistoryRangeInvalid defines model for errors_BalanceHistoryRangeInvalid.
+type ErrorsBalanceHistoryRangeInvalid struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsBalancePointAmbiguous defines model for errors_BalancePointAmbiguous.
+type ErrorsBalancePointAmbiguous struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsCannotBindRequest defines model for errors_CannotBindRequest.
 type ErrorsCannotBindRequest struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsCannotParseQueryParams defines model for errors_CannotParseQueryParams.
+type ErrorsCannotParseQueryParams struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsCreatingUser defines model for errors_CreatingUser.
 type ErrorsCreatingUser struct {
 	Code    interface{} `json:"code"`
@@ -179,12 +277,30 @@ type ErrorsDataNotFound struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsExportFormatInvalid defines model for errors_ExportFormatInvalid.
+type ErrorsExportFormatInvalid struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsExportTimeRangeInvalid defines model for errors_ExportTimeRangeInvalid.
+type ErrorsExportTimeRangeInvalid struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsGettingOutputs defines model for errors_GettingOutputs.
 type ErrorsGettingOutputs struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsGettingSourceTransactions defines model for errors_GettingSourceTransactions.
+type ErrorsGettingSourceTransactions struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsGettingUser defines model for errors_GettingUser.
 type ErrorsGettingUser struct {
 	Code    interface{} `json:"code"`
@@ -209,6 +325,12 @@ type ErrorsInvalidBatchSize struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidConditions defines model for errors_InvalidConditions.
+type ErrorsInvalidConditions struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidDataID defines model for errors_InvalidDataID.
 type ErrorsInvalidDataID struct {
 	Code    interface{} `json:"code"`
@@ -233,6 +355,12 @@ type ErrorsInvalidPubKey struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidTransactionID defines model for errors_InvalidTransactionID.
+type ErrorsInvalidTransactionID struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsMerkleRootNotFound defines model for errors_MerkleRootNotFound.
 type ErrorsMerkleRootNotFound struct {
 	Code    interface{} `json:"code"`
@@ -251,12 +379,24 @@ type ErrorsNoOperations struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsOperationNotFound defines model for errors_OperationNotFound.
+type ErrorsOperationNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsPaymailInconsistent defines model for errors_PaymailInconsistent.
 type ErrorsPaymailInconsistent struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsPaymailNotFound defines model for errors_PaymailNotFound.
+type ErrorsPaymailNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsSchema defines model for errors_Schema.
 type ErrorsSchema struct {
 	// Code Error code
@@ -266,12 +406,24 @@ type ErrorsSchema struct {
 	Message string `json:"message"`
 }
 
+// ErrorsSourceTransactionNotFound defines model for errors_SourceTransactionNotFound.
+type ErrorsSourceTransactionNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsTxBroadcast defines model for errors_TxBroadcast.
 type ErrorsTxBroadcast struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsTxOutlineReservationIDRequired defines model for errors_TxOutlineReservationIDRequired.
+type ErrorsTxOutlineReservationIDRequired struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsTxOutlineUserHasNotEnoughFunds defines model for errors_TxOutlineUserHasNotEnoughFunds.
 type ErrorsTxOutlineUserHasNotEnoughFunds struct {
 	Code    interface{} `json:"code"`
@@ -284,6 +436,12 @@ type ErrorsTxSpecFailedToDecodeHex struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsTxSpecInvalidAddress defines model for errors_TxSpecInvalidAddress.
+type ErrorsTxSpecInvalidAddress struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsTxSpecInvalidPaymailReceiver defines model for errors_TxSpecInvalidPaymailReceiver.
 type ErrorsTxSpecInvalidPaymailReceiver struct {
 	Code    interface{} `json:"code"`
@@ -314,6 +472,24 @@ type ErrorsTxSpecOutputsRequired struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsTxSpecScriptRequired defines model for errors_TxSpecScriptRequired.
+type ErrorsTxSpecScriptRequired struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsTxSpecScriptTooLarge defines model for errors_TxSpecScriptTooLarge.
+type ErrorsTxSpecScriptTooLarge struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsTxSpecUnsupportedAddressNetwork defines model for errors_TxSpecUnsupportedAddressNetwork.
+type ErrorsTxSpecUnsupportedAddressNetwork struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsUTXOSpent defines model for errors_UTXOSpent.
 type ErrorsUTXOSpent struct {
 	Code    interface{} `json:"code"`
@@ -337,6 +513,12 @@ type ErrorsUserAuthorization struct {
 	union json.RawMessage
 }
 
+// ErrorsUserNotFound defines model for errors_UserNotFound.
+type ErrorsUserNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ModelsAnnotatedTransactionOutline defines model for models_AnnotatedTransactionOutline.
 type ModelsAnnotatedTransactionOutline struct {
 	Annotations *ModelsOutlineAnnotations `json:"annotations,omitempty"`
@@ -346,6 +528,10 @@ type ModelsAnnotatedTransactionOutline struct {
 
 	// Hex Transaction hex
 	Hex string `json:"hex"`
+
+	// ReservationID ID of the reservation of the UTXOs selected as inputs of the transaction outline. <br>
+	// The reserved UTXOs are not selected for other transaction outlines until the outline is recorded, the reservation is cancelled or it expires.
+	ReservationID *string `json:"reservationID,omitempty"`
 }
 
 // ModelsAnnotatedTransactionOutlineFormat Transaction format
@@ -367,6 +553,16 @@ type ModelsCustomInstructions struct {
 	union json.RawMessage
 }
 
+// ModelsDailyBalance defines model for models_DailyBalance.
+type ModelsDailyBalance struct {
+	Confirmed int64 `json:"confirmed"`
+
+	// Date Day (UTC) at the end of which the balance is computed
+	Date        openapi_types.Date `json:"date"`
+	Total       int64              `json:"total"`
+	Unconfirmed int64              `json:"unconfirmed"`
+}
+
 // ModelsData defines model for models_Data.
 type ModelsData struct {
 	// Blob Data blob
@@ -430,6 +626,9 @@ type ModelsOperation struct {
 	// BlockHeight Block height of underlying transaction
 	BlockHeight *int64 `json:"blockHeight,omitempty"`
 
+	// Confirmations Number of confirmations of underlying transaction, counted up to the required number of confirmations
+	Confirmations *uint32 `json:"confirmations,omitempty"`
+
 	// Counterparty Counterparty of operation
 	Counterparty string `json:"counterparty"`
 
@@ -455,6 +654,67 @@ type ModelsOperationTxStatus string
 // ModelsOperationType Type of operation
 type ModelsOperationType string
 
+// ModelsOperationDetails defines model for models_OperationDetails.
+type ModelsOperationDetails struct {
+	// BlockHash Block hash of underlying transaction
+	BlockHash *string `json:"blockHash,omitempty"`
+
+	// BlockHeight Block height of underlying transaction
+	BlockHeight *int64 `json:"blockHeight,omitempty"`
+
+	// Confirmations Number of confirmations of underlying transaction, counted up to the required number of confirmations
+	Confirmations *uint32 `json:"confirmations,omitempty"`
+
+	// Counterparty Counterparty of operation
+	Counterparty string `json:"counterparty"`
+
+	// CreatedAt Creation date of operation
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data Data outputs of the transaction which belong to the user
+	Data []ModelsData `json:"data"`
+
+	// Fee Fee paid for the transaction; omitted when satoshis of some inputs are unknown
+	Fee *uint64 `json:"fee,omitempty"`
+
+	// Inputs Outputs of the user spent by the transaction
+	Inputs []ModelsOperationOutput `json:"inputs"`
+
+	// Outputs Outputs of the transaction received by the user
+	Outputs     []ModelsOperationOutput `json:"outputs"`
+	Transaction *ModelsTransactionHex   `json:"transaction,omitempty"`
+
+	// TxID Transaction ID
+	TxID string `json:"txID"`
+
+	// TxStatus Status of transaction
+	TxStatus ModelsOperationDetailsTxStatus `json:"txStatus"`
+
+	// Type Type of operation
+	Type ModelsOperationDetailsType `json:"type"`
+
+	// Value Value of operation
+	Value int64 `json:"value"`
+}
+
+// ModelsOperationDetailsTxStatus Status of transaction
+type ModelsOperationDetailsTxStatus string
+
+// ModelsOperationDetailsType Type of operation
+type ModelsOperationDetailsType string
+
+// ModelsOperationOutput defines model for models_OperationOutput.
+type ModelsOperationOutput struct {
+	// Satoshis Value of the output in satoshis
+	Satoshis uint64 `json:"satoshis"`
+
+	// TxID ID of the transaction containing the output
+	TxID string `json:"txID"`
+
+	// Vout Index of the output in the transaction
+	Vout uint32 `json:"vout"`
+}
+
 // ModelsOperationsSearchResult defines model for models_OperationsSearchResult.
 type ModelsOperationsSearchResult struct {
 	Content []ModelsOperation `json:"content"`
@@ -556,6 +816,48 @@ type ModelsSharedConfig struct {
 	PaymailDomains       []string        `json:"paymailDomains"`
 }
 
+// ModelsStablecoinBalance defines model for models_StablecoinBalance.
+type ModelsStablecoinBalance struct {
+	// Amount Sum of the unspent banknotes of the stablecoin
+	Amount uint64 `json:"amount"`
+
+	// Banknotes Number of the unspent banknotes of the stablecoin
+	Banknotes int `json:"banknotes"`
+
+	// StablecoinId Identifier of the stablecoin
+	StablecoinId string `json:"stablecoinId"`
+}
+
+// ModelsStablecoinBalances defines model for models_StablecoinBalances.
+type ModelsStablecoinBalances = []ModelsStablecoinBalance
+
+// ModelsStablecoinBanknote defines model for models_StablecoinBanknote.
+type ModelsStablecoinBanknote struct {
+	// Amount Amount of tokens in the banknote
+	Amount uint64 `json:"amount"`
+
+	// CreatedAt Time when the banknote was received
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Serial Serial number (token ID) of the banknote
+	Serial string `json:"serial"`
+
+	// StablecoinId Identifier of the stablecoin the banknote belongs to
+	StablecoinId string `json:"stablecoinId"`
+
+	// TxID ID of the transaction holding the banknote
+	TxID string `json:"txID"`
+
+	// Vout Index of the output holding the banknote
+	Vout uint32 `json:"vout"`
+}
+
+// ModelsStablecoinBanknotesSearchResult defines model for models_StablecoinBanknotesSearchResult.
+type ModelsStablecoinBanknotesSearchResult struct {
+	Content []ModelsStablecoinBanknote `json:"content"`
+	Page    ModelsSearchPage           `json:"page"`
+}
+
 // ModelsTransactionHex defines model for models_TransactionHex.
 type ModelsTransactionHex struct {
 	// Format Transaction format
@@ -570,11 +872,35 @@ type ModelsTransactionHexFormat string
 
 // ModelsUser defines model for models_User.
 type ModelsUser struct {
-	CreatedAt time.Time       `json:"createdAt"`
-	Id        string          `json:"id"`
-	Paymails  []ModelsPaymail `json:"paymails"`
-	PublicKey string          `json:"publicKey"`
-	UpdatedAt time.Time       `json:"updatedAt"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeactivatedAt Set when the user is deactivated; a deactivated user cannot authenticate and its paymails are not resolvable
+	DeactivatedAt *time.Time      `json:"deactivatedAt,omitempty"`
+	Id            string          `json:"id"`
+	Paymails      []ModelsPaymail `json:"paymails"`
+	PublicKey     string          `json:"publicKey"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+}
+
+// ModelsUserBalance defines model for models_UserBalance.
+type ModelsUserBalance struct {
+	// At Point in time of the balance; missing if the balance is computed at the block height
+	At *time.Time `json:"at,omitempty"`
+
+	// BlockHeight Block height of the balance; missing if the balance is computed at the point in time
+	BlockHeight *int64 `json:"blockHeight,omitempty"`
+
+	// Confirmed Part of the balance made of operations on mined transactions
+	Confirmed int64 `json:"confirmed"`
+
+	// History Balances at the end of each day of the requested history range
+	History *[]ModelsDailyBalance `json:"history,omitempty"`
+
+	// Total Balance of user made of all operations (except those on reverted transactions)
+	Total int64 `json:"total"`
+
+	// Unconfirmed Part of the balance made of operations on transactions which are not mined yet
+	Unconfirmed int64 `json:"unconfirmed"`
 }
 
 // ModelsUserDefinedCustomInstructions Instructions about how to unlock this input.
@@ -582,8 +908,20 @@ type ModelsUserDefinedCustomInstructions = string
 
 // ModelsUserInfo defines model for models_UserInfo.
 type ModelsUserInfo struct {
+	// ConfirmedBalance Balance of user made of transactions with at least the required number of confirmations
+	ConfirmedBalance uint64 `json:"confirmedBalance"`
+
 	// CurrentBalance Current balance of user
 	CurrentBalance uint64 `json:"currentBalance"`
+
+	// RequiredConfirmations Number of confirmations after which a transaction is counted into the confirmed balance
+	RequiredConfirmations uint32 `json:"requiredConfirmations"`
+}
+
+// ModelsUsersSearchResult defines model for models_UsersSearchResult.
+type ModelsUsersSearchResult struct {
+	Content []ModelsUser     `json:"content"`
+	Page    ModelsSearchPage `json:"page"`
 }
 
 // RequestsAddPaymail defines model for requests_AddPaymail.
@@ -597,6 +935,19 @@ type RequestsAddPaymail struct {
 	PublicName *string `json:"publicName,omitempty"`
 }
 
+// RequestsAddressOutputSpecification Pays the satoshis to the P2PKH address. <br>
+// The output is annotated only when the address belongs to the user.
+type RequestsAddressOutputSpecification struct {
+	Satoshis uint64 `json:"satoshis"`
+
+	// To Mainnet P2PKH address of the receiver.
+	To   string                                 `json:"to"`
+	Type RequestsAddressOutputSpecificationType `json:"type"`
+}
+
+// RequestsAddressOutputSpecificationType defines model for RequestsAddressOutputSpecification.Type.
+type RequestsAddressOutputSpecificationType string
+
 // RequestsCreateUser defines model for requests_CreateUser.
 type RequestsCreateUser struct {
 	Paymail   *RequestsAddPaymail `json:"paymail,omitempty"`
@@ -627,6 +978,12 @@ type RequestsOpReturnOutputSpecificationType string
 // RequestsOpReturnStringsOutput defines model for requests_OpReturnStringsOutput.
 type RequestsOpReturnStringsOutput = []string
 
+// RequestsOutpoint defines model for requests_Outpoint.
+type RequestsOutpoint struct {
+	TxID string `json:"txID"`
+	Vout uint32 `json:"vout"`
+}
+
 // RequestsPaymailOutputSpecification defines model for requests_PaymailOutputSpecification.
 type RequestsPaymailOutputSpecification struct {
 	From     *string `json:"from"`
@@ -643,6 +1000,41 @@ type RequestsPaymailOutputSpecification struct {
 // RequestsPaymailOutputSpecificationType defines model for RequestsPaymailOutputSpecification.Type.
 type RequestsPaymailOutputSpecificationType string
 
+// RequestsScriptOutputSpecification Pays the satoshis to the arbitrary locking script. <br>
+// The output is annotated only when it is a P2PKH script of the user's address.
+type RequestsScriptOutputSpecification struct {
+	// Satoshis Value of the output, can be zero only for data (OP_RETURN) scripts.
+	Satoshis *uint64 `json:"satoshis,omitempty"`
+
+	// Script Hex of the locking script, up to 100000 bytes.
+	Script string                                `json:"script"`
+	Type   RequestsScriptOutputSpecificationType `json:"type"`
+}
+
+// RequestsScriptOutputSpecificationType defines model for RequestsScriptOutputSpecification.Type.
+type RequestsScriptOutputSpecificationType string
+
+// RequestsSweepOutputSpecification Transfers all user's funds, left after the other outputs and the fee, to the paymail or address. <br>
+// The sweep output is placed after the other outputs and the transaction has no change output. <br>
+// Warning: Only one sweep output is allowed and it cannot be combined with inputs outpoints.
+type RequestsSweepOutputSpecification struct {
+	// From Sender paymail, used only when sweeping to paymail.
+	From *string `json:"from"`
+
+	// MaxSatoshis Skip user's UTXOs with higher value.
+	MaxSatoshis *uint64 `json:"maxSatoshis,omitempty"`
+
+	// MinSatoshis Skip user's UTXOs with lower value.
+	MinSatoshis *uint64 `json:"minSatoshis,omitempty"`
+
+	// To Paymail or address of the receiver.
+	To   string                               `json:"to"`
+	Type RequestsSweepOutputSpecificationType `json:"type"`
+}
+
+// RequestsSweepOutputSpecificationType defines model for RequestsSweepOutputSpecification.Type.
+type RequestsSweepOutputSpecificationType string
+
 // RequestsTransactionOutline defines model for requests_TransactionOutline.
 type RequestsTransactionOutline struct {
 	Annotations *ModelsOutputsAnnotations `json:"annotations,omitempty"`
@@ -652,11 +1044,69 @@ type RequestsTransactionOutline struct {
 
 	// Hex Transaction hex
 	Hex string `json:"hex"`
+
+	// ReservationID ID of the reservation of the UTXOs returned with the transaction outline, released when the transaction is recorded.
+	ReservationID *string `json:"reservationID,omitempty"`
 }
 
 // RequestsTransactionOutlineFormat Transaction format
 type RequestsTransactionOutlineFormat string
 
+// RequestsTransactionOutlineChangeSpecification Specification of the change outputs of the transaction. <br>
+// Without any options provided, the change is returned to the user in a single output.
+type RequestsTransactionOutlineChangeSpecification struct {
+	// Disabled Whether to suppress the change outputs, so the whole change is left to the miners as a fee.
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// MinimumSatoshis The dust threshold for the change outputs. <br>
+	// The change lower than this threshold is folded into the fee.
+	MinimumSatoshis *uint64 `json:"minimumSatoshis,omitempty"`
+
+	// Outputs The number of outputs the change is split into. <br>
+	// Warning: The change is split into fewer outputs when a single output would get less than minimumSatoshis.
+	Outputs *uint64 `json:"outputs,omitempty"`
+
+	// Strategy Strategy of distributing the change among the outputs.
+	Strategy *RequestsTransactionOutlineChangeSpecificationStrategy `json:"strategy,omitempty"`
+}
+
+// RequestsTransactionOutlineChangeSpecificationStrategy Strategy of distributing the change among the outputs.
+type RequestsTransactionOutlineChangeSpecificationStrategy string
+
+// RequestsTransactionOutlineInputsSpecification Specification of the inputs of the transaction. <br>
+// Without outpoints provided, the inputs are selected automatically from the user's UTXOs.
+type RequestsTransactionOutlineInputsSpecification struct {
+	// Exclude User's UTXOs which must not be selected automatically.
+	Exclude *[]RequestsOutpoint `json:"exclude,omitempty"`
+
+	// Outpoints User's UTXOs which must be spent by the transaction.
+	Outpoints *[]RequestsOutpoint `json:"outpoints,omitempty"`
+
+	// SelectRemaining Whether to select more user's UTXOs when the provided outpoints don't cover the outputs and the fee. <br>
+	// When false, the transaction is funded only by the provided outpoints.
+	SelectRemaining *bool `json:"selectRemaining,omitempty"`
+
+	// Strategy Strategy of selecting the user's UTXOs automatically. <br>
+	// When not provided, the strategy configured for the wallet is used.
+	// - oldest_first: the least recently used UTXOs first
+	// - largest_first: the UTXOs with the highest value first, so the transaction has as few inputs as possible
+	// - smallest_first: the UTXOs with the lowest value first, which consolidates the dust
+	// - branch_and_bound: the UTXOs matching the outputs and the fee so exactly that no change output is needed,
+	//   falls back to oldest_first when there is no such match
+	// - random: the UTXOs in random order
+	Strategy *RequestsTransactionOutlineInputsSpecificationStrategy `json:"strategy,omitempty"`
+}
+
+// RequestsTransactionOutlineInputsSpecificationStrategy Strategy of selecting the user's UTXOs automatically. <br>
+// When not provided, the strategy configured for the wallet is used.
+//   - oldest_first: the least recently used UTXOs first
+//   - largest_first: the UTXOs with the highest value first, so the transaction has as few inputs as possible
+//   - smallest_first: the UTXOs with the lowest value first, which consolidates the dust
+//   - branch_and_bound: the UTXOs matching the outputs and the fee so exactly that no change output is needed,
+//     falls back to oldest_first when there is no such match
+//   - random: the UTXOs in random order
+type RequestsTransactionOutlineInputsSpecificationStrategy string
+
 // RequestsTransactionOutlineOutputSpecification defines model for requests_TransactionOutlineOutputSpecification.
 type RequestsTransactionOutlineOutputSpecification struct {
 	union json.RawMessage
@@ -664,9 +1114,31 @@ type RequestsTransactionOutlineOutputSpecification struct {
 
 // RequestsTransactionSpecification defines model for requests_TransactionSpecification.
 type RequestsTransactionSpecification struct {
+	// Change Specification of the change outputs of the transaction. <br>
+	// Without any options provided, the change is returned to the user in a single output.
+	Change *RequestsTransactionOutlineChangeSpecification `json:"change,omitempty"`
+
+	// Inputs Specification of the inputs of the transaction. <br>
+	// Without outpoints provided, the inputs are selected automatically from the user's UTXOs.
+	Inputs  *RequestsTransactionOutlineInputsSpecification  `json:"inputs,omitempty"`
 	Outputs []RequestsTransactionOutlineOutputSpecification `json:"outputs"`
 }
 
+// RequestsUpdatePaymail defines model for requests_UpdatePaymail.
+type RequestsUpdatePaymail struct {
+	AvatarURL  *string `json:"avatarURL,omitempty"`
+	PublicName *string `json:"publicName,omitempty"`
+}
+
+// RequestsExportFormat defines model for requests_ExportFormat.
+type RequestsExportFormat string
+
+// RequestsExportFrom defines model for requests_ExportFrom.
+type RequestsExportFrom = time.Time
+
+// RequestsExportTo defines model for requests_ExportTo.
+type RequestsExportTo = time.Time
+
 // RequestsPageNumber defines model for requests_PageNumber.
 type RequestsPageNumber = int
 
@@ -679,6 +1151,9 @@ type RequestsSort = string
 // RequestsSortBy defines model for requests_SortBy.
 type RequestsSortBy = string
 
+// RequestsStablecoinID defines model for requests_StablecoinID.
+type RequestsStablecoinID = string
+
 // ResponsesAdminAddPaymailSuccess defines model for responses_AdminAddPaymailSuccess.
 type ResponsesAdminAddPaymailSuccess = ModelsPaymail
 
@@ -699,11 +1174,31 @@ type ResponsesAdminInvalidAvatarURL struct {
 	union json.RawMessage
 }
 
+// ResponsesAdminPaymailNotFound defines model for responses_AdminPaymailNotFound.
+type ResponsesAdminPaymailNotFound = ErrorsPaymailNotFound
+
+// ResponsesAdminSearchUsersBadRequest defines model for responses_AdminSearchUsersBadRequest.
+type ResponsesAdminSearchUsersBadRequest = ErrorsInvalidConditions
+
+// ResponsesAdminSearchUsersSuccess defines model for responses_AdminSearchUsersSuccess.
+type ResponsesAdminSearchUsersSuccess = ModelsUsersSearchResult
+
+// ResponsesAdminUpdatePaymailSuccess defines model for responses_AdminUpdatePaymailSuccess.
+type ResponsesAdminUpdatePaymailSuccess = ModelsPaymail
+
 // ResponsesAdminUserBadRequest defines model for responses_AdminUserBadRequest.
 type ResponsesAdminUserBadRequest struct {
 	union json.RawMessage
 }
 
+// ResponsesAdminUserNotFound defines model for responses_AdminUserNotFound.
+type ResponsesAdminUserNotFound = ErrorsUserNotFound
+
+// ResponsesCancelTransactionOutlineReservationBadRequest defines model for responses_CancelTransactionOutlineReservationBadRequest.
+type ResponsesCancelTransactionOutlineReservationBadRequest struct {
+	union json.RawMessage
+}
+
 // ResponsesCreateTransactionOutlineBadRequest defines model for responses_CreateTransactionOutlineBadRequest.
 type ResponsesCreateTransactionOutlineBadRequest struct {
 	union json.RawMessage
@@ -717,6 +1212,11 @@ type ResponsesCreateTransactionOutlineUnprocessable struct {
 	union json.RawMessage
 }
 
+// ResponsesExportHistoryBadRequest defines model for responses_ExportHistoryBadRequest.
+type ResponsesExportHistoryBadRequest struct {
+	union json.RawMessage
+}
+
 // ResponsesGetCurrentUserSuccess defines model for responses_GetCurrentUserSuccess.
 type ResponsesGetCurrentUserSuccess = ModelsUserInfo
 
@@ -745,6 +1245,26 @@ type ResponsesGetMerklerootsNotFound = ErrorsMerkleRootNotFound
 // ResponsesGetMerklerootsSuccess defines model for responses_GetMerklerootsSuccess.
 type ResponsesGetMerklerootsSuccess = ModelsGetMerkleRootResult
 
+// ResponsesGetOperationBadRequest defines model for responses_GetOperationBadRequest.
+type ResponsesGetOperationBadRequest = ErrorsInvalidTransactionID
+
+// ResponsesGetOperationNotFound defines model for responses_GetOperationNotFound.
+type ResponsesGetOperationNotFound = ErrorsOperationNotFound
+
+// ResponsesGetOperationSuccess defines model for responses_GetOperationSuccess.
+type ResponsesGetOperationSuccess = ModelsOperationDetails
+
+// ResponsesGetStablecoinBalancesSuccess defines model for responses_GetStablecoinBalancesSuccess.
+type ResponsesGetStablecoinBalancesSuccess = ModelsStablecoinBalances
+
+// ResponsesGetUserBalanceBadRequest defines model for responses_GetUserBalanceBadRequest.
+type ResponsesGetUserBalanceBadRequest struct {
+	union json.RawMessage
+}
+
+// ResponsesGetUserBalanceSuccess defines model for responses_GetUserBalanceSuccess.
+type ResponsesGetUserBalanceSuccess = ModelsUserBalance
+
 // ResponsesInternalServerError defines model for responses_InternalServerError.
 type ResponsesInternalServerError = ErrorsInternal
 
@@ -767,12 +1287,20 @@ type ResponsesRecordTransactionInternalServerError struct {
 // ResponsesRecordTransactionSuccess defines model for responses_RecordTransactionSuccess.
 type ResponsesRecordTransactionSuccess = ModelsRecordedOutline
 
+// ResponsesRecordTransactionUnprocessable defines model for responses_RecordTransactionUnprocessable.
+type ResponsesRecordTransactionUnprocessable struct {
+	union json.RawMessage
+}
+
 // ResponsesSearchBadRequest defines model for responses_SearchBadRequest.
 type ResponsesSearchBadRequest = ErrorsInvalidDataID
 
 // ResponsesSearchOperationsSuccess defines model for responses_SearchOperationsSuccess.
 type ResponsesSearchOperationsSuccess = ModelsOperationsSearchResult
 
+// ResponsesSearchStablecoinBanknotesSuccess defines model for responses_SearchStablecoinBanknotesSuccess.
+type ResponsesSearchStablecoinBanknotesSuccess = ModelsStablecoinBanknotesSearchResult
+
 // ResponsesSharedConfig Shared config
 type ResponsesSharedConfig = ModelsSharedConfig
 
@@ -782,6 +1310,54 @@ type ResponsesUserBadRequest = ErrorsInvalidDataID
 // ResponsesUserNotAuthorized defines model for responses_UserNotAuthorized.
 type ResponsesUserNotAuthorized = ErrorsUserAuthorization
 
+// AdminExportOperationsParams defines parameters for AdminExportOperations.
+type AdminExportOperationsParams struct {
+	// UserId Export only operations of the user with this ID
+	UserId *string `form:"userId,omitempty" json:"userId,omitempty"`
+
+	// Format Format of the export
+	Format *AdminExportOperationsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Export only operations created at or after this time
+	From *RequestsExportFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Export only operations created at or before this time
+	To *RequestsExportTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// AdminExportOperationsParamsFormat defines parameters for AdminExportOperations.
+type AdminExportOperationsParamsFormat string
+
+// SearchUsersParams defines parameters for SearchUsers.
+type SearchUsersParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// PublicKey Public key of the user
+	PublicKey *string `form:"publicKey,omitempty" json:"publicKey,omitempty"`
+
+	// Paymail Return only users owning this paymail address
+	Paymail *string `form:"paymail,omitempty" json:"paymail,omitempty"`
+
+	// CreatedFrom Return only users created at or after this time
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Return only users created at or before this time
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// Active Return only active (true) or only deactivated (false) users
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+}
+
 // MerkleRootsParams defines parameters for MerkleRoots.
 type MerkleRootsParams struct {
 	// BatchSize Batch size of merkleroots to be returned
@@ -791,6 +1367,21 @@ type MerkleRootsParams struct {
 	LastEvaluatedKey *string `form:"lastEvaluatedKey,omitempty" json:"lastEvaluatedKey,omitempty"`
 }
 
+// ExportOperationsParams defines parameters for ExportOperations.
+type ExportOperationsParams struct {
+	// Format Format of the export
+	Format *ExportOperationsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Export only operations created at or after this time
+	From *RequestsExportFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Export only operations created at or before this time
+	To *RequestsExportTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ExportOperationsParamsFormat defines parameters for ExportOperations.
+type ExportOperationsParamsFormat string
+
 // SearchOperationsParams defines parameters for SearchOperations.
 type SearchOperationsParams struct {
 	// Page Page number for pagination
@@ -804,10 +1395,67 @@ type SearchOperationsParams struct {
 
 	// SortBy Field to sort by
 	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
-}
 
-// CreateTransactionOutlineParams defines parameters for CreateTransactionOutline.
-type CreateTransactionOutlineParams struct {
+	// Type Type of the operation
+	Type *SearchOperationsParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Counterparty Counterparty of the operation (e.g. paymail of the sender or receiver), matched exactly but case-insensitively
+	Counterparty *string `form:"counterparty,omitempty" json:"counterparty,omitempty"`
+
+	// MinValue Minimal value of the operation in satoshis (outgoing operations have negative values)
+	MinValue *int64 `form:"minValue,omitempty" json:"minValue,omitempty"`
+
+	// MaxValue Maximal value of the operation in satoshis (outgoing operations have negative values)
+	MaxValue *int64 `form:"maxValue,omitempty" json:"maxValue,omitempty"`
+
+	// CreatedFrom Return only operations created at or after this time
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Return only operations created at or before this time
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// TxStatus Status of the underlying transaction
+	TxStatus *SearchOperationsParamsTxStatus `form:"txStatus,omitempty" json:"txStatus,omitempty"`
+}
+
+// SearchOperationsParamsType defines parameters for SearchOperations.
+type SearchOperationsParamsType string
+
+// SearchOperationsParamsTxStatus defines parameters for SearchOperations.
+type SearchOperationsParamsTxStatus string
+
+// OperationByTxIDParams defines parameters for OperationByTxID.
+type OperationByTxIDParams struct {
+	// IncludeHex Include BEEF or raw hex of the transaction in the response
+	IncludeHex *bool `form:"includeHex,omitempty" json:"includeHex,omitempty"`
+}
+
+// StablecoinBalancesParams defines parameters for StablecoinBalances.
+type StablecoinBalancesParams struct {
+	// StablecoinId Stablecoin identifier
+	StablecoinId *RequestsStablecoinID `form:"stablecoinId,omitempty" json:"stablecoinId,omitempty"`
+}
+
+// SearchStablecoinBanknotesParams defines parameters for SearchStablecoinBanknotes.
+type SearchStablecoinBanknotesParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// StablecoinId Stablecoin identifier
+	StablecoinId *RequestsStablecoinID `form:"stablecoinId,omitempty" json:"stablecoinId,omitempty"`
+}
+
+// CreateTransactionOutlineParams defines parameters for CreateTransactionOutline.
+type CreateTransactionOutlineParams struct {
 	// Format Required format of transaction hex
 	Format *CreateTransactionOutlineParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
@@ -815,12 +1463,30 @@ type CreateTransactionOutlineParams struct {
 // CreateTransactionOutlineParamsFormat defines parameters for CreateTransactionOutline.
 type CreateTransactionOutlineParamsFormat string
 
+// CurrentUserBalanceParams defines parameters for CurrentUserBalance.
+type CurrentUserBalanceParams struct {
+	// At Return the balance made of operations created at or before this time
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+
+	// BlockHeight Return the balance made of transactions mined at or below this block height
+	BlockHeight *int64 `form:"blockHeight,omitempty" json:"blockHeight,omitempty"`
+
+	// HistoryFrom Return also the balances at the end of each day starting from this day
+	HistoryFrom *openapi_types.Date `form:"historyFrom,omitempty" json:"historyFrom,omitempty"`
+
+	// HistoryTo Last day of the balance history (today by default)
+	HistoryTo *openapi_types.Date `form:"historyTo,omitempty" json:"historyTo,omitempty"`
+}
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = RequestsCreateUser
 
 // AddPaymailToUserJSONRequestBody defines body for AddPaymailToUser for application/json ContentType.
 type AddPaymailToUserJSONRequestBody = RequestsAddPaymail
 
+// UpdateUserPaymailJSONRequestBody defines body for UpdateUserPaymail for application/json ContentType.
+type UpdateUserPaymailJSONRequestBody = RequestsUpdatePaymail
+
 // RecordTransactionOutlineJSONRequestBody defines body for RecordTransactionOutline for application/json ContentType.
 type RecordTransactionOutlineJSONRequestBody = RequestsTransactionOutline
 
@@ -1219,6 +1885,90 @@ func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsPaymailOutp
 	return err
 }
 
+// AsRequestsSweepOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsSweepOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsSweepOutputSpecification() (RequestsSweepOutputSpecification, error) {
+	var body RequestsSweepOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsSweepOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsSweepOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsSweepOutputSpecification(v RequestsSweepOutputSpecification) error {
+	v.Type = "sweep"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsSweepOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsSweepOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsSweepOutputSpecification(v RequestsSweepOutputSpecification) error {
+	v.Type = "sweep"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsAddressOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsAddressOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsAddressOutputSpecification() (RequestsAddressOutputSpecification, error) {
+	var body RequestsAddressOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsAddressOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsAddressOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsAddressOutputSpecification(v RequestsAddressOutputSpecification) error {
+	v.Type = "address"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsAddressOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsAddressOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsAddressOutputSpecification(v RequestsAddressOutputSpecification) error {
+	v.Type = "address"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsScriptOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsScriptOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsScriptOutputSpecification() (RequestsScriptOutputSpecification, error) {
+	var body RequestsScriptOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsScriptOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsScriptOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsScriptOutputSpecification(v RequestsScriptOutputSpecification) error {
+	v.Type = "script"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsScriptOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsScriptOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsScriptOutputSpecification(v RequestsScriptOutputSpecification) error {
+	v.Type = "script"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t RequestsTransactionOutlineOutputSpecification) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
@@ -1233,10 +1983,16 @@ func (t RequestsTransactionOutlineOutputSpecification) ValueByDiscriminator() (i
 		return nil, err
 	}
 	switch discriminator {
+	case "address":
+		return t.AsRequestsAddressOutputSpecification()
 	case "op_return":
 		return t.AsRequestsOpReturnOutputSpecification()
 	case "paymail":
 		return t.AsRequestsPaymailOutputSpecification()
+	case "script":
+		return t.AsRequestsScriptOutputSpecification()
+	case "sweep":
+		return t.AsRequestsSweepOutputSpecification()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -1428,6 +2184,42 @@ func (t *ResponsesAdminUserBadRequest) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsErrorsTxOutlineReservationIDRequired returns the union data inside the ResponsesCancelTransactionOutlineReservationBadRequest as a ErrorsTxOutlineReservationIDRequired
+func (t ResponsesCancelTransactionOutlineReservationBadRequest) AsErrorsTxOutlineReservationIDRequired() (ErrorsTxOutlineReservationIDRequired, error) {
+	var body ErrorsTxOutlineReservationIDRequired
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsTxOutlineReservationIDRequired overwrites any union data inside the ResponsesCancelTransactionOutlineReservationBadRequest as the provided ErrorsTxOutlineReservationIDRequired
+func (t *ResponsesCancelTransactionOutlineReservationBadRequest) FromErrorsTxOutlineReservationIDRequired(v ErrorsTxOutlineReservationIDRequired) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsTxOutlineReservationIDRequired performs a merge with any union data inside the ResponsesCancelTransactionOutlineReservationBadRequest, using the provided ErrorsTxOutlineReservationIDRequired
+func (t *ResponsesCancelTransactionOutlineReservationBadRequest) MergeErrorsTxOutlineReservationIDRequired(v ErrorsTxOutlineReservationIDRequired) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesCancelTransactionOutlineReservationBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesCancelTransactionOutlineReservationBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsTxSpecNoDefaultPaymailAddress returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecNoDefaultPaymailAddress
 func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecNoDefaultPaymailAddress() (ErrorsTxSpecNoDefaultPaymailAddress, error) {
 	var body ErrorsTxSpecNoDefaultPaymailAddress
@@ -1584,6 +2376,110 @@ func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidPa
 	return err
 }
 
+// AsErrorsTxSpecInvalidAddress returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecInvalidAddress
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecInvalidAddress() (ErrorsTxSpecInvalidAddress, error) {
+	var body ErrorsTxSpecInvalidAddress
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsTxSpecInvalidAddress overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecInvalidAddress
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecInvalidAddress(v ErrorsTxSpecInvalidAddress) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsTxSpecInvalidAddress performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecInvalidAddress
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidAddress(v ErrorsTxSpecInvalidAddress) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsTxSpecUnsupportedAddressNetwork returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecUnsupportedAddressNetwork
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecUnsupportedAddressNetwork() (ErrorsTxSpecUnsupportedAddressNetwork, error) {
+	var body ErrorsTxSpecUnsupportedAddressNetwork
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsTxSpecUnsupportedAddressNetwork overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecUnsupportedAddressNetwork
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecUnsupportedAddressNetwork(v ErrorsTxSpecUnsupportedAddressNetwork) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsTxSpecUnsupportedAddressNetwork performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecUnsupportedAddressNetwork
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecUnsupportedAddressNetwork(v ErrorsTxSpecUnsupportedAddressNetwork) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsTxSpecScriptRequired returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecScriptRequired
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecScriptRequired() (ErrorsTxSpecScriptRequired, error) {
+	var body ErrorsTxSpecScriptRequired
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsTxSpecScriptRequired overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecScriptRequired
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecScriptRequired(v ErrorsTxSpecScriptRequired) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsTxSpecScriptRequired performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecScriptRequired
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecScriptRequired(v ErrorsTxSpecScriptRequired) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsTxSpecScriptTooLarge returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecScriptTooLarge
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecScriptTooLarge() (ErrorsTxSpecScriptTooLarge, error) {
+	var body ErrorsTxSpecScriptTooLarge
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsTxSpecScriptTooLarge overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecScriptTooLarge
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecScriptTooLarge(v ErrorsTxSpecScriptTooLarge) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsTxSpecScriptTooLarge performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecScriptTooLarge
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecScriptTooLarge(v ErrorsTxSpecScriptTooLarge) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ResponsesCreateTransactionOutlineBadRequest) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1630,6 +2526,94 @@ func (t *ResponsesCreateTransactionOutlineUnprocessable) UnmarshalJSON(b []byte)
 	return err
 }
 
+// AsErrorsCannotParseQueryParams returns the union data inside the ResponsesExportHistoryBadRequest as a ErrorsCannotParseQueryParams
+func (t ResponsesExportHistoryBadRequest) AsErrorsCannotParseQueryParams() (ErrorsCannotParseQueryParams, error) {
+	var body ErrorsCannotParseQueryParams
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsCannotParseQueryParams overwrites any union data inside the ResponsesExportHistoryBadRequest as the provided ErrorsCannotParseQueryParams
+func (t *ResponsesExportHistoryBadRequest) FromErrorsCannotParseQueryParams(v ErrorsCannotParseQueryParams) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsCannotParseQueryParams performs a merge with any union data inside the ResponsesExportHistoryBadRequest, using the provided ErrorsCannotParseQueryParams
+func (t *ResponsesExportHistoryBadRequest) MergeErrorsCannotParseQueryParams(v ErrorsCannotParseQueryParams) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsExportFormatInvalid returns the union data inside the ResponsesExportHistoryBadRequest as a ErrorsExportFormatInvalid
+func (t ResponsesExportHistoryBadRequest) AsErrorsExportFormatInvalid() (ErrorsExportFormatInvalid, error) {
+	var body ErrorsExportFormatInvalid
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsExportFormatInvalid overwrites any union data inside the ResponsesExportHistoryBadRequest as the provided ErrorsExportFormatInvalid
+func (t *ResponsesExportHistoryBadRequest) FromErrorsExportFormatInvalid(v ErrorsExportFormatInvalid) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsExportFormatInvalid performs a merge with any union data inside the ResponsesExportHistoryBadRequest, using the provided ErrorsExportFormatInvalid
+func (t *ResponsesExportHistoryBadRequest) MergeErrorsExportFormatInvalid(v ErrorsExportFormatInvalid) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsExportTimeRangeInvalid returns the union data inside the ResponsesExportHistoryBadRequest as a ErrorsExportTimeRangeInvalid
+func (t ResponsesExportHistoryBadRequest) AsErrorsExportTimeRangeInvalid() (ErrorsExportTimeRangeInvalid, error) {
+	var body ErrorsExportTimeRangeInvalid
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsExportTimeRangeInvalid overwrites any union data inside the ResponsesExportHistoryBadRequest as the provided ErrorsExportTimeRangeInvalid
+func (t *ResponsesExportHistoryBadRequest) FromErrorsExportTimeRangeInvalid(v ErrorsExportTimeRangeInvalid) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsExportTimeRangeInvalid performs a merge with any union data inside the ResponsesExportHistoryBadRequest, using the provided ErrorsExportTimeRangeInvalid
+func (t *ResponsesExportHistoryBadRequest) MergeErrorsExportTimeRangeInvalid(v ErrorsExportTimeRangeInvalid) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesExportHistoryBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesExportHistoryBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsDataNotFound returns the union data inside the ResponsesGetDataNotFound as a ErrorsDataNotFound
 func (t ResponsesGetDataNotFound) AsErrorsDataNotFound() (ErrorsDataNotFound, error) {
 	var body ErrorsDataNotFound
@@ -1858,6 +2842,68 @@ func (t *ResponsesGetMerklerootsInternalServerError) UnmarshalJSON(b []byte) err
 	return err
 }
 
+// AsErrorsBalancePointAmbiguous returns the union data inside the ResponsesGetUserBalanceBadRequest as a ErrorsBalancePointAmbiguous
+func (t ResponsesGetUserBalanceBadRequest) AsErrorsBalancePointAmbiguous() (ErrorsBalancePointAmbiguous, error) {
+	var body ErrorsBalancePointAmbiguous
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBalancePointAmbiguous overwrites any union data inside the ResponsesGetUserBalanceBadRequest as the provided ErrorsBalancePointAmbiguous
+func (t *ResponsesGetUserBalanceBadRequest) FromErrorsBalancePointAmbiguous(v ErrorsBalancePointAmbiguous) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBalancePointAmbiguous performs a merge with any union data inside the ResponsesGetUserBalanceBadRequest, using the provided ErrorsBalancePointAmbiguous
+func (t *ResponsesGetUserBalanceBadRequest) MergeErrorsBalancePointAmbiguous(v ErrorsBalancePointAmbiguous) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsBalanceHistoryRangeInvalid returns the union data inside the ResponsesGetUserBalanceBadRequest as a ErrorsBalanceHistoryRangeInvalid
+func (t ResponsesGetUserBalanceBadRequest) AsErrorsBalanceHistoryRangeInvalid() (ErrorsBalanceHistoryRangeInvalid, error) {
+	var body ErrorsBalanceHistoryRangeInvalid
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBalanceHistoryRangeInvalid overwrites any union data inside the ResponsesGetUserBalanceBadRequest as the provided ErrorsBalanceHistoryRangeInvalid
+func (t *ResponsesGetUserBalanceBadRequest) FromErrorsBalanceHistoryRangeInvalid(v ErrorsBalanceHistoryRangeInvalid) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBalanceHistoryRangeInvalid performs a merge with any union data inside the ResponsesGetUserBalanceBadRequest, using the provided ErrorsBalanceHistoryRangeInvalid
+func (t *ResponsesGetUserBalanceBadRequest) MergeErrorsBalanceHistoryRangeInvalid(v ErrorsBalanceHistoryRangeInvalid) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesGetUserBalanceBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesGetUserBalanceBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsInvalidDataID returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsInvalidDataID
 func (t ResponsesRecordTransactionBadRequest) AsErrorsInvalidDataID() (ErrorsInvalidDataID, error) {
 	var body ErrorsInvalidDataID
@@ -2076,6 +3122,32 @@ func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsTxBroadcast(v
 	return err
 }
 
+// AsErrorsGettingSourceTransactions returns the union data inside the ResponsesRecordTransactionInternalServerError as a ErrorsGettingSourceTransactions
+func (t ResponsesRecordTransactionInternalServerError) AsErrorsGettingSourceTransactions() (ErrorsGettingSourceTransactions, error) {
+	var body ErrorsGettingSourceTransactions
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsGettingSourceTransactions overwrites any union data inside the ResponsesRecordTransactionInternalServerError as the provided ErrorsGettingSourceTransactions
+func (t *ResponsesRecordTransactionInternalServerError) FromErrorsGettingSourceTransactions(v ErrorsGettingSourceTransactions) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsGettingSourceTransactions performs a merge with any union data inside the ResponsesRecordTransactionInternalServerError, using the provided ErrorsGettingSourceTransactions
+func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsGettingSourceTransactions(v ErrorsGettingSourceTransactions) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ResponsesRecordTransactionInternalServerError) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -2085,3 +3157,39 @@ func (t *ResponsesRecordTransactionInternalServerError) UnmarshalJSON(b []byte)
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
+
+// AsErrorsSourceTransactionNotFound returns the union data inside the ResponsesRecordTransactionUnprocessable as a ErrorsSourceTransactionNotFound
+func (t ResponsesRecordTransactionUnprocessable) AsErrorsSourceTransactionNotFound() (ErrorsSourceTransactionNotFound, error) {
+	var body ErrorsSourceTransactionNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsSourceTransactionNotFound overwrites any union data inside the ResponsesRecordTransactionUnprocessable as the provided ErrorsSourceTransactionNotFound
+func (t *ResponsesRecordTransactionUnprocessable) FromErrorsSourceTransactionNotFound(v ErrorsSourceTransactionNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsSourceTransactionNotFound performs a merge with any union data inside the ResponsesRecordTransactionUnprocessable, using the provided ErrorsSourceTransactionNotFound
+func (t *ResponsesRecordTransactionUnprocessable) MergeErrorsSourceTransactionNotFound(v ErrorsSourceTransactionNotFound) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesRecordTransactionUnprocessable) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesRecordTransactionUnprocessable) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -30,22 +31,37 @@ const (
 
 // Defines values for ModelsDataAnnotationBucket.
 const (
-	Data ModelsDataAnnotationBucket = "data"
+	ModelsDataAnnotationBucketData ModelsDataAnnotationBucket = "data"
 )
 
 // Defines values for ModelsOperationTxStatus.
 const (
-	BROADCASTED ModelsOperationTxStatus = "BROADCASTED"
-	CREATED     ModelsOperationTxStatus = "CREATED"
-	MINED       ModelsOperationTxStatus = "MINED"
-	PROBLEMATIC ModelsOperationTxStatus = "PROBLEMATIC"
-	REVERTED    ModelsOperationTxStatus = "REVERTED"
+	ModelsOperationTxStatusBROADCASTED ModelsOperationTxStatus = "BROADCASTED"
+	ModelsOperationTxStatusCREATED     ModelsOperationTxStatus = "CREATED"
+	ModelsOperationTxStatusMINED       ModelsOperationTxStatus = "MINED"
+	ModelsOperationTxStatusPROBLEMATIC ModelsOperationTxStatus = "PROBLEMATIC"
+	ModelsOperationTxStatusREVERTED    ModelsOperationTxStatus = "REVERTED"
 )
 
 // Defines values for ModelsOperationType.
 const (
-	Incoming ModelsOperationType = "incoming"
-	Outgoing ModelsOperationType = "outgoing"
+	ModelsOperationTypeIncoming ModelsOperationType = "incoming"
+	ModelsOperationTypeOutgoing ModelsOperationType = "outgoing"
+)
+
+// Defines values for ModelsOperationDetailsTxStatus.
+const (
+	ModelsOperationDetailsTxStatusBROADCASTED ModelsOperationDetailsTxStatus = "BROADCASTED"
+	ModelsOperationDetailsTxStatusCREATED     ModelsOperationDetailsTxStatus = "CREATED"
+	ModelsOperationDetailsTxStatusMINED       ModelsOperationDetailsTxStatus = "MINED"
+	ModelsOperationDetailsTxStatusPROBLEMATIC ModelsOperationDetailsTxStatus = "PROBLEMATIC"
+	ModelsOperationDetailsTxStatusREVERTED    ModelsOperationDetailsTxStatus = "REVERTED"
+)
+
+// Defines values for ModelsOperationDetailsType.
+const (
+	ModelsOperationDetailsTypeIncoming ModelsOperationDetailsType = "incoming"
+	ModelsOperationDetailsTypeOutgoing ModelsOperationDetailsType = "outgoing"
 )
 
 // Defines values for ModelsOutputAnnotationBucket.
@@ -64,6 +80,11 @@ const (
 	ModelsTransactionHexFormatRAW  ModelsTransactionHexFormat = "RAW"
 )
 
+// Defines values for RequestsAddressOutputSpecificationType.
+const (
+	Address RequestsAddressOutputSpecificationType = "address"
+)
+
 // Defines values for RequestsOpReturnOutputSpecificationDataType.
 const (
 	Hexes   RequestsOpReturnOutputSpecificationDataType = "hexes"
@@ -80,12 +101,71 @@ const (
 	Paymail RequestsPaymailOutputSpecificationType = "paymail"
 )
 
+// Defines values for RequestsScriptOutputSpecificationType.
+const (
+	Script RequestsScriptOutputSpecificationType = "script"
+)
+
+// Defines values for RequestsSweepOutputSpecificationType.
+const (
+	Sweep RequestsSweepOutputSpecificationType = "sweep"
+)
+
 // Defines values for RequestsTransactionOutlineFormat.
 const (
 	BEEF RequestsTransactionOutlineFormat = "BEEF"
 	RAW  RequestsTransactionOutlineFormat = "RAW"
 )
 
+// Defines values for RequestsTransactionOutlineChangeSpecificationStrategy.
+const (
+	RequestsTransactionOutlineChangeSpecificationStrategyEven   RequestsTransactionOutlineChangeSpecificationStrategy = "even"
+	RequestsTransactionOutlineChangeSpecificationStrategyRandom RequestsTransactionOutlineChangeSpecificationStrategy = "random"
+)
+
+// Defines values for RequestsTransactionOutlineInputsSpecificationStrategy.
+const (
+	RequestsTransactionOutlineInputsSpecificationStrategyBranchAndBound RequestsTransactionOutlineInputsSpecificationStrategy = "branch_and_bound"
+	RequestsTransactionOutlineInputsSpecificationStrategyLargestFirst   RequestsTransactionOutlineInputsSpecificationStrategy = "largest_first"
+	RequestsTransactionOutlineInputsSpecificationStrategyOldestFirst    RequestsTransactionOutlineInputsSpecificationStrategy = "oldest_first"
+	RequestsTransactionOutlineInputsSpecificationStrategyRandom         RequestsTransactionOutlineInputsSpecificationStrategy = "random"
+	RequestsTransactionOutlineInputsSpecificationStrategySmallestFirst  RequestsTransactionOutlineInputsSpecificationStrategy = "smallest_first"
+)
+
+// Defines values for RequestsExportFormat.
+const (
+	RequestsExportFormatCsv   RequestsExportFormat = "csv"
+	RequestsExportFormatJsonl RequestsExportFormat = "jsonl"
+)
+
+// Defines values for AdminExportOperationsParamsFormat.
+const (
+	AdminExportOperationsParamsFormatCsv   AdminExportOperationsParamsFormat = "csv"
+	AdminExportOperationsParamsFormatJsonl AdminExportOperationsParamsFormat = "jsonl"
+)
+
+// Defines values for ExportOperationsParamsFormat.
+const (
+	Csv   ExportOperationsParamsFormat = "csv"
+	Jsonl ExportOperationsParamsFormat = "jsonl"
+)
+
+// Defines values for SearchOperationsParamsType.
+const (
+	SearchOperationsParamsTypeData     SearchOperationsParamsType = "data"
+	SearchOperationsParamsTypeIncoming SearchOperationsParamsType = "incoming"
+	SearchOperationsParamsTypeOutgoing SearchOperationsParamsType = "outgoing"
+)
+
+// Defines values for SearchOperationsParamsTxStatus.
+const (
+	BROADCASTED SearchOperationsParamsTxStatus = "BROADCASTED"
+	CREATED     SearchOperationsParamsTxStatus = "CREATED"
+	MINED       SearchOperationsParamsTxStatus = "MINED"
+	PROBLEMATIC SearchOperationsParamsTxStatus = "PROBLEMATIC"
+	REVERTED    SearchOperationsParamsTxStatus = "REVERTED"
+)
+
 // Defines values for CreateTransactionOutlineParamsFormat.
 const (
 	Beef CreateTransactionOutlineParamsFormat = "beef"
@@ -168,12 +248,30 @@ type ErrorsBHSUnreachable struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsBalanceHistoryRangeInvalid defines model for errors_BalanceHistoryRangeInvalid.
+type ErrorsBalanceHistoryRangeInvalid struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsBalancePointAmbiguous defines model for errors_BalancePointAmbiguous.
+type ErrorsBalancePointAmbiguous struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsCannotBindRequest defines model for errors_CannotBindRequest.
 type ErrorsCannotBindRequest struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsCannotParseQueryParams defines model for errors_CannotParseQueryParams.
+type ErrorsCannotParseQueryParams struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsCreatingUser defines model for errors_CreatingUser.
 type ErrorsCreatingUser struct {
 	Code    interface{} `json:"code"`
@@ -186,12 +284,30 @@ type ErrorsDataNotFound struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsExportFormatInvalid defines model for errors_ExportFormatInvalid.
+type ErrorsExportFormatInvalid struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsExportTimeRangeInvalid defines model for errors_ExportTimeRangeInvalid.
+type ErrorsExportTimeRangeInvalid struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsGettingOutputs defines model for errors_GettingOutputs.
 type ErrorsGettingOutputs struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsGettingSourceTransactions defines model for errors_GettingSourceTransactions.
+type ErrorsGettingSourceTransactions struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsGettingUser defines model for errors_GettingUser.
 type ErrorsGettingUser struct {
 	Code    interface{} `json:"code"`
@@ -216,6 +332,12 @@ type ErrorsInvalidBatchSize struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidConditions defines model for errors_InvalidConditions.
+type ErrorsInvalidConditions struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsInvalidDataID defines model for errors_InvalidDataID.
 type ErrorsInvalidDataID struct {
 	Code    interface{} `json:"code"`
@@ -240,6 +362,12 @@ type ErrorsInvalidPubKey struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsInvalidTransactionID defines model for errors_InvalidTransactionID.
+type ErrorsInvalidTransactionID struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsMerkleRootNotFound defines model for errors_MerkleRootNotFound.
 type ErrorsMerkleRootNotFound struct {
 	Code    interface{} `json:"code"`
@@ -258,12 +386,24 @@ type ErrorsNoOperations struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsOperationNotFound defines model for errors_OperationNotFound.
+type ErrorsOperationNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsPaymailInconsistent defines model for errors_PaymailInconsistent.
 type ErrorsPaymailInconsistent struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsPaymailNotFound defines model for errors_PaymailNotFound.
+type ErrorsPaymailNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsSchema defines model for errors_Schema.
 type ErrorsSchema struct {
 	// Code Error code
@@ -273,12 +413,24 @@ type ErrorsSchema struct {
 	Message string `json:"message"`
 }
 
+// ErrorsSourceTransactionNotFound defines model for errors_SourceTransactionNotFound.
+type ErrorsSourceTransactionNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsTxBroadcast defines model for errors_TxBroadcast.
 type ErrorsTxBroadcast struct {
 	Code    interface{} `json:"code"`
 	Message interface{} `json:"message"`
 }
 
+// ErrorsTxOutlineReservationIDRequired defines model for errors_TxOutlineReservationIDRequired.
+type ErrorsTxOutlineReservationIDRequired struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsTxOutlineUserHasNotEnoughFunds defines model for errors_TxOutlineUserHasNotEnoughFunds.
 type ErrorsTxOutlineUserHasNotEnoughFunds struct {
 	Code    interface{} `json:"code"`
@@ -291,6 +443,12 @@ type ErrorsTxSpecFailedToDecodeHex struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsTxSpecInvalidAddress defines model for errors_TxSpecInvalidAddress.
+type ErrorsTxSpecInvalidAddress struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsTxSpecInvalidPaymailReceiver defines model for errors_TxSpecInvalidPaymailReceiver.
 type ErrorsTxSpecInvalidPaymailReceiver struct {
 	Code    interface{} `json:"code"`
@@ -321,6 +479,24 @@ type ErrorsTxSpecOutputsRequired struct {
 	Message interface{} `json:"message"`
 }
 
+// ErrorsTxSpecScriptRequired defines model for errors_TxSpecScriptRequired.
+type ErrorsTxSpecScriptRequired struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsTxSpecScriptTooLarge defines model for errors_TxSpecScriptTooLarge.
+type ErrorsTxSpecScriptTooLarge struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
+// ErrorsTxSpecUnsupportedAddressNetwork defines model for errors_TxSpecUnsupportedAddressNetwork.
+type ErrorsTxSpecUnsupportedAddressNetwork struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ErrorsUTXOSpent defines model for errors_UTXOSpent.
 type ErrorsUTXOSpent struct {
 	Code    interface{} `json:"code"`
@@ -344,6 +520,12 @@ type ErrorsUserAuthorization struct {
 	union json.RawMessage
 }
 
+// ErrorsUserNotFound defines model for errors_UserNotFound.
+type ErrorsUserNotFound struct {
+	Code    interface{} `json:"code"`
+	Message interface{} `json:"message"`
+}
+
 // ModelsAnnotatedTransactionOutline defines model for models_AnnotatedTransactionOutline.
 type ModelsAnnotatedTransactionOutline struct {
 	Annotations *ModelsOutlineAnnotations `json:"annotations,omitempty"`
@@ -353,6 +535,10 @@ type ModelsAnnotatedTransactionOutline struct {
 
 	// Hex Transaction hex
 	Hex string `json:"hex"`
+
+	// ReservationID ID of the reservation of the UTXOs selected as inputs of the transaction outline. <br>
+	// The reserved UTXOs are not selected for other transaction outlines until the outline is recorded, the reservation is cancelled or it expires.
+	ReservationID *string `json:"reservationID,omitempty"`
 }
 
 // ModelsAnnotatedTransactionOutlineFormat Transaction format
@@ -374,6 +560,16 @@ type ModelsCustomInstructions struct {
 	union json.RawMessage
 }
 
+// ModelsDailyBalance defines model for models_DailyBalance.
+type ModelsDailyBalance struct {
+	Confirmed int64 `json:"confirmed"`
+
+	// Date Day (UTC) at the end of which the balance is computed
+	Date        openapi_types.Date `json:"date"`
+	Total       int64              `json:"total"`
+	Unconfirmed int64              `json:"unconfirmed"`
+}
+
 // ModelsData defines model for models_Data.
 type ModelsData struct {
 	// Blob Data blob
@@ -437,6 +633,9 @@ type ModelsOperation struct {
 	// BlockHeight Block height of underlying transaction
 	BlockHeight *int64 `json:"blockHeight,omitempty"`
 
+	// Confirmations Number of confirmations of underlying transaction, counted up to the required number of confirmations
+	Confirmations *uint32 `json:"confirmations,omitempty"`
+
 	// Counterparty Counterparty of operation
 	Counterparty string `json:"counterparty"`
 
@@ -462,6 +661,67 @@ type ModelsOperationTxStatus string
 // ModelsOperationType Type of operation
 type ModelsOperationType string
 
+// ModelsOperationDetails defines model for models_OperationDetails.
+type ModelsOperationDetails struct {
+	// BlockHash Block hash of underlying transaction
+	BlockHash *string `json:"blockHash,omitempty"`
+
+	// BlockHeight Block height of underlying transaction
+	BlockHeight *int64 `json:"blockHeight,omitempty"`
+
+	// Confirmations Number of confirmations of underlying transaction, counted up to the required number of confirmations
+	Confirmations *uint32 `json:"confirmations,omitempty"`
+
+	// Counterparty Counterparty of operation
+	Counterparty string `json:"counterparty"`
+
+	// CreatedAt Creation date of operation
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data Data outputs of the transaction which belong to the user
+	Data []ModelsData `json:"data"`
+
+	// Fee Fee paid for the transaction; omitted when satoshis of some inputs are unknown
+	Fee *uint64 `json:"fee,omitempty"`
+
+	// Inputs Outputs of the user spent by the transaction
+	Inputs []ModelsOperationOutput `json:"inputs"`
+
+	// Outputs Outputs of the transaction received by the user
+	Outputs     []ModelsOperationOutput `json:"outputs"`
+	Transaction *ModelsTransactionHex   `json:"transaction,omitempty"`
+
+	// TxID Transaction ID
+	TxID string `json:"txID"`
+
+	// TxStatus Status of transaction
+	TxStatus ModelsOperationDetailsTxStatus `json:"txStatus"`
+
+	// Type Type of operation
+	Type ModelsOperationDetailsType `json:"type"`
+
+	// Value Value of operation
+	Value int64 `json:"value"`
+}
+
+// ModelsOperationDetailsTxStatus Status of transaction
+type ModelsOperationDetailsTxStatus string
+
+// ModelsOperationDetailsType Type of operation
+type ModelsOperationDetailsType string
+
+// ModelsOperationOutput defines model for models_OperationOutput.
+type ModelsOperationOutput struct {
+	// Satoshis Value of the output in satoshis
+	Satoshis uint64 `json:"satoshis"`
+
+	// TxID ID of the transaction containing the output
+	TxID string `json:"txID"`
+
+	// Vout Index of the output in the transaction
+	Vout uint32 `json:"vout"`
+}
+
 // ModelsOperationsSearchResult defines model for models_OperationsSearchResult.
 type ModelsOperationsSearchResult struct {
 	Content []ModelsOperation `json:"content"`
@@ -563,6 +823,48 @@ type ModelsSharedConfig struct {
 	PaymailDomains       []string        `json:"paymailDomains"`
 }
 
+// ModelsStablecoinBalance defines model for models_StablecoinBalance.
+type ModelsStablecoinBalance struct {
+	// Amount Sum of the unspent banknotes of the stablecoin
+	Amount uint64 `json:"amount"`
+
+	// Banknotes Number of the unspent banknotes of the stablecoin
+	Banknotes int `json:"banknotes"`
+
+	// StablecoinId Identifier of the stablecoin
+	StablecoinId string `json:"stablecoinId"`
+}
+
+// ModelsStablecoinBalances defines model for models_StablecoinBalances.
+type ModelsStablecoinBalances = []ModelsStablecoinBalance
+
+// ModelsStablecoinBanknote defines model for models_StablecoinBanknote.
+type ModelsStablecoinBanknote struct {
+	// Amount Amount of tokens in the banknote
+	Amount uint64 `json:"amount"`
+
+	// CreatedAt Time when the banknote was received
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Serial Serial number (token ID) of the banknote
+	Serial string `json:"serial"`
+
+	// StablecoinId Identifier of the stablecoin the banknote belongs to
+	StablecoinId string `json:"stablecoinId"`
+
+	// TxID ID of the transaction holding the banknote
+	TxID string `json:"txID"`
+
+	// Vout Index of the output holding the banknote
+	Vout uint32 `json:"vout"`
+}
+
+// ModelsStablecoinBanknotesSearchResult defines model for models_StablecoinBanknotesSearchResult.
+type ModelsStablecoinBanknotesSearchResult struct {
+	Content []ModelsStablecoinBanknote `json:"content"`
+	Page    ModelsSearchPage           `json:"page"`
+}
+
 // ModelsTransactionHex defines model for models_TransactionHex.
 type ModelsTransactionHex struct {
 	// Format Transaction format
@@ -577,11 +879,35 @@ type ModelsTransactionHexFormat string
 
 // ModelsUser defines model for models_User.
 type ModelsUser struct {
-	CreatedAt time.Time       `json:"createdAt"`
-	Id        string          `json:"id"`
-	Paymails  []ModelsPaymail `json:"paymails"`
-	PublicKey string          `json:"publicKey"`
-	UpdatedAt time.Time       `json:"updatedAt"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeactivatedAt Set when the user is deactivated; a deactivated user cannot authenticate and its paymails are not resolvable
+	DeactivatedAt *time.Time      `json:"deactivatedAt,omitempty"`
+	Id            string          `json:"id"`
+	Paymails      []ModelsPaymail `json:"paymails"`
+	PublicKey     string          `json:"publicKey"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+}
+
+// ModelsUserBalance defines model for models_UserBalance.
+type ModelsUserBalance struct {
+	// At Point in time of the balance; missing if the balance is computed at the block height
+	At *time.Time `json:"at,omitempty"`
+
+	// BlockHeight Block height of the balance; missing if the balance is computed at the point in time
+	BlockHeight *int64 `json:"blockHeight,omitempty"`
+
+	// Confirmed Part of the balance made of operations on mined transactions
+	Confirmed int64 `json:"confirmed"`
+
+	// History Balances at the end of each day of the requested history range
+	History *[]ModelsDailyBalance `json:"history,omitempty"`
+
+	// Total Balance of user made of all operations (except those on reverted transactions)
+	Total int64 `json:"total"`
+
+	// Unconfirmed Part of the balance made of operations on transactions which are not mined yet
+	Unconfirmed int64 `json:"unconfirmed"`
 }
 
 // ModelsUserDefinedCustomInstructions Instructions about how to unlock this input.
@@ -589,8 +915,20 @@ type ModelsUserDefinedCustomInstructions = string
 
 // ModelsUserInfo defines model for models_UserInfo.
 type ModelsUserInfo struct {
+	// ConfirmedBalance Balance of user made of transactions with at least the required number of confirmations
+	ConfirmedBalance uint64 `json:"confirmedBalance"`
+
 	// CurrentBalance Current balance of user
 	CurrentBalance uint64 `json:"currentBalance"`
+
+	// RequiredConfirmations Number of confirmations after which a transaction is counted into the confirmed balance
+	RequiredConfirmations uint32 `json:"requiredConfirmations"`
+}
+
+// ModelsUsersSearchResult defines model for models_UsersSearchResult.
+type ModelsUsersSearchResult struct {
+	Content []ModelsUser     `json:"content"`
+	Page    ModelsSearchPage `json:"page"`
 }
 
 // RequestsAddPaymail defines model for requests_AddPaymail.
@@ -604,6 +942,19 @@ type RequestsAddPaymail struct {
 	PublicName *string `json:"publicName,omitempty"`
 }
 
+// RequestsAddressOutputSpecification Pays the satoshis to the P2PKH address. <br>
+// The output is annotated only when the address belongs to the user.
+type RequestsAddressOutputSpecification struct {
+	Satoshis uint64 `json:"satoshis"`
+
+	// To Mainnet P2PKH address of the receiver.
+	To   string                                 `json:"to"`
+	Type RequestsAddressOutputSpecificationType `json:"type"`
+}
+
+// RequestsAddressOutputSpecificationType defines model for RequestsAddressOutputSpecification.Type.
+type RequestsAddressOutputSpecificationType string
+
 // RequestsCreateUser defines model for requests_CreateUser.
 type RequestsCreateUser struct {
 	Paymail   *RequestsAddPaymail `json:"paymail,omitempty"`
@@ -634,6 +985,12 @@ type RequestsOpReturnOutputSpecificationType string
 // RequestsOpReturnStringsOutput defines model for requests_OpReturnStringsOutput.
 type RequestsOpReturnStringsOutput = []string
 
+// RequestsOutpoint defines model for requests_Outpoint.
+type RequestsOutpoint struct {
+	TxID string `json:"txID"`
+	Vout uint32 `json:"vout"`
+}
+
 // RequestsPaymailOutputSpecification defines model for requests_PaymailOutputSpecification.
 type RequestsPaymailOutputSpecification struct {
 	From     *string `json:"from"`
@@ -650,6 +1007,41 @@ type RequestsPaymailOutputSpecification struct {
 // RequestsPaymailOutputSpecificationType defines model for RequestsPaymailOutputSpecification.Type.
 type RequestsPaymailOutputSpecificationType string
 
+// RequestsScriptOutputSpecification Pays the satoshis to the arbitrary locking script. <br>
+// The output is annotated only when it is a P2PKH script of the user's address.
+type RequestsScriptOutputSpecification struct {
+	// Satoshis Value of the output, can be zero only for data (OP_RETURN) scripts.
+	Satoshis *uint64 `json:"satoshis,omitempty"`
+
+	// Script Hex of the locking script, up to 100000 bytes.
+	Script string                                `json:"script"`
+	Type   RequestsScriptOutputSpecificationType `json:"type"`
+}
+
+// RequestsScriptOutputSpecificationType defines model for RequestsScriptOutputSpecification.Type.
+type RequestsScriptOutputSpecificationType string
+
+// RequestsSweepOutputSpecification Transfers all user's funds, left after the other outputs and the fee, to the paymail or address. <br>
+// The sweep output is placed after the other outputs and the transaction has no change output. <br>
+// Warning: Only one sweep output is allowed and it cannot be combined with inputs outpoints.
+type RequestsSweepOutputSpecification struct {
+	// From Sender paymail, used only when sweeping to paymail.
+	From *string `json:"from"`
+
+	// MaxSatoshis Skip user's UTXOs with higher value.
+	MaxSatoshis *uint64 `json:"maxSatoshis,omitempty"`
+
+	// MinSatoshis Skip user's UTXOs with lower value.
+	MinSatoshis *uint64 `json:"minSatoshis,omitempty"`
+
+	// To Paymail or address of the receiver.
+	To   string                               `json:"to"`
+	Type RequestsSweepOutputSpecificationType `json:"type"`
+}
+
+// RequestsSweepOutputSpecificationType defines model for RequestsSweepOutputSpecification.Type.
+type RequestsSweepOutputSpecificationType string
+
 // RequestsTransactionOutline defines model for requests_TransactionOutline.
 type RequestsTransactionOutline struct {
 	Annotations *ModelsOutputsAnnotations `json:"annotations,omitempty"`
@@ -659,11 +1051,69 @@ type RequestsTransactionOutline struct {
 
 	// Hex Transaction hex
 	Hex string `json:"hex"`
+
+	// ReservationID ID of the reservation of the UTXOs returned with the transaction outline, released when the transaction is recorded.
+	ReservationID *string `json:"reservationID,omitempty"`
 }
 
 // RequestsTransactionOutlineFormat Transaction format
 type RequestsTransactionOutlineFormat string
 
+// RequestsTransactionOutlineChangeSpecification Specification of the change outputs of the transaction. <br>
+// Without any options provided, the change is returned to the user in a single output.
+type RequestsTransactionOutlineChangeSpecification struct {
+	// Disabled Whether to suppress the change outputs, so the whole change is left to the miners as a fee.
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// MinimumSatoshis The dust threshold for the change outputs. <br>
+	// The change lower than this threshold is folded into the fee.
+	MinimumSatoshis *uint64 `json:"minimumSatoshis,omitempty"`
+
+	// Outputs The number of outputs the change is split into. <br>
+	// Warning: The change is split into fewer outputs when a single output would get less than minimumSatoshis.
+	Outputs *uint64 `json:"outputs,omitempty"`
+
+	// Strategy Strategy of distributing the change among the outputs.
+	Strategy *RequestsTransactionOutlineChangeSpecificationStrategy `json:"strategy,omitempty"`
+}
+
+// RequestsTransactionOutlineChangeSpecificationStrategy Strategy of distributing the change among the outputs.
+type RequestsTransactionOutlineChangeSpecificationStrategy string
+
+// RequestsTransactionOutlineInputsSpecification Specification of the inputs of the transaction. <br>
+// Without outpoints provided, the inputs are selected automatically from the user's UTXOs.
+type RequestsTransactionOutlineInputsSpecification struct {
+	// Exclude User's UTXOs which must not be selected automatically.
+	Exclude *[]RequestsOutpoint `json:"exclude,omitempty"`
+
+	// Outpoints User's UTXOs which must be spent by the transaction.
+	Outpoints *[]RequestsOutpoint `json:"outpoints,omitempty"`
+
+	// SelectRemaining Whether to select more user's UTXOs when the provided outpoints don't cover the outputs and the fee. <br>
+	// When false, the transaction is funded only by the provided outpoints.
+	SelectRemaining *bool `json:"selectRemaining,omitempty"`
+
+	// Strategy Strategy of selecting the user's UTXOs automatically. <br>
+	// When not provided, the strategy configured for the wallet is used.
+	// - oldest_first: the least recently used UTXOs first
+	// - largest_first: the UTXOs with the highest value first, so the transaction has as few inputs as possible
+	// - smallest_first: the UTXOs with the lowest value first, which consolidates the dust
+	// - branch_and_bound: the UTXOs matching the outputs and the fee so exactly that no change output is needed,
+	//   falls back to oldest_first when there is no such match
+	// - random: the UTXOs in random order
+	Strategy *RequestsTransactionOutlineInputsSpecificationStrategy `json:"strategy,omitempty"`
+}
+
+// RequestsTransactionOutlineInputsSpecificationStrategy Strategy of selecting the user's UTXOs automatically. <br>
+// When not provided, the strategy configured for the wallet is used.
+//   - oldest_first: the least recently used UTXOs first
+//   - largest_first: the UTXOs with the highest value first, so the transaction has as few inputs as possible
+//   - smallest_first: the UTXOs with the lowest value first, which consolidates the dust
+//   - branch_and_bound: the UTXOs matching the outputs and the fee so exactly that no change output is needed,
+//     falls back to oldest_first when there is no such match
+//   - random: the UTXOs in random order
+type RequestsTransactionOutlineInputsSpecificationStrategy string
+
 // RequestsTransactionOutlineOutputSpecification defines model for requests_TransactionOutlineOutputSpecification.
 type RequestsTransactionOutlineOutputSpecification struct {
 	union json.RawMessage
@@ -671,9 +1121,31 @@ type RequestsTransactionOutlineOutputSpecification struct {
 
 // RequestsTransactionSpecification defines model for requests_TransactionSpecification.
 type RequestsTransactionSpecification struct {
+	// Change Specification of the change outputs of the transaction. <br>
+	// Without any options provided, the change is returned to the user in a single output.
+	Change *RequestsTransactionOutlineChangeSpecification `json:"change,omitempty"`
+
+	// Inputs Specification of the inputs of the transaction. <br>
+	// Without outpoints provided, the inputs are selected automatically from the user's UTXOs.
+	Inputs  *RequestsTransactionOutlineInputsSpecification  `json:"inputs,omitempty"`
 	Outputs []RequestsTransactionOutlineOutputSpecification `json:"outputs"`
 }
 
+// RequestsUpdatePaymail defines model for requests_UpdatePaymail.
+type RequestsUpdatePaymail struct {
+	AvatarURL  *string `json:"avatarURL,omitempty"`
+	PublicName *string `json:"publicName,omitempty"`
+}
+
+// RequestsExportFormat defines model for requests_ExportFormat.
+type RequestsExportFormat string
+
+// RequestsExportFrom defines model for requests_ExportFrom.
+type RequestsExportFrom = time.Time
+
+// RequestsExportTo defines model for requests_ExportTo.
+type RequestsExportTo = time.Time
+
 // RequestsPageNumber defines model for requests_PageNumber.
 type RequestsPageNumber = int
 
@@ -686,6 +1158,9 @@ type RequestsSort = string
 // RequestsSortBy defines model for requests_SortBy.
 type RequestsSortBy = string
 
+// RequestsStablecoinID defines model for requests_StablecoinID.
+type RequestsStablecoinID = string
+
 // ResponsesAdminAddPaymailSuccess defines model for responses_AdminAddPaymailSuccess.
 type ResponsesAdminAddPaymailSuccess = ModelsPaymail
 
@@ -706,11 +1181,31 @@ type ResponsesAdminInvalidAvatarURL struct {
 	union json.RawMessage
 }
 
+// ResponsesAdminPaymailNotFound defines model for responses_AdminPaymailNotFound.
+type ResponsesAdminPaymailNotFound = ErrorsPaymailNotFound
+
+// ResponsesAdminSearchUsersBadRequest defines model for responses_AdminSearchUsersBadRequest.
+type ResponsesAdminSearchUsersBadRequest = ErrorsInvalidConditions
+
+// ResponsesAdminSearchUsersSuccess defines model for responses_AdminSearchUsersSuccess.
+type ResponsesAdminSearchUsersSuccess = ModelsUsersSearchResult
+
+// ResponsesAdminUpdatePaymailSuccess defines model for responses_AdminUpdatePaymailSuccess.
+type ResponsesAdminUpdatePaymailSuccess = ModelsPaymail
+
 // ResponsesAdminUserBadRequest defines model for responses_AdminUserBadRequest.
 type ResponsesAdminUserBadRequest struct {
 	union json.RawMessage
 }
 
+// ResponsesAdminUserNotFound defines model for responses_AdminUserNotFound.
+type ResponsesAdminUserNotFound = ErrorsUserNotFound
+
+// ResponsesCancelTransactionOutlineReservationBadRequest defines model for responses_CancelTransactionOutlineReservationBadRequest.
+type ResponsesCancelTransactionOutlineReservationBadRequest struct {
+	union json.RawMessage
+}
+
 // ResponsesCreateTransactionOutlineBadRequest defines model for responses_CreateTransactionOutlineBadRequest.
 type ResponsesCreateTransactionOutlineBadRequest struct {
 	union json.RawMessage
@@ -724,6 +1219,11 @@ type ResponsesCreateTransactionOutlineUnprocessable struct {
 	union json.RawMessage
 }
 
+// ResponsesExportHistoryBadRequest defines model for responses_ExportHistoryBadRequest.
+type ResponsesExportHistoryBadRequest struct {
+	union json.RawMessage
+}
+
 // ResponsesGetCurrentUserSuccess defines model for responses_GetCurrentUserSuccess.
 type ResponsesGetCurrentUserSuccess = ModelsUserInfo
 
@@ -752,6 +1252,26 @@ type ResponsesGetMerklerootsNotFound = ErrorsMerkleRootNotFound
 // ResponsesGetMerklerootsSuccess defines model for responses_GetMerklerootsSuccess.
 type ResponsesGetMerklerootsSuccess = ModelsGetMerkleRootResult
 
+// ResponsesGetOperationBadRequest defines model for responses_GetOperationBadRequest.
+type ResponsesGetOperationBadRequest = ErrorsInvalidTransactionID
+
+// ResponsesGetOperationNotFound defines model for responses_GetOperationNotFound.
+type ResponsesGetOperationNotFound = ErrorsOperationNotFound
+
+// ResponsesGetOperationSuccess defines model for responses_GetOperationSuccess.
+type ResponsesGetOperationSuccess = ModelsOperationDetails
+
+// ResponsesGetStablecoinBalancesSuccess defines model for responses_GetStablecoinBalancesSuccess.
+type ResponsesGetStablecoinBalancesSuccess = ModelsStablecoinBalances
+
+// ResponsesGetUserBalanceBadRequest defines model for responses_GetUserBalanceBadRequest.
+type ResponsesGetUserBalanceBadRequest struct {
+	union json.RawMessage
+}
+
+// ResponsesGetUserBalanceSuccess defines model for responses_GetUserBalanceSuccess.
+type ResponsesGetUserBalanceSuccess = ModelsUserBalance
+
 // ResponsesInternalServerError defines model for responses_InternalServerError.
 type ResponsesInternalServerError = ErrorsInternal
 
@@ -774,12 +1294,20 @@ type ResponsesRecordTransactionInternalServerError struct {
 // ResponsesRecordTransactionSuccess defines model for responses_RecordTransactionSuccess.
 type ResponsesRecordTransactionSuccess = ModelsRecordedOutline
 
+// ResponsesRecordTransactionUnprocessable defines model for responses_RecordTransactionUnprocessable.
+type ResponsesRecordTransactionUnprocessable struct {
+	union json.RawMessage
+}
+
 // ResponsesSearchBadRequest defines model for responses_SearchBadRequest.
 type ResponsesSearchBadRequest = ErrorsInvalidDataID
 
 // ResponsesSearchOperationsSuccess defines model for responses_SearchOperationsSuccess.
 type ResponsesSearchOperationsSuccess = ModelsOperationsSearchResult
 
+// ResponsesSearchStablecoinBanknotesSuccess defines model for responses_SearchStablecoinBanknotesSuccess.
+type ResponsesSearchStablecoinBanknotesSuccess = ModelsStablecoinBanknotesSearchResult
+
 // ResponsesSharedConfig Shared config
 type ResponsesSharedConfig = ModelsSharedConfig
 
@@ -789,6 +1317,54 @@ type ResponsesUserBadRequest = ErrorsInvalidDataID
 // ResponsesUserNotAuthorized defines model for responses_UserNotAuthorized.
 type ResponsesUserNotAuthorized = ErrorsUserAuthorization
 
+// AdminExportOperationsParams defines parameters for AdminExportOperations.
+type AdminExportOperationsParams struct {
+	// UserId Export only operations of the user with this ID
+	UserId *string `form:"userId,omitempty" json:"userId,omitempty"`
+
+	// Format Format of the export
+	Format *AdminExportOperationsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Export only operations created at or after this time
+	From *RequestsExportFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Export only operations created at or before this time
+	To *RequestsExportTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// AdminExportOperationsParamsFormat defines parameters for AdminExportOperations.
+type AdminExportOperationsParamsFormat string
+
+// SearchUsersParams defines parameters for SearchUsers.
+type SearchUsersParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// PublicKey Public key of the user
+	PublicKey *string `form:"publicKey,omitempty" json:"publicKey,omitempty"`
+
+	// Paymail Return only users owning this paymail address
+	Paymail *string `form:"paymail,omitempty" json:"paymail,omitempty"`
+
+	// CreatedFrom Return only users created at or after this time
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Return only users created at or before this time
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// Active Return only active (true) or only deactivated (false) users
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+}
+
 // MerkleRootsParams defines parameters for MerkleRoots.
 type MerkleRootsParams struct {
 	// BatchSize Batch size of merkleroots to be returned
@@ -798,6 +1374,21 @@ type MerkleRootsParams struct {
 	LastEvaluatedKey *string `form:"lastEvaluatedKey,omitempty" json:"lastEvaluatedKey,omitempty"`
 }
 
+// ExportOperationsParams defines parameters for ExportOperations.
+type ExportOperationsParams struct {
+	// Format Format of the export
+	Format *ExportOperationsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Export only operations created at or after this time
+	From *RequestsExportFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Export only operations created at or before this time
+	To *RequestsExportTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ExportOperationsParamsFormat defines parameters for ExportOperations.
+type ExportOperationsParamsFormat string
+
 // SearchOperationsParams defines parameters for SearchOperations.
 type SearchOperationsParams struct {
 	// Page Page number for pagination
@@ -811,10 +1402,67 @@ type SearchOperationsParams struct {
 
 	// SortBy Field to sort by
 	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
-}
 
-// CreateTransactionOutlineParams defines parameters for CreateTransactionOutline.
-type CreateTransactionOutlineParams struct {
+	// Type Type of the operation
+	Type *SearchOperationsParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Counterparty Counterparty of the operation (e.g. paymail of the sender or receiver), matched exactly but case-insensitively
+	Counterparty *string `form:"counterparty,omitempty" json:"counterparty,omitempty"`
+
+	// MinValue Minimal value of the operation in satoshis (outgoing operations have negative values)
+	MinValue *int64 `form:"minValue,omitempty" json:"minValue,omitempty"`
+
+	// MaxValue Maximal value of the operation in satoshis (outgoing operations have negative values)
+	MaxValue *int64 `form:"maxValue,omitempty" json:"maxValue,omitempty"`
+
+	// CreatedFrom Return only operations created at or after this time
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
+
+	// CreatedTo Return only operations created at or before this time
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
+
+	// TxStatus Status of the underlying transaction
+	TxStatus *SearchOperationsParamsTxStatus `form:"txStatus,omitempty" json:"txStatus,omitempty"`
+}
+
+// SearchOperationsParamsType defines parameters for SearchOperations.
+type SearchOperationsParamsType string
+
+// SearchOperationsParamsTxStatus defines parameters for SearchOperations.
+type SearchOperationsParamsTxStatus string
+
+// OperationByTxIDParams defines parameters for OperationByTxID.
+type OperationByTxIDParams struct {
+	// IncludeHex Include BEEF or raw hex of the transaction in the response
+	IncludeHex *bool `form:"includeHex,omitempty" json:"includeHex,omitempty"`
+}
+
+// StablecoinBalancesParams defines parameters for StablecoinBalances.
+type StablecoinBalancesParams struct {
+	// StablecoinId Stablecoin identifier
+	StablecoinId *RequestsStablecoinID `form:"stablecoinId,omitempty" json:"stablecoinId,omitempty"`
+}
+
+// SearchStablecoinBanknotesParams defines parameters for SearchStablecoinBanknotes.
+type SearchStablecoinBanknotesParams struct {
+	// Page Page number for pagination
+	Page *RequestsPageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *RequestsPageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Sort Sorting order (asc or desc)
+	Sort *RequestsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy *RequestsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// StablecoinId Stablecoin identifier
+	StablecoinId *RequestsStablecoinID `form:"stablecoinId,omitempty" json:"stablecoinId,omitempty"`
+}
+
+// CreateTransactionOutlineParams defines parameters for CreateTransactionOutline.
+type CreateTransactionOutlineParams struct {
 	// Format Required format of transaction hex
 	Format *CreateTransactionOutlineParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 }
@@ -822,12 +1470,30 @@ type CreateTransactionOutlineParams struct {
 // CreateTransactionOutlineParamsFormat defines parameters for CreateTransactionOutline.
 type CreateTransactionOutlineParamsFormat string
 
+// CurrentUserBalanceParams defines parameters for CurrentUserBalance.
+type CurrentUserBalanceParams struct {
+	// At Return the balance made of operations created at or before this time
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+
+	// BlockHeight Return the balance made of transactions mined at or below this block height
+	BlockHeight *int64 `form:"blockHeight,omitempty" json:"blockHeight,omitempty"`
+
+	// HistoryFrom Return also the balances at the end of each day starting from this day
+	HistoryFrom *openapi_types.Date `form:"historyFrom,omitempty" json:"historyFrom,omitempty"`
+
+	// HistoryTo Last day of the balance history (today by default)
+	HistoryTo *openapi_types.Date `form:"historyTo,omitempty" json:"historyTo,omitempty"`
+}
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = RequestsCreateUser
 
 // AddPaymailToUserJSONRequestBody defines body for AddPaymailToUser for application/json ContentType.
 type AddPaymailToUserJSONRequestBody = RequestsAddPaymail
 
+// UpdateUserPaymailJSONRequestBody defines body for UpdateUserPaymail for application/json ContentType.
+type UpdateUserPaymailJSONRequestBody = RequestsUpdatePaymail
+
 // RecordTransactionOutlineJSONRequestBody defines body for RecordTransactionOutline for application/json ContentType.
 type RecordTransactionOutlineJSONRequestBody = RequestsTransactionOutline
 
@@ -1226,6 +1892,90 @@ func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsPaymailOutp
 	return err
 }
 
+// AsRequestsSweepOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsSweepOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsSweepOutputSpecification() (RequestsSweepOutputSpecification, error) {
+	var body RequestsSweepOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsSweepOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsSweepOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsSweepOutputSpecification(v RequestsSweepOutputSpecification) error {
+	v.Type = "sweep"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsSweepOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsSweepOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsSweepOutputSpecification(v RequestsSweepOutputSpecification) error {
+	v.Type = "sweep"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsAddressOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsAddressOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsAddressOutputSpecification() (RequestsAddressOutputSpecification, error) {
+	var body RequestsAddressOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsAddressOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsAddressOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsAddressOutputSpecification(v RequestsAddressOutputSpecification) error {
+	v.Type = "address"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsAddressOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsAddressOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsAddressOutputSpecification(v RequestsAddressOutputSpecification) error {
+	v.Type = "address"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRequestsScriptOutputSpecification returns the union data inside the RequestsTransactionOutlineOutputSpecification as a RequestsScriptOutputSpecification
+func (t RequestsTransactionOutlineOutputSpecification) AsRequestsScriptOutputSpecification() (RequestsScriptOutputSpecification, error) {
+	var body RequestsScriptOutputSpecification
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRequestsScriptOutputSpecification overwrites any union data inside the RequestsTransactionOutlineOutputSpecification as the provided RequestsScriptOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) FromRequestsScriptOutputSpecification(v RequestsScriptOutputSpecification) error {
+	v.Type = "script"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRequestsScriptOutputSpecification performs a merge with any union data inside the RequestsTransactionOutlineOutputSpecification, using the provided RequestsScriptOutputSpecification
+func (t *RequestsTransactionOutlineOutputSpecification) MergeRequestsScriptOutputSpecification(v RequestsScriptOutputSpecification) error {
+	v.Type = "script"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t RequestsTransactionOutlineOutputSpecification) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
@@ -1240,10 +1990,16 @@ func (t RequestsTransactionOutlineOutputSpecification) ValueByDiscriminator() (i
 		return nil, err
 	}
 	switch discriminator {
+	case "address":
+		return t.AsRequestsAddressOutputSpecification()
 	case "op_return":
 		return t.AsRequestsOpReturnOutputSpecification()
 	case "paymail":
 		return t.AsRequestsPaymailOutputSpecification()
+	case "script":
+		return t.AsRequestsScriptOutputSpecification()
+	case "sweep":
+		return t.AsRequestsSweepOutputSpecification()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -1435,6 +2191,42 @@ func (t *ResponsesAdminUserBadRequest) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsErrorsTxOutlineReservationIDRequired returns the union data inside the ResponsesCancelTransactionOutlineReservationBadRequest as a ErrorsTxOutlineReservationIDRequired
+func (t ResponsesCancelTransactionOutlineReservationBadRequest) AsErrorsTxOutlineReservationIDRequired() (ErrorsTxOutlineReservationIDRequired, error) {
+	var body ErrorsTxOutlineReservationIDRequired
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsTxOutlineReservationIDRequired overwrites any union data inside the ResponsesCancelTransactionOutlineReservationBadRequest as the provided ErrorsTxOutlineReservationIDRequired
+func (t *ResponsesCancelTransactionOutlineReservationBadRequest) FromErrorsTxOutlineReservationIDRequired(v ErrorsTxOutlineReservationIDRequired) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsTxOutlineReservationIDRequired performs a merge with any union data inside the ResponsesCancelTransactionOutlineReservationBadRequest, using the provided ErrorsTxOutlineReservationIDRequired
+func (t *ResponsesCancelTransactionOutlineReservationBadRequest) MergeErrorsTxOutlineReservationIDRequired(v ErrorsTxOutlineReservationIDRequired) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesCancelTransactionOutlineReservationBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesCancelTransactionOutlineReservationBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsTxSpecNoDefaultPaymailAddress returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecNoDefaultPaymailAddress
 func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecNoDefaultPaymailAddress() (ErrorsTxSpecNoDefaultPaymailAddress, error) {
 	var body ErrorsTxSpecNoDefaultPaymailAddress
@@ -1591,32 +2383,22 @@ func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidPa
 	return err
 }
 
-func (t ResponsesCreateTransactionOutlineBadRequest) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *ResponsesCreateTransactionOutlineBadRequest) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsErrorsTxOutlineUserHasNotEnoughFunds returns the union data inside the ResponsesCreateTransactionOutlineUnprocessable as a ErrorsTxOutlineUserHasNotEnoughFunds
-func (t ResponsesCreateTransactionOutlineUnprocessable) AsErrorsTxOutlineUserHasNotEnoughFunds() (ErrorsTxOutlineUserHasNotEnoughFunds, error) {
-	var body ErrorsTxOutlineUserHasNotEnoughFunds
+// AsErrorsTxSpecInvalidAddress returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecInvalidAddress
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecInvalidAddress() (ErrorsTxSpecInvalidAddress, error) {
+	var body ErrorsTxSpecInvalidAddress
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsTxOutlineUserHasNotEnoughFunds overwrites any union data inside the ResponsesCreateTransactionOutlineUnprocessable as the provided ErrorsTxOutlineUserHasNotEnoughFunds
-func (t *ResponsesCreateTransactionOutlineUnprocessable) FromErrorsTxOutlineUserHasNotEnoughFunds(v ErrorsTxOutlineUserHasNotEnoughFunds) error {
+// FromErrorsTxSpecInvalidAddress overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecInvalidAddress
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecInvalidAddress(v ErrorsTxSpecInvalidAddress) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsTxOutlineUserHasNotEnoughFunds performs a merge with any union data inside the ResponsesCreateTransactionOutlineUnprocessable, using the provided ErrorsTxOutlineUserHasNotEnoughFunds
-func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsTxOutlineUserHasNotEnoughFunds(v ErrorsTxOutlineUserHasNotEnoughFunds) error {
+// MergeErrorsTxSpecInvalidAddress performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecInvalidAddress
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecInvalidAddress(v ErrorsTxSpecInvalidAddress) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1627,32 +2409,22 @@ func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsTxOutlineUse
 	return err
 }
 
-func (t ResponsesCreateTransactionOutlineUnprocessable) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *ResponsesCreateTransactionOutlineUnprocessable) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsErrorsDataNotFound returns the union data inside the ResponsesGetDataNotFound as a ErrorsDataNotFound
-func (t ResponsesGetDataNotFound) AsErrorsDataNotFound() (ErrorsDataNotFound, error) {
-	var body ErrorsDataNotFound
+// AsErrorsTxSpecUnsupportedAddressNetwork returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecUnsupportedAddressNetwork
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecUnsupportedAddressNetwork() (ErrorsTxSpecUnsupportedAddressNetwork, error) {
+	var body ErrorsTxSpecUnsupportedAddressNetwork
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsDataNotFound overwrites any union data inside the ResponsesGetDataNotFound as the provided ErrorsDataNotFound
-func (t *ResponsesGetDataNotFound) FromErrorsDataNotFound(v ErrorsDataNotFound) error {
+// FromErrorsTxSpecUnsupportedAddressNetwork overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecUnsupportedAddressNetwork
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecUnsupportedAddressNetwork(v ErrorsTxSpecUnsupportedAddressNetwork) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsDataNotFound performs a merge with any union data inside the ResponsesGetDataNotFound, using the provided ErrorsDataNotFound
-func (t *ResponsesGetDataNotFound) MergeErrorsDataNotFound(v ErrorsDataNotFound) error {
+// MergeErrorsTxSpecUnsupportedAddressNetwork performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecUnsupportedAddressNetwork
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecUnsupportedAddressNetwork(v ErrorsTxSpecUnsupportedAddressNetwork) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1663,32 +2435,22 @@ func (t *ResponsesGetDataNotFound) MergeErrorsDataNotFound(v ErrorsDataNotFound)
 	return err
 }
 
-func (t ResponsesGetDataNotFound) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *ResponsesGetDataNotFound) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsErrorsBHSUnreachable returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnreachable
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnreachable() (ErrorsBHSUnreachable, error) {
-	var body ErrorsBHSUnreachable
+// AsErrorsTxSpecScriptRequired returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecScriptRequired
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecScriptRequired() (ErrorsTxSpecScriptRequired, error) {
+	var body ErrorsTxSpecScriptRequired
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSUnreachable overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnreachable
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnreachable(v ErrorsBHSUnreachable) error {
+// FromErrorsTxSpecScriptRequired overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecScriptRequired
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecScriptRequired(v ErrorsTxSpecScriptRequired) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSUnreachable performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnreachable
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnreachable(v ErrorsBHSUnreachable) error {
+// MergeErrorsTxSpecScriptRequired performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecScriptRequired
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecScriptRequired(v ErrorsTxSpecScriptRequired) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1699,22 +2461,22 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnreachable(v
 	return err
 }
 
-// AsErrorsBHSNoSuccessResponse returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSNoSuccessResponse
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSNoSuccessResponse() (ErrorsBHSNoSuccessResponse, error) {
-	var body ErrorsBHSNoSuccessResponse
+// AsErrorsTxSpecScriptTooLarge returns the union data inside the ResponsesCreateTransactionOutlineBadRequest as a ErrorsTxSpecScriptTooLarge
+func (t ResponsesCreateTransactionOutlineBadRequest) AsErrorsTxSpecScriptTooLarge() (ErrorsTxSpecScriptTooLarge, error) {
+	var body ErrorsTxSpecScriptTooLarge
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSNoSuccessResponse overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSNoSuccessResponse
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSNoSuccessResponse(v ErrorsBHSNoSuccessResponse) error {
+// FromErrorsTxSpecScriptTooLarge overwrites any union data inside the ResponsesCreateTransactionOutlineBadRequest as the provided ErrorsTxSpecScriptTooLarge
+func (t *ResponsesCreateTransactionOutlineBadRequest) FromErrorsTxSpecScriptTooLarge(v ErrorsTxSpecScriptTooLarge) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSNoSuccessResponse performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSNoSuccessResponse
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSNoSuccessResponse(v ErrorsBHSNoSuccessResponse) error {
+// MergeErrorsTxSpecScriptTooLarge performs a merge with any union data inside the ResponsesCreateTransactionOutlineBadRequest, using the provided ErrorsTxSpecScriptTooLarge
+func (t *ResponsesCreateTransactionOutlineBadRequest) MergeErrorsTxSpecScriptTooLarge(v ErrorsTxSpecScriptTooLarge) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1725,22 +2487,32 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSNoSuccessResp
 	return err
 }
 
-// AsErrorsBHSUnauthorized returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnauthorized
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnauthorized() (ErrorsBHSUnauthorized, error) {
-	var body ErrorsBHSUnauthorized
+func (t ResponsesCreateTransactionOutlineBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesCreateTransactionOutlineBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsTxOutlineUserHasNotEnoughFunds returns the union data inside the ResponsesCreateTransactionOutlineUnprocessable as a ErrorsTxOutlineUserHasNotEnoughFunds
+func (t ResponsesCreateTransactionOutlineUnprocessable) AsErrorsTxOutlineUserHasNotEnoughFunds() (ErrorsTxOutlineUserHasNotEnoughFunds, error) {
+	var body ErrorsTxOutlineUserHasNotEnoughFunds
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSUnauthorized overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnauthorized
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnauthorized(v ErrorsBHSUnauthorized) error {
+// FromErrorsTxOutlineUserHasNotEnoughFunds overwrites any union data inside the ResponsesCreateTransactionOutlineUnprocessable as the provided ErrorsTxOutlineUserHasNotEnoughFunds
+func (t *ResponsesCreateTransactionOutlineUnprocessable) FromErrorsTxOutlineUserHasNotEnoughFunds(v ErrorsTxOutlineUserHasNotEnoughFunds) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSUnauthorized performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnauthorized
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnauthorized(v ErrorsBHSUnauthorized) error {
+// MergeErrorsTxOutlineUserHasNotEnoughFunds performs a merge with any union data inside the ResponsesCreateTransactionOutlineUnprocessable, using the provided ErrorsTxOutlineUserHasNotEnoughFunds
+func (t *ResponsesCreateTransactionOutlineUnprocessable) MergeErrorsTxOutlineUserHasNotEnoughFunds(v ErrorsTxOutlineUserHasNotEnoughFunds) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1751,22 +2523,32 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnauthorized(
 	return err
 }
 
-// AsErrorsBHSBadRequest returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSBadRequest
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSBadRequest() (ErrorsBHSBadRequest, error) {
-	var body ErrorsBHSBadRequest
+func (t ResponsesCreateTransactionOutlineUnprocessable) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesCreateTransactionOutlineUnprocessable) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsCannotParseQueryParams returns the union data inside the ResponsesExportHistoryBadRequest as a ErrorsCannotParseQueryParams
+func (t ResponsesExportHistoryBadRequest) AsErrorsCannotParseQueryParams() (ErrorsCannotParseQueryParams, error) {
+	var body ErrorsCannotParseQueryParams
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSBadRequest overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSBadRequest
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSBadRequest(v ErrorsBHSBadRequest) error {
+// FromErrorsCannotParseQueryParams overwrites any union data inside the ResponsesExportHistoryBadRequest as the provided ErrorsCannotParseQueryParams
+func (t *ResponsesExportHistoryBadRequest) FromErrorsCannotParseQueryParams(v ErrorsCannotParseQueryParams) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSBadRequest performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSBadRequest
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadRequest(v ErrorsBHSBadRequest) error {
+// MergeErrorsCannotParseQueryParams performs a merge with any union data inside the ResponsesExportHistoryBadRequest, using the provided ErrorsCannotParseQueryParams
+func (t *ResponsesExportHistoryBadRequest) MergeErrorsCannotParseQueryParams(v ErrorsCannotParseQueryParams) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1777,22 +2559,22 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadRequest(v
 	return err
 }
 
-// AsErrorsBHSUnhealthy returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnhealthy
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnhealthy() (ErrorsBHSUnhealthy, error) {
-	var body ErrorsBHSUnhealthy
+// AsErrorsExportFormatInvalid returns the union data inside the ResponsesExportHistoryBadRequest as a ErrorsExportFormatInvalid
+func (t ResponsesExportHistoryBadRequest) AsErrorsExportFormatInvalid() (ErrorsExportFormatInvalid, error) {
+	var body ErrorsExportFormatInvalid
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSUnhealthy overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnhealthy
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnhealthy(v ErrorsBHSUnhealthy) error {
+// FromErrorsExportFormatInvalid overwrites any union data inside the ResponsesExportHistoryBadRequest as the provided ErrorsExportFormatInvalid
+func (t *ResponsesExportHistoryBadRequest) FromErrorsExportFormatInvalid(v ErrorsExportFormatInvalid) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSUnhealthy performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnhealthy
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnhealthy(v ErrorsBHSUnhealthy) error {
+// MergeErrorsExportFormatInvalid performs a merge with any union data inside the ResponsesExportHistoryBadRequest, using the provided ErrorsExportFormatInvalid
+func (t *ResponsesExportHistoryBadRequest) MergeErrorsExportFormatInvalid(v ErrorsExportFormatInvalid) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1803,22 +2585,22 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnhealthy(v E
 	return err
 }
 
-// AsErrorsBHSBadURL returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSBadURL
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSBadURL() (ErrorsBHSBadURL, error) {
-	var body ErrorsBHSBadURL
+// AsErrorsExportTimeRangeInvalid returns the union data inside the ResponsesExportHistoryBadRequest as a ErrorsExportTimeRangeInvalid
+func (t ResponsesExportHistoryBadRequest) AsErrorsExportTimeRangeInvalid() (ErrorsExportTimeRangeInvalid, error) {
+	var body ErrorsExportTimeRangeInvalid
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSBadURL overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSBadURL
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSBadURL(v ErrorsBHSBadURL) error {
+// FromErrorsExportTimeRangeInvalid overwrites any union data inside the ResponsesExportHistoryBadRequest as the provided ErrorsExportTimeRangeInvalid
+func (t *ResponsesExportHistoryBadRequest) FromErrorsExportTimeRangeInvalid(v ErrorsExportTimeRangeInvalid) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSBadURL performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSBadURL
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadURL(v ErrorsBHSBadURL) error {
+// MergeErrorsExportTimeRangeInvalid performs a merge with any union data inside the ResponsesExportHistoryBadRequest, using the provided ErrorsExportTimeRangeInvalid
+func (t *ResponsesExportHistoryBadRequest) MergeErrorsExportTimeRangeInvalid(v ErrorsExportTimeRangeInvalid) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1829,22 +2611,32 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadURL(v Erro
 	return err
 }
 
-// AsErrorsBHSParsingResponse returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSParsingResponse
-func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSParsingResponse() (ErrorsBHSParsingResponse, error) {
-	var body ErrorsBHSParsingResponse
+func (t ResponsesExportHistoryBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesExportHistoryBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsDataNotFound returns the union data inside the ResponsesGetDataNotFound as a ErrorsDataNotFound
+func (t ResponsesGetDataNotFound) AsErrorsDataNotFound() (ErrorsDataNotFound, error) {
+	var body ErrorsDataNotFound
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromErrorsBHSParsingResponse overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSParsingResponse
-func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSParsingResponse(v ErrorsBHSParsingResponse) error {
+// FromErrorsDataNotFound overwrites any union data inside the ResponsesGetDataNotFound as the provided ErrorsDataNotFound
+func (t *ResponsesGetDataNotFound) FromErrorsDataNotFound(v ErrorsDataNotFound) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeErrorsBHSParsingResponse performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSParsingResponse
-func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSParsingResponse(v ErrorsBHSParsingResponse) error {
+// MergeErrorsDataNotFound performs a merge with any union data inside the ResponsesGetDataNotFound, using the provided ErrorsDataNotFound
+func (t *ResponsesGetDataNotFound) MergeErrorsDataNotFound(v ErrorsDataNotFound) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1855,7 +2647,199 @@ func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSParsingRespon
 	return err
 }
 
-func (t ResponsesGetMerklerootsInternalServerError) MarshalJSON() ([]byte, error) {
+func (t ResponsesGetDataNotFound) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesGetDataNotFound) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsBHSUnreachable returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnreachable
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnreachable() (ErrorsBHSUnreachable, error) {
+	var body ErrorsBHSUnreachable
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBHSUnreachable overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnreachable
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnreachable(v ErrorsBHSUnreachable) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBHSUnreachable performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnreachable
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnreachable(v ErrorsBHSUnreachable) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsBHSNoSuccessResponse returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSNoSuccessResponse
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSNoSuccessResponse() (ErrorsBHSNoSuccessResponse, error) {
+	var body ErrorsBHSNoSuccessResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBHSNoSuccessResponse overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSNoSuccessResponse
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSNoSuccessResponse(v ErrorsBHSNoSuccessResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBHSNoSuccessResponse performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSNoSuccessResponse
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSNoSuccessResponse(v ErrorsBHSNoSuccessResponse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsBHSUnauthorized returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnauthorized
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnauthorized() (ErrorsBHSUnauthorized, error) {
+	var body ErrorsBHSUnauthorized
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBHSUnauthorized overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnauthorized
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnauthorized(v ErrorsBHSUnauthorized) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBHSUnauthorized performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnauthorized
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnauthorized(v ErrorsBHSUnauthorized) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsBHSBadRequest returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSBadRequest
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSBadRequest() (ErrorsBHSBadRequest, error) {
+	var body ErrorsBHSBadRequest
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBHSBadRequest overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSBadRequest
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSBadRequest(v ErrorsBHSBadRequest) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBHSBadRequest performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSBadRequest
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadRequest(v ErrorsBHSBadRequest) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsBHSUnhealthy returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSUnhealthy
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSUnhealthy() (ErrorsBHSUnhealthy, error) {
+	var body ErrorsBHSUnhealthy
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBHSUnhealthy overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSUnhealthy
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSUnhealthy(v ErrorsBHSUnhealthy) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBHSUnhealthy performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSUnhealthy
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSUnhealthy(v ErrorsBHSUnhealthy) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsBHSBadURL returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSBadURL
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSBadURL() (ErrorsBHSBadURL, error) {
+	var body ErrorsBHSBadURL
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBHSBadURL overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSBadURL
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSBadURL(v ErrorsBHSBadURL) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBHSBadURL performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSBadURL
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSBadURL(v ErrorsBHSBadURL) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsBHSParsingResponse returns the union data inside the ResponsesGetMerklerootsInternalServerError as a ErrorsBHSParsingResponse
+func (t ResponsesGetMerklerootsInternalServerError) AsErrorsBHSParsingResponse() (ErrorsBHSParsingResponse, error) {
+	var body ErrorsBHSParsingResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBHSParsingResponse overwrites any union data inside the ResponsesGetMerklerootsInternalServerError as the provided ErrorsBHSParsingResponse
+func (t *ResponsesGetMerklerootsInternalServerError) FromErrorsBHSParsingResponse(v ErrorsBHSParsingResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBHSParsingResponse performs a merge with any union data inside the ResponsesGetMerklerootsInternalServerError, using the provided ErrorsBHSParsingResponse
+func (t *ResponsesGetMerklerootsInternalServerError) MergeErrorsBHSParsingResponse(v ErrorsBHSParsingResponse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesGetMerklerootsInternalServerError) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
@@ -1865,6 +2849,68 @@ func (t *ResponsesGetMerklerootsInternalServerError) UnmarshalJSON(b []byte) err
 	return err
 }
 
+// AsErrorsBalancePointAmbiguous returns the union data inside the ResponsesGetUserBalanceBadRequest as a ErrorsBalancePointAmbiguous
+func (t ResponsesGetUserBalanceBadRequest) AsErrorsBalancePointAmbiguous() (ErrorsBalancePointAmbiguous, error) {
+	var body ErrorsBalancePointAmbiguous
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBalancePointAmbiguous overwrites any union data inside the ResponsesGetUserBalanceBadRequest as the provided ErrorsBalancePointAmbiguous
+func (t *ResponsesGetUserBalanceBadRequest) FromErrorsBalancePointAmbiguous(v ErrorsBalancePointAmbiguous) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBalancePointAmbiguous performs a merge with any union data inside the ResponsesGetUserBalanceBadRequest, using the provided ErrorsBalancePointAmbiguous
+func (t *ResponsesGetUserBalanceBadRequest) MergeErrorsBalancePointAmbiguous(v ErrorsBalancePointAmbiguous) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorsBalanceHistoryRangeInvalid returns the union data inside the ResponsesGetUserBalanceBadRequest as a ErrorsBalanceHistoryRangeInvalid
+func (t ResponsesGetUserBalanceBadRequest) AsErrorsBalanceHistoryRangeInvalid() (ErrorsBalanceHistoryRangeInvalid, error) {
+	var body ErrorsBalanceHistoryRangeInvalid
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsBalanceHistoryRangeInvalid overwrites any union data inside the ResponsesGetUserBalanceBadRequest as the provided ErrorsBalanceHistoryRangeInvalid
+func (t *ResponsesGetUserBalanceBadRequest) FromErrorsBalanceHistoryRangeInvalid(v ErrorsBalanceHistoryRangeInvalid) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsBalanceHistoryRangeInvalid performs a merge with any union data inside the ResponsesGetUserBalanceBadRequest, using the provided ErrorsBalanceHistoryRangeInvalid
+func (t *ResponsesGetUserBalanceBadRequest) MergeErrorsBalanceHistoryRangeInvalid(v ErrorsBalanceHistoryRangeInvalid) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesGetUserBalanceBadRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesGetUserBalanceBadRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsErrorsInvalidDataID returns the union data inside the ResponsesRecordTransactionBadRequest as a ErrorsInvalidDataID
 func (t ResponsesRecordTransactionBadRequest) AsErrorsInvalidDataID() (ErrorsInvalidDataID, error) {
 	var body ErrorsInvalidDataID
@@ -2083,21 +3129,83 @@ func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsTxBroadcast(v
 	return err
 }
 
-func (t ResponsesRecordTransactionInternalServerError) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// AsErrorsGettingSourceTransactions returns the union data inside the ResponsesRecordTransactionInternalServerError as a ErrorsGettingSourceTransactions
+func (t ResponsesRecordTransactionInternalServerError) AsErrorsGettingSourceTransactions() (ErrorsGettingSourceTransactions, error) {
+	var body ErrorsGettingSourceTransactions
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (t *ResponsesRecordTransactionInternalServerError) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+// FromErrorsGettingSourceTransactions overwrites any union data inside the ResponsesRecordTransactionInternalServerError as the provided ErrorsGettingSourceTransactions
+func (t *ResponsesRecordTransactionInternalServerError) FromErrorsGettingSourceTransactions(v ErrorsGettingSourceTransactions) error {
+	b, err := json.Marshal(v)
+	t.union = b
 	return err
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// MergeErrorsGettingSourceTransactions performs a merge with any union data inside the ResponsesRecordTransactionInternalServerError, using the provided ErrorsGettingSourceTransactions
+func (t *ResponsesRecordTransactionInternalServerError) MergeErrorsGettingSourceTransactions(v ErrorsGettingSourceTransactions) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-// Doer performs HTTP requests.
-//
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesRecordTransactionInternalServerError) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesRecordTransactionInternalServerError) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsErrorsSourceTransactionNotFound returns the union data inside the ResponsesRecordTransactionUnprocessable as a ErrorsSourceTransactionNotFound
+func (t ResponsesRecordTransactionUnprocessable) AsErrorsSourceTransactionNotFound() (ErrorsSourceTransactionNotFound, error) {
+	var body ErrorsSourceTransactionNotFound
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorsSourceTransactionNotFound overwrites any union data inside the ResponsesRecordTransactionUnprocessable as the provided ErrorsSourceTransactionNotFound
+func (t *ResponsesRecordTransactionUnprocessable) FromErrorsSourceTransactionNotFound(v ErrorsSourceTransactionNotFound) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorsSourceTransactionNotFound performs a merge with any union data inside the ResponsesRecordTransactionUnprocessable, using the provided ErrorsSourceTransactionNotFound
+func (t *ResponsesRecordTransactionUnprocessable) MergeErrorsSourceTransactionNotFound(v ErrorsSourceTransactionNotFound) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ResponsesRecordTransactionUnprocessable) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ResponsesRecordTransactionUnprocessable) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
 // The standard http.Client implements this interface.
 type HttpRequestDoer interface {
 	Do(req *http.Request) (*http.Response, error)
@@ -2166,9 +3274,15 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// AdminExportOperations request
+	AdminExportOperations(ctx context.Context, params *AdminExportOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AdminStatus request
 	AdminStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SearchUsers request
+	SearchUsers(ctx context.Context, params *SearchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateUserWithBody request with any body
 	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2177,11 +3291,25 @@ type ClientInterface interface {
 	// UserById request
 	UserById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeactivateUser request
+	DeactivateUser(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AddPaymailToUserWithBody request with any body
 	AddPaymailToUserWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	AddPaymailToUser(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteUserPaymail request
+	DeleteUserPaymail(ctx context.Context, id string, paymailId uint, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserPaymailWithBody request with any body
+	UpdateUserPaymailWithBody(ctx context.Context, id string, paymailId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUserPaymail(ctx context.Context, id string, paymailId uint, body UpdateUserPaymailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReactivateUser request
+	ReactivateUser(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SharedConfig request
 	SharedConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2191,9 +3319,21 @@ type ClientInterface interface {
 	// MerkleRoots request
 	MerkleRoots(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExportOperations request
+	ExportOperations(ctx context.Context, params *ExportOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SearchOperations request
 	SearchOperations(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// OperationByTxID request
+	OperationByTxID(ctx context.Context, txid string, params *OperationByTxIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StablecoinBalances request
+	StablecoinBalances(ctx context.Context, params *StablecoinBalancesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchStablecoinBanknotes request
+	SearchStablecoinBanknotes(ctx context.Context, params *SearchStablecoinBanknotesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RecordTransactionOutlineWithBody request with any body
 	RecordTransactionOutlineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2204,8 +3344,26 @@ type ClientInterface interface {
 
 	CreateTransactionOutline(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CancelTransactionOutlineReservation request
+	CancelTransactionOutlineReservation(ctx context.Context, reservationID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CurrentUser request
 	CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CurrentUserBalance request
+	CurrentUserBalance(ctx context.Context, params *CurrentUserBalanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AdminExportOperations(ctx context.Context, params *AdminExportOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminExportOperationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) AdminStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -2220,6 +3378,18 @@ func (c *Client) AdminStatus(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
+func (c *Client) SearchUsers(ctx context.Context, params *SearchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -2256,6 +3426,18 @@ func (c *Client) UserById(ctx context.Context, id string, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeactivateUser(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeactivateUserRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AddPaymailToUserWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAddPaymailToUserRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
@@ -2280,8 +3462,8 @@ func (c *Client) AddPaymailToUser(ctx context.Context, id string, body AddPaymai
 	return c.Client.Do(req)
 }
 
-func (c *Client) SharedConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSharedConfigRequest(c.Server)
+func (c *Client) DeleteUserPaymail(ctx context.Context, id string, paymailId uint, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserPaymailRequest(c.Server, id, paymailId)
 	if err != nil {
 		return nil, err
 	}
@@ -2292,8 +3474,8 @@ func (c *Client) SharedConfig(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) DataById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDataByIdRequest(c.Server, id)
+func (c *Client) UpdateUserPaymailWithBody(ctx context.Context, id string, paymailId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserPaymailRequestWithBody(c.Server, id, paymailId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2304,8 +3486,8 @@ func (c *Client) DataById(ctx context.Context, id string, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) MerkleRoots(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMerkleRootsRequest(c.Server, params)
+func (c *Client) UpdateUserPaymail(ctx context.Context, id string, paymailId uint, body UpdateUserPaymailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserPaymailRequest(c.Server, id, paymailId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2316,8 +3498,8 @@ func (c *Client) MerkleRoots(ctx context.Context, params *MerkleRootsParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchOperations(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchOperationsRequest(c.Server, params)
+func (c *Client) ReactivateUser(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReactivateUserRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2328,8 +3510,8 @@ func (c *Client) SearchOperations(ctx context.Context, params *SearchOperationsP
 	return c.Client.Do(req)
 }
 
-func (c *Client) RecordTransactionOutlineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRecordTransactionOutlineRequestWithBody(c.Server, contentType, body)
+func (c *Client) SharedConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSharedConfigRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2340,8 +3522,8 @@ func (c *Client) RecordTransactionOutlineWithBody(ctx context.Context, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) RecordTransactionOutline(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRecordTransactionOutlineRequest(c.Server, body)
+func (c *Client) DataById(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDataByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2352,8 +3534,8 @@ func (c *Client) RecordTransactionOutline(ctx context.Context, body RecordTransa
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTransactionOutlineWithBody(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTransactionOutlineRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) MerkleRoots(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMerkleRootsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2364,8 +3546,8 @@ func (c *Client) CreateTransactionOutlineWithBody(ctx context.Context, params *C
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTransactionOutline(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTransactionOutlineRequest(c.Server, params, body)
+func (c *Client) ExportOperations(ctx context.Context, params *ExportOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportOperationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2376,8 +3558,8 @@ func (c *Client) CreateTransactionOutline(ctx context.Context, params *CreateTra
 	return c.Client.Do(req)
 }
 
-func (c *Client) CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCurrentUserRequest(c.Server)
+func (c *Client) SearchOperations(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchOperationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2388,135 +3570,136 @@ func (c *Client) CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewAdminStatusRequest generates requests for AdminStatus
-func NewAdminStatusRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) OperationByTxID(ctx context.Context, txid string, params *OperationByTxIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOperationByTxIDRequest(c.Server, txid, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v2/admin/status")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StablecoinBalances(ctx context.Context, params *StablecoinBalancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStablecoinBalancesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateUserRequest calls the generic CreateUser builder with application/json body
-func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SearchStablecoinBanknotes(ctx context.Context, params *SearchStablecoinBanknotesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchStablecoinBanknotesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
-func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RecordTransactionOutlineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordTransactionOutlineRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v2/admin/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RecordTransactionOutline(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordTransactionOutlineRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUserByIdRequest generates requests for UserById
-func NewUserByIdRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CreateTransactionOutlineWithBody(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionOutlineRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTransactionOutline(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransactionOutlineRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v2/admin/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CancelTransactionOutlineReservation(ctx context.Context, reservationID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTransactionOutlineReservationRequest(c.Server, reservationID)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAddPaymailToUserRequest calls the generic AddPaymailToUser builder with application/json body
-func NewAddPaymailToUserRequest(server string, id string, body AddPaymailToUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCurrentUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddPaymailToUserRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddPaymailToUserRequestWithBody generates requests for AddPaymailToUser with any type of body
-func NewAddPaymailToUserRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CurrentUserBalance(ctx context.Context, params *CurrentUserBalanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCurrentUserBalanceRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAdminExportOperationsRequest generates requests for AdminExportOperations
+func NewAdminExportOperationsRequest(server string, params *AdminExportOperationsParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/paymails", pathParam0)
+	operationPath := fmt.Sprintf("/api/v2/admin/operations/export")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2526,33 +3709,74 @@ func NewAddPaymailToUserRequestWithBody(server string, id string, contentType st
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.UserId != nil {
 
-// NewSharedConfigRequest generates requests for SharedConfig
-func NewSharedConfigRequest(server string) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userId", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	operationPath := fmt.Sprintf("/api/v2/configs/shared")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if params.Format != nil {
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -2563,23 +3787,16 @@ func NewSharedConfigRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDataByIdRequest generates requests for DataById
-func NewDataByIdRequest(server string, id string) (*http.Request, error) {
+// NewAdminStatusRequest generates requests for AdminStatus
+func NewAdminStatusRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/data/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v2/admin/status")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2597,8 +3814,8 @@ func NewDataByIdRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewMerkleRootsRequest generates requests for MerkleRoots
-func NewMerkleRootsRequest(server string, params *MerkleRootsParams) (*http.Request, error) {
+// NewSearchUsersRequest generates requests for SearchUsers
+func NewSearchUsersRequest(server string, params *SearchUsersParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2606,7 +3823,7 @@ func NewMerkleRootsRequest(server string, params *MerkleRootsParams) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/merkleroots")
+	operationPath := fmt.Sprintf("/api/v2/admin/users")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2619,9 +3836,9 @@ func NewMerkleRootsRequest(server string, params *MerkleRootsParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.BatchSize != nil {
+		if params.Page != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batchSize", runtime.ParamLocationQuery, *params.BatchSize); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2635,9 +3852,9 @@ func NewMerkleRootsRequest(server string, params *MerkleRootsParams) (*http.Requ
 
 		}
 
-		if params.LastEvaluatedKey != nil {
+		if params.Size != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastEvaluatedKey", runtime.ParamLocationQuery, *params.LastEvaluatedKey); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2651,42 +3868,57 @@ func NewMerkleRootsRequest(server string, params *MerkleRootsParams) (*http.Requ
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.Sort != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewSearchOperationsRequest generates requests for SearchOperations
-func NewSearchOperationsRequest(server string, params *SearchOperationsParams) (*http.Request, error) {
-	var err error
+		if params.SortBy != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v2/operations/search")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		if params.PublicKey != nil {
 
-	if params != nil {
-		queryValues := queryURL.Query()
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "publicKey", runtime.ParamLocationQuery, *params.PublicKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-		if params.Page != nil {
+		}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+		if params.Paymail != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "paymail", runtime.ParamLocationQuery, *params.Paymail); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2700,9 +3932,9 @@ func NewSearchOperationsRequest(server string, params *SearchOperationsParams) (
 
 		}
 
-		if params.Size != nil {
+		if params.CreatedFrom != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdFrom", runtime.ParamLocationQuery, *params.CreatedFrom); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2716,9 +3948,9 @@ func NewSearchOperationsRequest(server string, params *SearchOperationsParams) (
 
 		}
 
-		if params.Sort != nil {
+		if params.CreatedTo != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTo", runtime.ParamLocationQuery, *params.CreatedTo); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2732,9 +3964,9 @@ func NewSearchOperationsRequest(server string, params *SearchOperationsParams) (
 
 		}
 
-		if params.SortBy != nil {
+		if params.Active != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2759,19 +3991,19 @@ func NewSearchOperationsRequest(server string, params *SearchOperationsParams) (
 	return req, nil
 }
 
-// NewRecordTransactionOutlineRequest calls the generic RecordTransactionOutline builder with application/json body
-func NewRecordTransactionOutlineRequest(server string, body RecordTransactionOutlineJSONRequestBody) (*http.Request, error) {
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewRecordTransactionOutlineRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewRecordTransactionOutlineRequestWithBody generates requests for RecordTransactionOutline with any type of body
-func NewRecordTransactionOutlineRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2779,7 +4011,7 @@ func NewRecordTransactionOutlineRequestWithBody(server string, contentType strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/transactions")
+	operationPath := fmt.Sprintf("/api/v2/admin/users")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2799,27 +4031,23 @@ func NewRecordTransactionOutlineRequestWithBody(server string, contentType strin
 	return req, nil
 }
 
-// NewCreateTransactionOutlineRequest calls the generic CreateTransactionOutline builder with application/json body
-func NewCreateTransactionOutlineRequest(server string, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewUserByIdRequest generates requests for UserById
+func NewUserByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTransactionOutlineRequestWithBody(server, params, "application/json", bodyReader)
-}
-
-// NewCreateTransactionOutlineRequestWithBody generates requests for CreateTransactionOutline with any type of body
-func NewCreateTransactionOutlineRequestWithBody(server string, params *CreateTransactionOutlineParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/transactions/outlines")
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2829,48 +4057,31 @@ func NewCreateTransactionOutlineRequestWithBody(server string, params *CreateTra
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Format != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCurrentUserRequest generates requests for CurrentUser
-func NewCurrentUserRequest(server string) (*http.Request, error) {
+// NewDeactivateUserRequest generates requests for DeactivateUser
+func NewDeactivateUserRequest(server string, id string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2/users/current")
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/deactivate", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2880,7 +4091,7 @@ func NewCurrentUserRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2888,620 +4099,2712 @@ func NewCurrentUserRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewAddPaymailToUserRequest calls the generic AddPaymailToUser builder with application/json body
+func NewAddPaymailToUserRequest(server string, id string, body AddPaymailToUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPaymailToUserRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewAddPaymailToUserRequestWithBody generates requests for AddPaymailToUser with any type of body
+func NewAddPaymailToUserRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// AdminStatusWithResponse request
-	AdminStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminStatusResponse, error)
 
-	// CreateUserWithBodyWithResponse request with any body
-	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/paymails", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserByIdWithResponse request
-	UserByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserByIdResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddPaymailToUserWithBodyWithResponse request with any body
-	AddPaymailToUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	AddPaymailToUserWithResponse(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error)
+	return req, nil
+}
 
-	// SharedConfigWithResponse request
-	SharedConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SharedConfigResponse, error)
+// NewDeleteUserPaymailRequest generates requests for DeleteUserPaymail
+func NewDeleteUserPaymailRequest(server string, id string, paymailId uint) (*http.Request, error) {
+	var err error
 
-	// DataByIdWithResponse request
-	DataByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataByIdResponse, error)
+	var pathParam0 string
 
-	// MerkleRootsWithResponse request
-	MerkleRootsWithResponse(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*MerkleRootsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// SearchOperationsWithResponse request
-	SearchOperationsWithResponse(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*SearchOperationsResponse, error)
+	var pathParam1 string
 
-	// RecordTransactionOutlineWithBodyWithResponse request with any body
-	RecordTransactionOutlineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "paymailId", runtime.ParamLocationPath, paymailId)
+	if err != nil {
+		return nil, err
+	}
 
-	RecordTransactionOutlineWithResponse(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateTransactionOutlineWithBodyWithResponse request with any body
-	CreateTransactionOutlineWithBodyWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error)
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/paymails/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateTransactionOutlineWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CurrentUserWithResponse request
-	CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error)
-}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type AdminStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AdminStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateUserPaymailRequest calls the generic UpdateUserPaymail builder with application/json body
+func NewUpdateUserPaymailRequest(server string, id string, paymailId uint, body UpdateUserPaymailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserPaymailRequestWithBody(server, id, paymailId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AdminStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateUserPaymailRequestWithBody generates requests for UpdateUserPaymail with any type of body
+func NewUpdateUserPaymailRequestWithBody(server string, id string, paymailId uint, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r AdminStatusResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	var pathParam0 string
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r AdminStatusResponse) Bytes() []byte {
-	return r.Body
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-type CreateUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ResponsesAdminCreateUserSuccess
-	JSON400      *ResponsesAdminUserBadRequest
-	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
-	JSON422      *ResponsesAdminInvalidAvatarURL
-	JSON500      *ResponsesAdminCreateUserInternalServerError
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r CreateUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "paymailId", runtime.ParamLocationPath, paymailId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r CreateUserResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
-
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r CreateUserResponse) Bytes() []byte {
-	return r.Body
-}
 
-type UserByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesAdminGetUser
-	JSON500      *ResponsesAdminGetUserInternalServerError
-}
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/paymails/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UserByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r UserByIdResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r UserByIdResponse) Bytes() []byte {
-	return r.Body
+	return req, nil
 }
 
-type AddPaymailToUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ResponsesAdminAddPaymailSuccess
-	JSON400      *ResponsesAdminUserBadRequest
-	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
-	JSON422      *ResponsesAdminInvalidAvatarURL
-}
+// NewReactivateUserRequest generates requests for ReactivateUser
+func NewReactivateUserRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r AddPaymailToUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddPaymailToUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r AddPaymailToUserResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	operationPath := fmt.Sprintf("/api/v2/admin/users/%s/reactivate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r AddPaymailToUserResponse) Bytes() []byte {
-	return r.Body
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-type SharedConfigResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesSharedConfig
-	JSON401      *ResponsesNotAuthorized
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SharedConfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSharedConfigRequest generates requests for SharedConfig
+func NewSharedConfigRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SharedConfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v2/configs/shared")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r SharedConfigResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r SharedConfigResponse) Bytes() []byte {
-	return r.Body
-}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type DataByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesGetDataSuccess
-	JSON400      *ResponsesUserBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON404      *ResponsesGetDataNotFound
-	JSON500      *ResponsesInternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DataByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewDataByIdRequest generates requests for DataById
+func NewDataByIdRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DataByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var pathParam0 string
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r DataByIdResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r DataByIdResponse) Bytes() []byte {
-	return r.Body
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type MerkleRootsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesGetMerklerootsSuccess
-	JSON400      *ResponsesGetMerklerootsBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON404      *ResponsesGetMerklerootsNotFound
-	JSON409      *ResponsesGetMerklerootsConflict
-	JSON500      *ResponsesGetMerklerootsInternalServerError
-}
+	operationPath := fmt.Sprintf("/api/v2/data/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r MerkleRootsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MerkleRootsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r MerkleRootsResponse) Response() *http.Response {
-	return r.HTTPResponse
+	return req, nil
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r MerkleRootsResponse) Bytes() []byte {
-	return r.Body
-}
+// NewMerkleRootsRequest generates requests for MerkleRoots
+func NewMerkleRootsRequest(server string, params *MerkleRootsParams) (*http.Request, error) {
+	var err error
 
-type SearchOperationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesSearchOperationsSuccess
-	JSON400      *ResponsesSearchBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON500      *ResponsesInternalServerError
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r SearchOperationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v2/merkleroots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchOperationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r SearchOperationsResponse) Response() *http.Response {
-	return r.HTTPResponse
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r SearchOperationsResponse) Bytes() []byte {
-	return r.Body
-}
+		if params.BatchSize != nil {
 
-type RecordTransactionOutlineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ResponsesRecordTransactionSuccess
-	JSON400      *ResponsesRecordTransactionBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON500      *ResponsesRecordTransactionInternalServerError
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batchSize", runtime.ParamLocationQuery, *params.BatchSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r RecordTransactionOutlineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		}
+
+		if params.LastEvaluatedKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastEvaluatedKey", runtime.ParamLocationQuery, *params.LastEvaluatedKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RecordTransactionOutlineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r RecordTransactionOutlineResponse) Response() *http.Response {
-	return r.HTTPResponse
+	return req, nil
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r RecordTransactionOutlineResponse) Bytes() []byte {
-	return r.Body
-}
+// NewExportOperationsRequest generates requests for ExportOperations
+func NewExportOperationsRequest(server string, params *ExportOperationsParams) (*http.Request, error) {
+	var err error
 
-type CreateTransactionOutlineResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesCreateTransactionOutlineSuccess
-	JSON400      *ResponsesCreateTransactionOutlineBadRequest
-	JSON401      *ResponsesUserNotAuthorized
-	JSON422      *ResponsesCreateTransactionOutlineUnprocessable
-	JSON500      *ResponsesInternalServerError
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateTransactionOutlineResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v2/operations/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTransactionOutlineResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchOperationsRequest generates requests for SearchOperations
+func NewSearchOperationsRequest(server string, params *SearchOperationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/operations/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Counterparty != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "counterparty", runtime.ParamLocationQuery, *params.Counterparty); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinValue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minValue", runtime.ParamLocationQuery, *params.MinValue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxValue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxValue", runtime.ParamLocationQuery, *params.MaxValue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdFrom", runtime.ParamLocationQuery, *params.CreatedFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdTo", runtime.ParamLocationQuery, *params.CreatedTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TxStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "txStatus", runtime.ParamLocationQuery, *params.TxStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOperationByTxIDRequest generates requests for OperationByTxID
+func NewOperationByTxIDRequest(server string, txid string, params *OperationByTxIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txID", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/operations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeHex != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeHex", runtime.ParamLocationQuery, *params.IncludeHex); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStablecoinBalancesRequest generates requests for StablecoinBalances
+func NewStablecoinBalancesRequest(server string, params *StablecoinBalancesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/stablecoins/balances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StablecoinId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stablecoinId", runtime.ParamLocationQuery, *params.StablecoinId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchStablecoinBanknotesRequest generates requests for SearchStablecoinBanknotes
+func NewSearchStablecoinBanknotesRequest(server string, params *SearchStablecoinBanknotesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/stablecoins/banknotes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StablecoinId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stablecoinId", runtime.ParamLocationQuery, *params.StablecoinId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRecordTransactionOutlineRequest calls the generic RecordTransactionOutline builder with application/json body
+func NewRecordTransactionOutlineRequest(server string, body RecordTransactionOutlineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecordTransactionOutlineRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRecordTransactionOutlineRequestWithBody generates requests for RecordTransactionOutline with any type of body
+func NewRecordTransactionOutlineRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateTransactionOutlineRequest calls the generic CreateTransactionOutline builder with application/json body
+func NewCreateTransactionOutlineRequest(server string, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransactionOutlineRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateTransactionOutlineRequestWithBody generates requests for CreateTransactionOutline with any type of body
+func NewCreateTransactionOutlineRequestWithBody(server string, params *CreateTransactionOutlineParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/transactions/outlines")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelTransactionOutlineReservationRequest generates requests for CancelTransactionOutlineReservation
+func NewCancelTransactionOutlineReservationRequest(server string, reservationID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationID", runtime.ParamLocationPath, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/transactions/outlines/reservations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCurrentUserRequest generates requests for CurrentUser
+func NewCurrentUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/current")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCurrentUserBalanceRequest generates requests for CurrentUserBalance
+func NewCurrentUserBalanceRequest(server string, params *CurrentUserBalanceParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/users/current/balance")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.At != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "at", runtime.ParamLocationQuery, *params.At); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BlockHeight != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "blockHeight", runtime.ParamLocationQuery, *params.BlockHeight); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HistoryFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "historyFrom", runtime.ParamLocationQuery, *params.HistoryFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.HistoryTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "historyTo", runtime.ParamLocationQuery, *params.HistoryTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AdminExportOperationsWithResponse request
+	AdminExportOperationsWithResponse(ctx context.Context, params *AdminExportOperationsParams, reqEditors ...RequestEditorFn) (*AdminExportOperationsResponse, error)
+
+	// AdminStatusWithResponse request
+	AdminStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminStatusResponse, error)
+
+	// SearchUsersWithResponse request
+	SearchUsersWithResponse(ctx context.Context, params *SearchUsersParams, reqEditors ...RequestEditorFn) (*SearchUsersResponse, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// UserByIdWithResponse request
+	UserByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserByIdResponse, error)
+
+	// DeactivateUserWithResponse request
+	DeactivateUserWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeactivateUserResponse, error)
+
+	// AddPaymailToUserWithBodyWithResponse request with any body
+	AddPaymailToUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error)
+
+	AddPaymailToUserWithResponse(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error)
+
+	// DeleteUserPaymailWithResponse request
+	DeleteUserPaymailWithResponse(ctx context.Context, id string, paymailId uint, reqEditors ...RequestEditorFn) (*DeleteUserPaymailResponse, error)
+
+	// UpdateUserPaymailWithBodyWithResponse request with any body
+	UpdateUserPaymailWithBodyWithResponse(ctx context.Context, id string, paymailId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserPaymailResponse, error)
+
+	UpdateUserPaymailWithResponse(ctx context.Context, id string, paymailId uint, body UpdateUserPaymailJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserPaymailResponse, error)
+
+	// ReactivateUserWithResponse request
+	ReactivateUserWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ReactivateUserResponse, error)
+
+	// SharedConfigWithResponse request
+	SharedConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SharedConfigResponse, error)
+
+	// DataByIdWithResponse request
+	DataByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataByIdResponse, error)
+
+	// MerkleRootsWithResponse request
+	MerkleRootsWithResponse(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*MerkleRootsResponse, error)
+
+	// ExportOperationsWithResponse request
+	ExportOperationsWithResponse(ctx context.Context, params *ExportOperationsParams, reqEditors ...RequestEditorFn) (*ExportOperationsResponse, error)
+
+	// SearchOperationsWithResponse request
+	SearchOperationsWithResponse(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*SearchOperationsResponse, error)
+
+	// OperationByTxIDWithResponse request
+	OperationByTxIDWithResponse(ctx context.Context, txid string, params *OperationByTxIDParams, reqEditors ...RequestEditorFn) (*OperationByTxIDResponse, error)
+
+	// StablecoinBalancesWithResponse request
+	StablecoinBalancesWithResponse(ctx context.Context, params *StablecoinBalancesParams, reqEditors ...RequestEditorFn) (*StablecoinBalancesResponse, error)
+
+	// SearchStablecoinBanknotesWithResponse request
+	SearchStablecoinBanknotesWithResponse(ctx context.Context, params *SearchStablecoinBanknotesParams, reqEditors ...RequestEditorFn) (*SearchStablecoinBanknotesResponse, error)
+
+	// RecordTransactionOutlineWithBodyWithResponse request with any body
+	RecordTransactionOutlineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error)
+
+	RecordTransactionOutlineWithResponse(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error)
+
+	// CreateTransactionOutlineWithBodyWithResponse request with any body
+	CreateTransactionOutlineWithBodyWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error)
+
+	CreateTransactionOutlineWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error)
+
+	// CancelTransactionOutlineReservationWithResponse request
+	CancelTransactionOutlineReservationWithResponse(ctx context.Context, reservationID string, reqEditors ...RequestEditorFn) (*CancelTransactionOutlineReservationResponse, error)
+
+	// CurrentUserWithResponse request
+	CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error)
+
+	// CurrentUserBalanceWithResponse request
+	CurrentUserBalanceWithResponse(ctx context.Context, params *CurrentUserBalanceParams, reqEditors ...RequestEditorFn) (*CurrentUserBalanceResponse, error)
+}
+
+type AdminExportOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ResponsesExportHistoryBadRequest
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminExportOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminExportOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r AdminExportOperationsResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r AdminExportOperationsResponse) Bytes() []byte {
+	return r.Body
+}
+
+type AdminStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r AdminStatusResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r AdminStatusResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SearchUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesAdminSearchUsersSuccess
+	JSON400      *ResponsesAdminSearchUsersBadRequest
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SearchUsersResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SearchUsersResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResponsesAdminCreateUserSuccess
+	JSON400      *ResponsesAdminUserBadRequest
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON422      *ResponsesAdminInvalidAvatarURL
+	JSON500      *ResponsesAdminCreateUserInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r CreateUserResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CreateUserResponse) Bytes() []byte {
+	return r.Body
+}
+
+type UserByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesAdminGetUser
+	JSON500      *ResponsesAdminGetUserInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UserByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r UserByIdResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r UserByIdResponse) Bytes() []byte {
+	return r.Body
+}
+
+type DeactivateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesAdminGetUser
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON404      *ResponsesAdminUserNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeactivateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeactivateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r DeactivateUserResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r DeactivateUserResponse) Bytes() []byte {
+	return r.Body
+}
+
+type AddPaymailToUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResponsesAdminAddPaymailSuccess
+	JSON400      *ResponsesAdminUserBadRequest
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON422      *ResponsesAdminInvalidAvatarURL
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPaymailToUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPaymailToUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r AddPaymailToUserResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r AddPaymailToUserResponse) Bytes() []byte {
+	return r.Body
+}
+
+type DeleteUserPaymailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON404      *ResponsesAdminPaymailNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserPaymailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserPaymailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r DeleteUserPaymailResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r DeleteUserPaymailResponse) Bytes() []byte {
+	return r.Body
+}
+
+type UpdateUserPaymailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesAdminUpdatePaymailSuccess
+	JSON400      *ResponsesAdminUserBadRequest
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON404      *ResponsesAdminPaymailNotFound
+	JSON422      *ResponsesAdminInvalidAvatarURL
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserPaymailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserPaymailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r UpdateUserPaymailResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r UpdateUserPaymailResponse) Bytes() []byte {
+	return r.Body
+}
+
+type ReactivateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesAdminGetUser
+	JSON401      *ResponsesNotAuthorizedToAdminEndpoint
+	JSON404      *ResponsesAdminUserNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ReactivateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReactivateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r ReactivateUserResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r ReactivateUserResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SharedConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesSharedConfig
+	JSON401      *ResponsesNotAuthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r SharedConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SharedConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SharedConfigResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SharedConfigResponse) Bytes() []byte {
+	return r.Body
+}
+
+type DataByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetDataSuccess
+	JSON400      *ResponsesUserBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetDataNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DataByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DataByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r DataByIdResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r DataByIdResponse) Bytes() []byte {
+	return r.Body
+}
+
+type MerkleRootsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetMerklerootsSuccess
+	JSON400      *ResponsesGetMerklerootsBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetMerklerootsNotFound
+	JSON409      *ResponsesGetMerklerootsConflict
+	JSON500      *ResponsesGetMerklerootsInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r MerkleRootsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MerkleRootsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r MerkleRootsResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r MerkleRootsResponse) Bytes() []byte {
+	return r.Body
+}
+
+type ExportOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ResponsesExportHistoryBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r ExportOperationsResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r ExportOperationsResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SearchOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesSearchOperationsSuccess
+	JSON400      *ResponsesSearchBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SearchOperationsResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SearchOperationsResponse) Bytes() []byte {
+	return r.Body
+}
+
+type OperationByTxIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetOperationSuccess
+	JSON400      *ResponsesGetOperationBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON404      *ResponsesGetOperationNotFound
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r OperationByTxIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OperationByTxIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r OperationByTxIDResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r OperationByTxIDResponse) Bytes() []byte {
+	return r.Body
+}
+
+type StablecoinBalancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetStablecoinBalancesSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r StablecoinBalancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StablecoinBalancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r StablecoinBalancesResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r StablecoinBalancesResponse) Bytes() []byte {
+	return r.Body
+}
+
+type SearchStablecoinBanknotesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesSearchStablecoinBanknotesSuccess
+	JSON400      *ResponsesSearchBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchStablecoinBanknotesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchStablecoinBanknotesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r SearchStablecoinBanknotesResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r SearchStablecoinBanknotesResponse) Bytes() []byte {
+	return r.Body
+}
+
+type RecordTransactionOutlineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ResponsesRecordTransactionSuccess
+	JSON400      *ResponsesRecordTransactionBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON422      *ResponsesRecordTransactionUnprocessable
+	JSON500      *ResponsesRecordTransactionInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RecordTransactionOutlineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecordTransactionOutlineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r RecordTransactionOutlineResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r RecordTransactionOutlineResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CreateTransactionOutlineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesCreateTransactionOutlineSuccess
+	JSON400      *ResponsesCreateTransactionOutlineBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON422      *ResponsesCreateTransactionOutlineUnprocessable
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransactionOutlineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransactionOutlineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
 // HTTPResponse returns http.Response from which this response was parsed.
 func (r CreateTransactionOutlineResponse) Response() *http.Response {
 	return r.HTTPResponse
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r CreateTransactionOutlineResponse) Bytes() []byte {
-	return r.Body
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CreateTransactionOutlineResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CancelTransactionOutlineReservationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ResponsesCancelTransactionOutlineReservationBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelTransactionOutlineReservationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelTransactionOutlineReservationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r CancelTransactionOutlineReservationResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CancelTransactionOutlineReservationResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CurrentUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetCurrentUserSuccess
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CurrentUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CurrentUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r CurrentUserResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CurrentUserResponse) Bytes() []byte {
+	return r.Body
+}
+
+type CurrentUserBalanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ResponsesGetUserBalanceSuccess
+	JSON400      *ResponsesGetUserBalanceBadRequest
+	JSON401      *ResponsesUserNotAuthorized
+	JSON500      *ResponsesInternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CurrentUserBalanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CurrentUserBalanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HTTPResponse returns http.Response from which this response was parsed.
+func (r CurrentUserBalanceResponse) Response() *http.Response {
+	return r.HTTPResponse
+}
+
+// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
+func (r CurrentUserBalanceResponse) Bytes() []byte {
+	return r.Body
+}
+
+// AdminExportOperationsWithResponse request returning *AdminExportOperationsResponse
+func (c *ClientWithResponses) AdminExportOperationsWithResponse(ctx context.Context, params *AdminExportOperationsParams, reqEditors ...RequestEditorFn) (*AdminExportOperationsResponse, error) {
+	rsp, err := c.AdminExportOperations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminExportOperationsResponse(rsp)
+}
+
+// AdminStatusWithResponse request returning *AdminStatusResponse
+func (c *ClientWithResponses) AdminStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminStatusResponse, error) {
+	rsp, err := c.AdminStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminStatusResponse(rsp)
+}
+
+// SearchUsersWithResponse request returning *SearchUsersResponse
+func (c *ClientWithResponses) SearchUsersWithResponse(ctx context.Context, params *SearchUsersParams, reqEditors ...RequestEditorFn) (*SearchUsersResponse, error) {
+	rsp, err := c.SearchUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchUsersResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// UserByIdWithResponse request returning *UserByIdResponse
+func (c *ClientWithResponses) UserByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserByIdResponse, error) {
+	rsp, err := c.UserById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserByIdResponse(rsp)
+}
+
+// DeactivateUserWithResponse request returning *DeactivateUserResponse
+func (c *ClientWithResponses) DeactivateUserWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeactivateUserResponse, error) {
+	rsp, err := c.DeactivateUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeactivateUserResponse(rsp)
+}
+
+// AddPaymailToUserWithBodyWithResponse request with arbitrary body returning *AddPaymailToUserResponse
+func (c *ClientWithResponses) AddPaymailToUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error) {
+	rsp, err := c.AddPaymailToUserWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPaymailToUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddPaymailToUserWithResponse(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error) {
+	rsp, err := c.AddPaymailToUser(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPaymailToUserResponse(rsp)
+}
+
+// DeleteUserPaymailWithResponse request returning *DeleteUserPaymailResponse
+func (c *ClientWithResponses) DeleteUserPaymailWithResponse(ctx context.Context, id string, paymailId uint, reqEditors ...RequestEditorFn) (*DeleteUserPaymailResponse, error) {
+	rsp, err := c.DeleteUserPaymail(ctx, id, paymailId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserPaymailResponse(rsp)
+}
+
+// UpdateUserPaymailWithBodyWithResponse request with arbitrary body returning *UpdateUserPaymailResponse
+func (c *ClientWithResponses) UpdateUserPaymailWithBodyWithResponse(ctx context.Context, id string, paymailId uint, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserPaymailResponse, error) {
+	rsp, err := c.UpdateUserPaymailWithBody(ctx, id, paymailId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserPaymailResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserPaymailWithResponse(ctx context.Context, id string, paymailId uint, body UpdateUserPaymailJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserPaymailResponse, error) {
+	rsp, err := c.UpdateUserPaymail(ctx, id, paymailId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserPaymailResponse(rsp)
+}
+
+// ReactivateUserWithResponse request returning *ReactivateUserResponse
+func (c *ClientWithResponses) ReactivateUserWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ReactivateUserResponse, error) {
+	rsp, err := c.ReactivateUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReactivateUserResponse(rsp)
+}
+
+// SharedConfigWithResponse request returning *SharedConfigResponse
+func (c *ClientWithResponses) SharedConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SharedConfigResponse, error) {
+	rsp, err := c.SharedConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSharedConfigResponse(rsp)
+}
+
+// DataByIdWithResponse request returning *DataByIdResponse
+func (c *ClientWithResponses) DataByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataByIdResponse, error) {
+	rsp, err := c.DataById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDataByIdResponse(rsp)
+}
+
+// MerkleRootsWithResponse request returning *MerkleRootsResponse
+func (c *ClientWithResponses) MerkleRootsWithResponse(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*MerkleRootsResponse, error) {
+	rsp, err := c.MerkleRoots(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMerkleRootsResponse(rsp)
+}
+
+// ExportOperationsWithResponse request returning *ExportOperationsResponse
+func (c *ClientWithResponses) ExportOperationsWithResponse(ctx context.Context, params *ExportOperationsParams, reqEditors ...RequestEditorFn) (*ExportOperationsResponse, error) {
+	rsp, err := c.ExportOperations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportOperationsResponse(rsp)
+}
+
+// SearchOperationsWithResponse request returning *SearchOperationsResponse
+func (c *ClientWithResponses) SearchOperationsWithResponse(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*SearchOperationsResponse, error) {
+	rsp, err := c.SearchOperations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchOperationsResponse(rsp)
+}
+
+// OperationByTxIDWithResponse request returning *OperationByTxIDResponse
+func (c *ClientWithResponses) OperationByTxIDWithResponse(ctx context.Context, txid string, params *OperationByTxIDParams, reqEditors ...RequestEditorFn) (*OperationByTxIDResponse, error) {
+	rsp, err := c.OperationByTxID(ctx, txid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOperationByTxIDResponse(rsp)
+}
+
+// StablecoinBalancesWithResponse request returning *StablecoinBalancesResponse
+func (c *ClientWithResponses) StablecoinBalancesWithResponse(ctx context.Context, params *StablecoinBalancesParams, reqEditors ...RequestEditorFn) (*StablecoinBalancesResponse, error) {
+	rsp, err := c.StablecoinBalances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStablecoinBalancesResponse(rsp)
+}
+
+// SearchStablecoinBanknotesWithResponse request returning *SearchStablecoinBanknotesResponse
+func (c *ClientWithResponses) SearchStablecoinBanknotesWithResponse(ctx context.Context, params *SearchStablecoinBanknotesParams, reqEditors ...RequestEditorFn) (*SearchStablecoinBanknotesResponse, error) {
+	rsp, err := c.SearchStablecoinBanknotes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchStablecoinBanknotesResponse(rsp)
 }
 
-type CurrentUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ResponsesGetCurrentUserSuccess
-	JSON401      *ResponsesUserNotAuthorized
-	JSON500      *ResponsesInternalServerError
+// RecordTransactionOutlineWithBodyWithResponse request with arbitrary body returning *RecordTransactionOutlineResponse
+func (c *ClientWithResponses) RecordTransactionOutlineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error) {
+	rsp, err := c.RecordTransactionOutlineWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordTransactionOutlineResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CurrentUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) RecordTransactionOutlineWithResponse(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error) {
+	rsp, err := c.RecordTransactionOutline(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseRecordTransactionOutlineResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CurrentUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateTransactionOutlineWithBodyWithResponse request with arbitrary body returning *CreateTransactionOutlineResponse
+func (c *ClientWithResponses) CreateTransactionOutlineWithBodyWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error) {
+	rsp, err := c.CreateTransactionOutlineWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateTransactionOutlineResponse(rsp)
 }
 
-// HTTPResponse returns http.Response from which this response was parsed.
-func (r CurrentUserResponse) Response() *http.Response {
-	return r.HTTPResponse
+func (c *ClientWithResponses) CreateTransactionOutlineWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error) {
+	rsp, err := c.CreateTransactionOutline(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransactionOutlineResponse(rsp)
 }
 
-// Bytes is a convenience method to retrieve the raw bytes from the HTTP response
-func (r CurrentUserResponse) Bytes() []byte {
-	return r.Body
+// CancelTransactionOutlineReservationWithResponse request returning *CancelTransactionOutlineReservationResponse
+func (c *ClientWithResponses) CancelTransactionOutlineReservationWithResponse(ctx context.Context, reservationID string, reqEditors ...RequestEditorFn) (*CancelTransactionOutlineReservationResponse, error) {
+	rsp, err := c.CancelTransactionOutlineReservation(ctx, reservationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTransactionOutlineReservationResponse(rsp)
+}
+
+// CurrentUserWithResponse request returning *CurrentUserResponse
+func (c *ClientWithResponses) CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error) {
+	rsp, err := c.CurrentUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCurrentUserResponse(rsp)
+}
+
+// CurrentUserBalanceWithResponse request returning *CurrentUserBalanceResponse
+func (c *ClientWithResponses) CurrentUserBalanceWithResponse(ctx context.Context, params *CurrentUserBalanceParams, reqEditors ...RequestEditorFn) (*CurrentUserBalanceResponse, error) {
+	rsp, err := c.CurrentUserBalance(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCurrentUserBalanceResponse(rsp)
+}
+
+// ParseAdminExportOperationsResponse parses an HTTP response from a AdminExportOperationsWithResponse call
+func ParseAdminExportOperationsResponse(rsp *http.Response) (*AdminExportOperationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminExportOperationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesExportHistoryBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminStatusResponse parses an HTTP response from a AdminStatusWithResponse call
+func ParseAdminStatusResponse(rsp *http.Response) (*AdminStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchUsersResponse parses an HTTP response from a SearchUsersWithResponse call
+func ParseSearchUsersResponse(rsp *http.Response) (*SearchUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesAdminSearchUsersSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesAdminSearchUsersBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResponsesAdminCreateUserSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesAdminUserBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesAdminInvalidAvatarURL
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesAdminCreateUserInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// AdminStatusWithResponse request returning *AdminStatusResponse
-func (c *ClientWithResponses) AdminStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminStatusResponse, error) {
-	rsp, err := c.AdminStatus(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// ParseUserByIdResponse parses an HTTP response from a UserByIdWithResponse call
+func ParseUserByIdResponse(rsp *http.Response) (*UserByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesAdminGetUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesAdminGetUserInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseAdminStatusResponse(rsp)
+
+	return response, nil
 }
 
-// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
-func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeactivateUserResponse parses an HTTP response from a DeactivateUserWithResponse call
+func ParseDeactivateUserResponse(rsp *http.Response) (*DeactivateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateUserResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUser(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeactivateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateUserResponse(rsp)
-}
 
-// UserByIdWithResponse request returning *UserByIdResponse
-func (c *ClientWithResponses) UserByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UserByIdResponse, error) {
-	rsp, err := c.UserById(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesAdminGetUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesAdminUserNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUserByIdResponse(rsp)
+
+	return response, nil
 }
 
-// AddPaymailToUserWithBodyWithResponse request with arbitrary body returning *AddPaymailToUserResponse
-func (c *ClientWithResponses) AddPaymailToUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error) {
-	rsp, err := c.AddPaymailToUserWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseAddPaymailToUserResponse parses an HTTP response from a AddPaymailToUserWithResponse call
+func ParseAddPaymailToUserResponse(rsp *http.Response) (*AddPaymailToUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddPaymailToUserResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddPaymailToUserWithResponse(ctx context.Context, id string, body AddPaymailToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPaymailToUserResponse, error) {
-	rsp, err := c.AddPaymailToUser(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddPaymailToUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddPaymailToUserResponse(rsp)
-}
 
-// SharedConfigWithResponse request returning *SharedConfigResponse
-func (c *ClientWithResponses) SharedConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SharedConfigResponse, error) {
-	rsp, err := c.SharedConfig(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ResponsesAdminAddPaymailSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesAdminUserBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesAdminInvalidAvatarURL
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	}
-	return ParseSharedConfigResponse(rsp)
+
+	return response, nil
 }
 
-// DataByIdWithResponse request returning *DataByIdResponse
-func (c *ClientWithResponses) DataByIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DataByIdResponse, error) {
-	rsp, err := c.DataById(ctx, id, reqEditors...)
+// ParseDeleteUserPaymailResponse parses an HTTP response from a DeleteUserPaymailWithResponse call
+func ParseDeleteUserPaymailResponse(rsp *http.Response) (*DeleteUserPaymailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDataByIdResponse(rsp)
-}
 
-// MerkleRootsWithResponse request returning *MerkleRootsResponse
-func (c *ClientWithResponses) MerkleRootsWithResponse(ctx context.Context, params *MerkleRootsParams, reqEditors ...RequestEditorFn) (*MerkleRootsResponse, error) {
-	rsp, err := c.MerkleRoots(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteUserPaymailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMerkleRootsResponse(rsp)
-}
 
-// SearchOperationsWithResponse request returning *SearchOperationsResponse
-func (c *ClientWithResponses) SearchOperationsWithResponse(ctx context.Context, params *SearchOperationsParams, reqEditors ...RequestEditorFn) (*SearchOperationsResponse, error) {
-	rsp, err := c.SearchOperations(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesAdminPaymailNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseSearchOperationsResponse(rsp)
+
+	return response, nil
 }
 
-// RecordTransactionOutlineWithBodyWithResponse request with arbitrary body returning *RecordTransactionOutlineResponse
-func (c *ClientWithResponses) RecordTransactionOutlineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error) {
-	rsp, err := c.RecordTransactionOutlineWithBody(ctx, contentType, body, reqEditors...)
+// ParseUpdateUserPaymailResponse parses an HTTP response from a UpdateUserPaymailWithResponse call
+func ParseUpdateUserPaymailResponse(rsp *http.Response) (*UpdateUserPaymailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRecordTransactionOutlineResponse(rsp)
-}
 
-func (c *ClientWithResponses) RecordTransactionOutlineWithResponse(ctx context.Context, body RecordTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTransactionOutlineResponse, error) {
-	rsp, err := c.RecordTransactionOutline(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateUserPaymailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRecordTransactionOutlineResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesAdminUpdatePaymailSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesAdminUserBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesAdminPaymailNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesAdminInvalidAvatarURL
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// CreateTransactionOutlineWithBodyWithResponse request with arbitrary body returning *CreateTransactionOutlineResponse
-func (c *ClientWithResponses) CreateTransactionOutlineWithBodyWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error) {
-	rsp, err := c.CreateTransactionOutlineWithBody(ctx, params, contentType, body, reqEditors...)
+// ParseReactivateUserResponse parses an HTTP response from a ReactivateUserWithResponse call
+func ParseReactivateUserResponse(rsp *http.Response) (*ReactivateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTransactionOutlineResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTransactionOutlineWithResponse(ctx context.Context, params *CreateTransactionOutlineParams, body CreateTransactionOutlineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransactionOutlineResponse, error) {
-	rsp, err := c.CreateTransactionOutline(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ReactivateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesAdminGetUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesNotAuthorizedToAdminEndpoint
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesAdminUserNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCreateTransactionOutlineResponse(rsp)
-}
 
-// CurrentUserWithResponse request returning *CurrentUserResponse
-func (c *ClientWithResponses) CurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CurrentUserResponse, error) {
-	rsp, err := c.CurrentUser(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCurrentUserResponse(rsp)
+	return response, nil
 }
 
-// ParseAdminStatusResponse parses an HTTP response from a AdminStatusWithResponse call
-func ParseAdminStatusResponse(rsp *http.Response) (*AdminStatusResponse, error) {
+// ParseSharedConfigResponse parses an HTTP response from a SharedConfigWithResponse call
+func ParseSharedConfigResponse(rsp *http.Response) (*SharedConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AdminStatusResponse{
+	response := &SharedConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesSharedConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ResponsesNotAuthorizedToAdminEndpoint
+		var dest ResponsesNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3512,50 +6815,50 @@ func ParseAdminStatusResponse(rsp *http.Response) (*AdminStatusResponse, error)
 	return response, nil
 }
 
-// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
-func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+// ParseDataByIdResponse parses an HTTP response from a DataByIdWithResponse call
+func ParseDataByIdResponse(rsp *http.Response) (*DataByIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateUserResponse{
+	response := &DataByIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResponsesAdminCreateUserSuccess
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesGetDataSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ResponsesAdminUserBadRequest
+		var dest ResponsesUserBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ResponsesNotAuthorizedToAdminEndpoint
+		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ResponsesAdminInvalidAvatarURL
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetDataNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ResponsesAdminCreateUserInternalServerError
+		var dest ResponsesInternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3566,29 +6869,57 @@ func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
 	return response, nil
 }
 
-// ParseUserByIdResponse parses an HTTP response from a UserByIdWithResponse call
-func ParseUserByIdResponse(rsp *http.Response) (*UserByIdResponse, error) {
+// ParseMerkleRootsResponse parses an HTTP response from a MerkleRootsWithResponse call
+func ParseMerkleRootsResponse(rsp *http.Response) (*MerkleRootsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UserByIdResponse{
+	response := &MerkleRootsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResponsesAdminGetUser
+		var dest ResponsesGetMerklerootsSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesGetMerklerootsBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponsesGetMerklerootsNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ResponsesGetMerklerootsConflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ResponsesAdminGetUserInternalServerError
+		var dest ResponsesGetMerklerootsInternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3599,109 +6930,116 @@ func ParseUserByIdResponse(rsp *http.Response) (*UserByIdResponse, error) {
 	return response, nil
 }
 
-// ParseAddPaymailToUserResponse parses an HTTP response from a AddPaymailToUserWithResponse call
-func ParseAddPaymailToUserResponse(rsp *http.Response) (*AddPaymailToUserResponse, error) {
+// ParseExportOperationsResponse parses an HTTP response from a ExportOperationsWithResponse call
+func ParseExportOperationsResponse(rsp *http.Response) (*ExportOperationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddPaymailToUserResponse{
+	response := &ExportOperationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ResponsesAdminAddPaymailSuccess
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ResponsesAdminUserBadRequest
+		var dest ResponsesExportHistoryBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ResponsesNotAuthorizedToAdminEndpoint
+		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ResponsesAdminInvalidAvatarURL
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSharedConfigResponse parses an HTTP response from a SharedConfigWithResponse call
-func ParseSharedConfigResponse(rsp *http.Response) (*SharedConfigResponse, error) {
+// ParseSearchOperationsResponse parses an HTTP response from a SearchOperationsWithResponse call
+func ParseSearchOperationsResponse(rsp *http.Response) (*SearchOperationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SharedConfigResponse{
+	response := &SearchOperationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResponsesSharedConfig
+		var dest ResponsesSearchOperationsSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesSearchBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ResponsesNotAuthorized
+		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDataByIdResponse parses an HTTP response from a DataByIdWithResponse call
-func ParseDataByIdResponse(rsp *http.Response) (*DataByIdResponse, error) {
+// ParseOperationByTxIDResponse parses an HTTP response from a OperationByTxIDWithResponse call
+func ParseOperationByTxIDResponse(rsp *http.Response) (*OperationByTxIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DataByIdResponse{
+	response := &OperationByTxIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResponsesGetDataSuccess
+		var dest ResponsesGetOperationSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ResponsesUserBadRequest
+		var dest ResponsesGetOperationBadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3715,7 +7053,7 @@ func ParseDataByIdResponse(rsp *http.Response) (*DataByIdResponse, error) {
 		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ResponsesGetDataNotFound
+		var dest ResponsesGetOperationNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3733,34 +7071,27 @@ func ParseDataByIdResponse(rsp *http.Response) (*DataByIdResponse, error) {
 	return response, nil
 }
 
-// ParseMerkleRootsResponse parses an HTTP response from a MerkleRootsWithResponse call
-func ParseMerkleRootsResponse(rsp *http.Response) (*MerkleRootsResponse, error) {
+// ParseStablecoinBalancesResponse parses an HTTP response from a StablecoinBalancesWithResponse call
+func ParseStablecoinBalancesResponse(rsp *http.Response) (*StablecoinBalancesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MerkleRootsResponse{
+	response := &StablecoinBalancesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResponsesGetMerklerootsSuccess
+		var dest ResponsesGetStablecoinBalancesSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ResponsesGetMerklerootsBadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ResponsesUserNotAuthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3768,22 +7099,8 @@ func ParseMerkleRootsResponse(rsp *http.Response) (*MerkleRootsResponse, error)
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ResponsesGetMerklerootsNotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ResponsesGetMerklerootsConflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ResponsesGetMerklerootsInternalServerError
+		var dest ResponsesInternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3794,22 +7111,22 @@ func ParseMerkleRootsResponse(rsp *http.Response) (*MerkleRootsResponse, error)
 	return response, nil
 }
 
-// ParseSearchOperationsResponse parses an HTTP response from a SearchOperationsWithResponse call
-func ParseSearchOperationsResponse(rsp *http.Response) (*SearchOperationsResponse, error) {
+// ParseSearchStablecoinBanknotesResponse parses an HTTP response from a SearchStablecoinBanknotesWithResponse call
+func ParseSearchStablecoinBanknotesResponse(rsp *http.Response) (*SearchStablecoinBanknotesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchOperationsResponse{
+	response := &SearchStablecoinBanknotesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResponsesSearchOperationsSuccess
+		var dest ResponsesSearchStablecoinBanknotesSuccess
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3876,6 +7193,13 @@ func ParseRecordTransactionOutlineResponse(rsp *http.Response) (*RecordTransacti
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponsesRecordTransactionUnprocessable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ResponsesRecordTransactionInternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3942,6 +7266,46 @@ func ParseCreateTransactionOutlineResponse(rsp *http.Response) (*CreateTransacti
 	return response, nil
 }
 
+// ParseCancelTransactionOutlineReservationResponse parses an HTTP response from a CancelTransactionOutlineReservationWithResponse call
+func ParseCancelTransactionOutlineReservationResponse(rsp *http.Response) (*CancelTransactionOutlineReservationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelTransactionOutlineReservationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesCancelTransactionOutlineReservationBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseCurrentUserResponse parses an HTTP response from a CurrentUserWithResponse call
 func ParseCurrentUserResponse(rsp *http.Response) (*CurrentUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3981,3 +7345,50 @@ func ParseCurrentUserResponse(rsp *http.Response) (*CurrentUserResponse, error)
 
 	return response, nil
 }
+
+// ParseCurrentUserBalanceResponse parses an HTTP response from a CurrentUserBalanceWithResponse call
+func ParseCurrentUserBalanceResponse(rsp *http.Response) (*CurrentUserBalanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CurrentUserBalanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResponsesGetUserBalanceSuccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponsesGetUserBalanceBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ResponsesUserNotAuthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponsesInternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
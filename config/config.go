@@ -66,6 +66,10 @@ type AppConfig struct {
 	TokenOverlay *TokenOverlayConfig `json:"token_overlay" mapstructure:"token_overlay"`
 	// GatewayConfig is a config for Gateway Backend Service for retrieving stablecoin rules information.
 	Gateway *GatewayConfig `json:"gateway" mapstructure:"gateway"`
+	// Stablecoin is a config for the stablecoin transfers.
+	Stablecoin *StablecoinConfig `json:"stablecoin" mapstructure:"stablecoin"`
+	// UTXOSelection is a config for selecting UTXOs to fund the transactions.
+	UTXOSelection *UTXOSelectionConfig `json:"utxo_selection" mapstructure:"utxo_selection"`
 }
 
 // AuthenticationConfig is the configuration for Authentication
@@ -190,6 +194,7 @@ type PaymailConfig struct {
 	// DomainValidationEnabled should be turned off if hosted domain is not paymail related.
 	DomainValidationEnabled bool `json:"domain_validation_enabled" mapstructure:"domain_validation_enabled"`
 	// SenderValidationEnabled should be turned on for extra security.
+	// It also requires the incoming stablecoin transfer intents and transfers to be signed with the sender's paymail PKI key.
 	SenderValidationEnabled bool `json:"sender_validation_enabled" mapstructure:"sender_validation_enabled"`
 }
 
@@ -209,6 +214,8 @@ type BHSConfig struct {
 	AuthToken string `json:"auth_token" mapstructure:"auth_token"`
 	// URL is the URL used to communicate with Block Headers Service (BHS)
 	URL string `json:"url" mapstructure:"url"`
+	// ConfirmationDepth is the number of confirmations after which a mined transaction is considered safe from chain reorganizations
+	ConfirmationDepth uint32 `json:"confirmation_depth" mapstructure:"confirmation_depth"`
 }
 
 // TaskManagerConfig is a configuration for the taskmanager
@@ -253,10 +260,83 @@ type TokenOverlayConfig struct {
 	URL string `json:"url" mapstructure:"url"`
 	// APIVersion is version of Token Overlay Service api.
 	APIVersion string `json:"api_version" mapstructure:"api_version"`
+	// ReconciliationInterval is how often the token utxos of the wallet are compared with the overlay state, zero disables the reconciliation.
+	ReconciliationInterval time.Duration `json:"reconciliation_interval" mapstructure:"reconciliation_interval"`
+	// BroadcastPolicy tells whether the token transaction is broadcast before the overlay has registered it.
+	BroadcastPolicy OverlayBroadcastPolicy `json:"broadcast_policy" mapstructure:"broadcast_policy"`
 }
 
+// OverlayBroadcastPolicy is the policy of broadcasting the token transactions waiting for the overlay registration.
+type OverlayBroadcastPolicy string
+
+const (
+	// OverlayBroadcastAnyway broadcasts the token transaction while its overlay registration is retried.
+	OverlayBroadcastAnyway OverlayBroadcastPolicy = "broadcast"
+	// OverlayBroadcastHold holds the broadcast of the token transaction until the overlay has registered it.
+	OverlayBroadcastHold OverlayBroadcastPolicy = "hold"
+)
+
 // GatewayConfig is a config for Gateway Backend Service for retrieving stablecoin rules information.
 type GatewayConfig struct {
 	// URL is a URL for Gateway Backend Service.
 	URL string `json:"url" mapstructure:"url"`
+	// RulesCacheTTL is the time for which the stablecoin rules are cached, they are refreshed in the background every half of this time.
+	RulesCacheTTL time.Duration `json:"rules_cache_ttl" mapstructure:"rules_cache_ttl"`
+}
+
+// StablecoinConfig is a config for the stablecoin transfers.
+type StablecoinConfig struct {
+	// IntentTTL is the time after which the unused transfer intent expires and cannot be used for the transfer anymore.
+	IntentTTL time.Duration `json:"intent_ttl" mapstructure:"intent_ttl"`
+	// SenderPolicy is a config for the policies deciding who can open a transfer intent.
+	SenderPolicy *SenderPolicyConfig `json:"sender_policy" mapstructure:"sender_policy"`
+	// Signers is a list of the paymails signing the transfer intents and the transfers sent to other paymail hosts.
+	// Paymails without a signer send unsigned requests.
+	Signers []*StablecoinSignerConfig `json:"signers" mapstructure:"signers"`
+}
+
+// StablecoinSignerConfig is a config for signing the stablecoin requests sent by a paymail.
+type StablecoinSignerConfig struct {
+	// Paymail is the sender paymail address.
+	Paymail string `json:"paymail" mapstructure:"paymail"`
+	// PrivateKey is the WIF of the private key of the paymail PKI, so the receiver can verify the signature.
+	// The key must not be committed in the config file, it should be provided by PrivateKeyEnv instead.
+	PrivateKey string `json:"private_key" mapstructure:"private_key"`
+	// PrivateKeyEnv is the name of the environment variable holding the WIF of the private key of the paymail PKI,
+	// so the key can be injected from a secret store. Exactly one of PrivateKey and PrivateKeyEnv must be set.
+	// The keys held by a KMS or an HSM can be used by providing a custom signer with engine.WithStablecoinSigner.
+	PrivateKeyEnv string `json:"private_key_env" mapstructure:"private_key_env"`
+}
+
+// UTXOSelectionConfig is a config for selecting UTXOs to fund the transactions.
+type UTXOSelectionConfig struct {
+	// Strategy is the default strategy of selecting UTXOs, used when the transaction specification doesn't provide one.
+	// Possible values: oldest_first, largest_first, smallest_first, branch_and_bound, random.
+	// The strategy is validated by the engine when the UTXO selector is created.
+	Strategy string `json:"strategy" mapstructure:"strategy"`
+	// ReservationTTL is the time for which the selected UTXOs are reserved for the transaction outline,
+	// unless the outline is recorded or its reservation is cancelled earlier.
+	ReservationTTL time.Duration `json:"reservation_ttl" mapstructure:"reservation_ttl"`
+}
+
+// SenderPolicyConfig is a config for the policies deciding who can open a transfer intent.
+type SenderPolicyConfig struct {
+	// AllowList is a list of paymails or domains allowed to open a transfer intent, empty list allows everyone.
+	AllowList []string `json:"allow_list" mapstructure:"allow_list"`
+	// DenyList is a list of paymails or domains which are not allowed to open a transfer intent, it takes precedence over AllowList.
+	DenyList []string `json:"deny_list" mapstructure:"deny_list"`
+	// DailySenderLimit is the max amount of the stablecoin a single sender can transfer within a day (UTC), 0 means no limit.
+	DailySenderLimit uint64 `json:"daily_sender_limit" mapstructure:"daily_sender_limit"`
+	// DailyReceiverLimit is the max amount of the stablecoin a single receiver can receive within a day (UTC), 0 means no limit.
+	DailyReceiverLimit uint64 `json:"daily_receiver_limit" mapstructure:"daily_receiver_limit"`
+	// Callback is a config for the external policy service (e.g. KYC), which is asked about every transfer intent.
+	Callback *SenderPolicyCallbackConfig `json:"callback" mapstructure:"callback"`
+}
+
+// SenderPolicyCallbackConfig is a config for the external policy service.
+type SenderPolicyCallbackConfig struct {
+	// URL is a URL of the external policy service, empty URL disables the callback.
+	URL string `json:"url" mapstructure:"url"`
+	// Timeout is the max time of waiting for the external policy service decision.
+	Timeout time.Duration `json:"timeout" mapstructure:"timeout"`
 }
@@ -36,6 +36,8 @@ func GetDefaultAppConfig() *AppConfig {
 		CustomFeeUnit:        nil,
 		TokenOverlay:         getTokenOverlayConfig(),
 		Gateway:              getGatewayConfig(),
+		Stablecoin:           getStablecoinConfig(),
+		UTXOSelection:        getUTXOSelectionConfig(),
 	}
 }
 
@@ -140,8 +142,9 @@ func getPaymailDefaults() *PaymailConfig {
 
 func getBHSDefaults() *BHSConfig {
 	return &BHSConfig{
-		AuthToken: "mQZQ6WmxURxWz5ch",
-		URL:       "http://localhost:8080",
+		AuthToken:         "mQZQ6WmxURxWz5ch",
+		URL:               "http://localhost:8080",
+		ConfirmationDepth: 6,
 	}
 }
 
@@ -175,13 +178,40 @@ func getExperimentalFeaturesConfig() *ExperimentalConfig {
 
 func getTokenOverlayConfig() *TokenOverlayConfig {
 	return &TokenOverlayConfig{
-		URL:        "http://localhost:3091",
-		APIVersion: "v2",
+		URL:                    "http://localhost:3091",
+		APIVersion:             "v2",
+		ReconciliationInterval: 30 * time.Minute,
+		BroadcastPolicy:        OverlayBroadcastAnyway,
 	}
 }
 
 func getGatewayConfig() *GatewayConfig {
 	return &GatewayConfig{
-		URL: "http://localhost:8090",
+		URL:           "http://localhost:8090",
+		RulesCacheTTL: 10 * time.Minute,
+	}
+}
+
+func getStablecoinConfig() *StablecoinConfig {
+	return &StablecoinConfig{
+		IntentTTL: 15 * time.Minute,
+		SenderPolicy: &SenderPolicyConfig{
+			AllowList:          []string{},
+			DenyList:           []string{},
+			DailySenderLimit:   0,
+			DailyReceiverLimit: 0,
+			Callback: &SenderPolicyCallbackConfig{
+				URL:     "",
+				Timeout: 5 * time.Second,
+			},
+		},
+		Signers: []*StablecoinSignerConfig{},
+	}
+}
+
+func getUTXOSelectionConfig() *UTXOSelectionConfig {
+	return &UTXOSelectionConfig{
+		Strategy:       "oldest_first",
+		ReservationTTL: 10 * time.Minute,
 	}
 }
@@ -36,5 +36,21 @@ func (c *AppConfig) Validate() error {
 		return err
 	}
 
+	if err = c.TokenOverlay.Validate(); err != nil {
+		return err
+	}
+
+	if err = c.Gateway.Validate(); err != nil {
+		return err
+	}
+
+	if err = c.Stablecoin.Validate(); err != nil {
+		return err
+	}
+
+	if err = c.UTXOSelection.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
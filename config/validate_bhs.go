@@ -12,5 +12,9 @@ func (b *BHSConfig) Validate() error {
 		return spverrors.Newf("bhs url is required")
 	}
 
+	if b.ConfirmationDepth == 0 {
+		return spverrors.Newf("bhs confirmation depth must be greater than 0")
+	}
+
 	return nil
 }
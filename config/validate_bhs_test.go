@@ -42,6 +42,11 @@ func TestValidateBHSConfig(t *testing.T) {
 				cfg.BHS.URL = ""
 			},
 		},
+		"return error when confirmation depth is zero": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.BHS.ConfirmationDepth = 0
+			},
+		},
 		"return error when config is nil": {
 			scenario: func(cfg *config.AppConfig) {
 				cfg.BHS = nil
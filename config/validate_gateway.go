@@ -0,0 +1,20 @@
+package config
+
+import "github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+
+// Validate checks the configuration for specific rules
+func (g *GatewayConfig) Validate() error {
+	if g == nil {
+		return spverrors.Newf("gateway config is required")
+	}
+
+	if g.URL == "" {
+		return spverrors.Newf("gateway url is required")
+	}
+
+	if g.RulesCacheTTL <= 0 {
+		return spverrors.Newf("gateway rules cache ttl must be greater than zero: %s", g.RulesCacheTTL)
+	}
+
+	return nil
+}
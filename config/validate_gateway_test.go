@@ -0,0 +1,51 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGatewayConfig(t *testing.T) {
+	t.Parallel()
+
+	invalidConfigTests := map[string]struct {
+		scenario func(cfg *config.AppConfig)
+	}{
+		"return error when config is nil": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Gateway = nil
+			},
+		},
+		"return error when url is empty": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Gateway.URL = ""
+			},
+		},
+		"return error when rules cache ttl is zero": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Gateway.RulesCacheTTL = 0
+			},
+		},
+		"return error when rules cache ttl is negative": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Gateway.RulesCacheTTL = -1
+			},
+		},
+	}
+	for name, test := range invalidConfigTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			cfg := config.GetDefaultAppConfig()
+
+			test.scenario(cfg)
+
+			// when:
+			err := cfg.Validate()
+
+			// then:
+			require.Error(t, err)
+		})
+	}
+}
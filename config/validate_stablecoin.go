@@ -0,0 +1,58 @@
+package config
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// Validate checks the configuration for specific rules
+func (s *StablecoinConfig) Validate() error {
+	if s == nil {
+		return spverrors.Newf("stablecoin config is required")
+	}
+
+	if s.IntentTTL <= 0 {
+		return spverrors.Newf("stablecoin intent ttl must be greater than zero: %s", s.IntentTTL)
+	}
+
+	for _, signer := range s.Signers {
+		if signer == nil || strings.TrimSpace(signer.Paymail) == "" {
+			return spverrors.Newf("stablecoin signer requires paymail")
+		}
+		if (strings.TrimSpace(signer.PrivateKey) == "") == (strings.TrimSpace(signer.PrivateKeyEnv) == "") {
+			return spverrors.Newf("stablecoin signer %s requires exactly one of private key and private key env", signer.Paymail)
+		}
+	}
+
+	return s.SenderPolicy.Validate()
+}
+
+// Validate checks the configuration for specific rules
+func (p *SenderPolicyConfig) Validate() error {
+	if p == nil {
+		return spverrors.Newf("stablecoin sender policy config is required")
+	}
+
+	for _, entry := range append(p.AllowList, p.DenyList...) {
+		if strings.TrimSpace(entry) == "" {
+			return spverrors.Newf("stablecoin sender policy lists cannot contain empty entries")
+		}
+	}
+
+	if p.Callback == nil || p.Callback.URL == "" {
+		return nil
+	}
+
+	callbackURL, err := url.Parse(p.Callback.URL)
+	if err != nil || (callbackURL.Scheme != "http" && callbackURL.Scheme != "https") || callbackURL.Host == "" {
+		return spverrors.Newf("stablecoin sender policy callback url is invalid: %s", p.Callback.URL)
+	}
+
+	if p.Callback.Timeout <= 0 {
+		return spverrors.Newf("stablecoin sender policy callback timeout must be greater than zero: %s", p.Callback.Timeout)
+	}
+
+	return nil
+}
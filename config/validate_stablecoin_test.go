@@ -0,0 +1,136 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStablecoinConfig(t *testing.T) {
+	t.Parallel()
+
+	validConfigTests := map[string]struct {
+		scenario func(cfg *config.AppConfig)
+	}{
+		"valid default config": {
+			scenario: func(cfg *config.AppConfig) {},
+		},
+		"valid config with sender policies": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.SenderPolicy.AllowList = []string{"example.com"}
+				cfg.Stablecoin.SenderPolicy.DenyList = []string{"mallory@example.com"}
+				cfg.Stablecoin.SenderPolicy.DailySenderLimit = 1000
+				cfg.Stablecoin.SenderPolicy.DailyReceiverLimit = 5000
+				cfg.Stablecoin.SenderPolicy.Callback.URL = "https://kyc.example.com/policy"
+			},
+		},
+		"valid config with signers": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.Signers = []*config.StablecoinSignerConfig{
+					{Paymail: "treasury@example.com", PrivateKey: "KyS3s1kGgjJLdd5mKHnXNG1zNnvbVBpQ4n4NbXnWWKPuqgCUMmbR"},
+				}
+			},
+		},
+		"valid config with signer key from env": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.Signers = []*config.StablecoinSignerConfig{
+					{Paymail: "treasury@example.com", PrivateKeyEnv: "TREASURY_PKI_KEY"},
+				}
+			},
+		},
+	}
+	for name, test := range validConfigTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			cfg := config.GetDefaultAppConfig()
+
+			test.scenario(cfg)
+
+			// when:
+			err := cfg.Validate()
+
+			// then:
+			require.NoError(t, err)
+		})
+	}
+
+	invalidConfigTests := map[string]struct {
+		scenario func(cfg *config.AppConfig)
+	}{
+		"return error when config is nil": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin = nil
+			},
+		},
+		"return error when intent ttl is zero": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.IntentTTL = 0
+			},
+		},
+		"return error when intent ttl is negative": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.IntentTTL = -1
+			},
+		},
+		"return error when sender policy is nil": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.SenderPolicy = nil
+			},
+		},
+		"return error when allow list contains empty entry": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.SenderPolicy.AllowList = []string{"alice@example.com", " "}
+			},
+		},
+		"return error when deny list contains empty entry": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.SenderPolicy.DenyList = []string{""}
+			},
+		},
+		"return error when signer has no paymail": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.Signers = []*config.StablecoinSignerConfig{{PrivateKey: "KyS3s1kGgjJLdd5mKHnXNG1zNnvbVBpQ4n4NbXnWWKPuqgCUMmbR"}}
+			},
+		},
+		"return error when signer has no private key": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.Signers = []*config.StablecoinSignerConfig{{Paymail: "treasury@example.com"}}
+			},
+		},
+		"return error when signer has both private key and private key env": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.Signers = []*config.StablecoinSignerConfig{{
+					Paymail:       "treasury@example.com",
+					PrivateKey:    "KyS3s1kGgjJLdd5mKHnXNG1zNnvbVBpQ4n4NbXnWWKPuqgCUMmbR",
+					PrivateKeyEnv: "TREASURY_PKI_KEY",
+				}}
+			},
+		},
+		"return error when callback url is invalid": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.SenderPolicy.Callback.URL = "localhost:8080/policy"
+			},
+		},
+		"return error when callback timeout is zero": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.Stablecoin.SenderPolicy.Callback.URL = "http://localhost:8080/policy"
+				cfg.Stablecoin.SenderPolicy.Callback.Timeout = 0
+			},
+		},
+	}
+	for name, test := range invalidConfigTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			cfg := config.GetDefaultAppConfig()
+
+			test.scenario(cfg)
+
+			// when:
+			err := cfg.Validate()
+
+			// then:
+			require.Error(t, err)
+		})
+	}
+}
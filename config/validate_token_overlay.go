@@ -0,0 +1,25 @@
+package config
+
+import "github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+
+// Validate checks the configuration for specific rules
+func (t *TokenOverlayConfig) Validate() error {
+	if t == nil {
+		return spverrors.Newf("token overlay config is required")
+	}
+
+	if t.URL == "" {
+		return spverrors.Newf("token overlay url is required")
+	}
+
+	if t.ReconciliationInterval < 0 {
+		return spverrors.Newf("token overlay reconciliation interval cannot be negative: %s", t.ReconciliationInterval)
+	}
+
+	switch t.BroadcastPolicy {
+	case OverlayBroadcastAnyway, OverlayBroadcastHold:
+		return nil
+	default:
+		return spverrors.Newf("token overlay broadcast policy must be %q or %q: %q", OverlayBroadcastAnyway, OverlayBroadcastHold, t.BroadcastPolicy)
+	}
+}
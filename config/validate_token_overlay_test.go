@@ -0,0 +1,80 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTokenOverlayConfig(t *testing.T) {
+	t.Parallel()
+
+	validConfigTests := map[string]struct {
+		scenario func(cfg *config.AppConfig)
+	}{
+		"hold broadcast policy": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.TokenOverlay.BroadcastPolicy = config.OverlayBroadcastHold
+			},
+		},
+		"disabled reconciliation": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.TokenOverlay.ReconciliationInterval = 0
+			},
+		},
+	}
+	for name, test := range validConfigTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			cfg := config.GetDefaultAppConfig()
+
+			test.scenario(cfg)
+
+			// when:
+			err := cfg.Validate()
+
+			// then:
+			require.NoError(t, err)
+		})
+	}
+
+	invalidConfigTests := map[string]struct {
+		scenario func(cfg *config.AppConfig)
+	}{
+		"return error when config is nil": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.TokenOverlay = nil
+			},
+		},
+		"return error when url is empty": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.TokenOverlay.URL = ""
+			},
+		},
+		"return error when reconciliation interval is negative": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.TokenOverlay.ReconciliationInterval = -1
+			},
+		},
+		"return error when broadcast policy is unknown": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.TokenOverlay.BroadcastPolicy = "later"
+			},
+		},
+	}
+	for name, test := range invalidConfigTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			cfg := config.GetDefaultAppConfig()
+
+			test.scenario(cfg)
+
+			// when:
+			err := cfg.Validate()
+
+			// then:
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package config
+
+import "github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+
+// Validate checks the configuration for specific rules
+func (u *UTXOSelectionConfig) Validate() error {
+	if u == nil {
+		return spverrors.Newf("utxo selection config is required")
+	}
+
+	if u.ReservationTTL <= 0 {
+		return spverrors.Newf("utxo reservation ttl must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUTXOSelectionConfig(t *testing.T) {
+	t.Parallel()
+
+	validConfigTests := map[string]struct {
+		scenario func(cfg *config.AppConfig)
+	}{
+		"valid default config": {
+			scenario: func(cfg *config.AppConfig) {},
+		},
+		"valid largest first strategy": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.UTXOSelection.Strategy = "largest_first"
+			},
+		},
+		"valid smallest first strategy": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.UTXOSelection.Strategy = "smallest_first"
+			},
+		},
+		"valid branch and bound strategy": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.UTXOSelection.Strategy = "branch_and_bound"
+			},
+		},
+		"valid random strategy": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.UTXOSelection.Strategy = "random"
+			},
+		},
+		"valid custom reservation ttl": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.UTXOSelection.ReservationTTL = 30 * time.Second
+			},
+		},
+	}
+	for name, test := range validConfigTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			cfg := config.GetDefaultAppConfig()
+
+			test.scenario(cfg)
+
+			// when:
+			err := cfg.Validate()
+
+			// then:
+			require.NoError(t, err)
+		})
+	}
+
+	invalidConfigTests := map[string]struct {
+		scenario func(cfg *config.AppConfig)
+	}{
+		"return error when config is nil": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.UTXOSelection = nil
+			},
+		},
+		"return error when reservation ttl is zero": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.UTXOSelection.ReservationTTL = 0
+			},
+		},
+		"return error when reservation ttl is negative": {
+			scenario: func(cfg *config.AppConfig) {
+				cfg.UTXOSelection.ReservationTTL = -time.Minute
+			},
+		},
+	}
+	for name, test := range invalidConfigTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			cfg := config.GetDefaultAppConfig()
+
+			test.scenario(cfg)
+
+			// when:
+			err := cfg.Validate()
+
+			// then:
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/samber/lo"
+)
+
+const transactionsHistoryBatchSize = 500
+
+// TransactionHistoryEntry is a transaction seen from the perspective of a single xpub,
+// with the running balance of that xpub after the transaction
+type TransactionHistoryEntry struct {
+	Transaction  *Transaction
+	XPubID       string
+	Value        int64
+	Direction    TransactionDirection
+	Counterparty string
+	Balance      int64
+}
+
+// TransactionsHistory iterates over the transactions of the xpub (or of all xpubs when xPubID is empty) in chronological order.
+// Every transaction yields an entry per involved xpub, with the value, counterparty and running balance of that xpub.
+// Only the entries created in the given time range are yielded, the balances start from the opening balances
+// summed up by a single aggregate query over the transactions created before the range.
+// The transactions are fetched in batches with the creation time used as a cursor, so the whole history is never loaded into memory.
+func (c *Client) TransactionsHistory(ctx context.Context, xPubID string, from, to *time.Time) iter.Seq2[*TransactionHistoryEntry, error] {
+	return func(yield func(*TransactionHistoryEntry, error) bool) {
+		balances := map[string]int64{}
+
+		var cursor time.Time
+		if from != nil {
+			var err error
+			if balances, err = c.transactionsHistoryOpeningBalances(ctx, xPubID, *from); err != nil {
+				yield(nil, spverrors.Wrapf(err, "failed to get opening balances of transactions history"))
+				return
+			}
+			cursor = *from
+		}
+		// IDs of the transactions created exactly at the cursor time, which were already processed
+		processedAtCursor := map[string]bool{}
+
+		for {
+			transactions, err := c.transactionsHistoryBatch(ctx, xPubID, cursor, to)
+			if err != nil {
+				yield(nil, spverrors.Wrapf(err, "failed to get transactions history"))
+				return
+			}
+
+			progressed := false
+			for _, tx := range transactions {
+				if tx.CreatedAt.Equal(cursor) && processedAtCursor[tx.ID] {
+					continue
+				}
+				progressed = true
+				if !tx.CreatedAt.Equal(cursor) {
+					cursor = tx.CreatedAt
+					clear(processedAtCursor)
+				}
+				processedAtCursor[tx.ID] = true
+
+				for _, entry := range transactionHistoryEntries(tx, xPubID, balances) {
+					if !yield(entry, nil) {
+						return
+					}
+				}
+			}
+
+			if len(transactions) < transactionsHistoryBatchSize {
+				return
+			}
+			if !progressed {
+				yield(nil, spverrors.Newf("too many transactions created at %s to iterate over them", cursor))
+				return
+			}
+		}
+	}
+}
+
+// transactionsHistoryOpeningBalances sums the values of the transactions created before the given time per xpub
+// (only of the given xpub, if set), so the history doesn't need to iterate over the transactions before the range
+func (c *Client) transactionsHistoryOpeningBalances(ctx context.Context, xPubID string, before time.Time) (map[string]int64, error) {
+	ds := c.Datastore()
+	transactions := ds.GetTableName(tableTransactions)
+
+	outputValues := fmt.Sprintf("%s, json_each(%s.xpub_output_value) AS output_values", transactions, transactions)
+	balance := "SUM(output_values.value)"
+	if ds.Engine() == datastore.PostgreSQL {
+		outputValues = fmt.Sprintf("%s, jsonb_each_text(%s.xpub_output_value) AS output_values", transactions, transactions)
+		balance = "SUM(output_values.value::bigint)"
+	}
+
+	query := ds.DB().WithContext(ctx).
+		Table(outputValues).
+		Select("output_values.key AS xpub_id, "+balance+" AS balance").
+		Where(fmt.Sprintf("%s.deleted_at IS NULL AND %s.created_at < ?", transactions, transactions), before).
+		Group("output_values.key")
+	if xPubID != "" {
+		query = query.Where("output_values.key = ?", xPubID)
+	}
+
+	var rows []struct {
+		XpubID  string
+		Balance int64
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to sum transaction values")
+	}
+
+	balances := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		balances[row.XpubID] = row.Balance
+	}
+	return balances, nil
+}
+
+func (c *Client) transactionsHistoryBatch(ctx context.Context, xPubID string, cursor time.Time, to *time.Time) ([]*Transaction, error) {
+	createdAt := map[string]interface{}{}
+	if !cursor.IsZero() {
+		createdAt["$gte"] = cursor
+	}
+	if to != nil {
+		createdAt["$lte"] = *to
+	}
+
+	conditions := map[string]interface{}{
+		"deleted_at": nil,
+	}
+	if len(createdAt) > 0 {
+		conditions["created_at"] = createdAt
+	}
+
+	queryParams := &datastore.QueryParams{
+		Page:          1,
+		PageSize:      transactionsHistoryBatchSize,
+		OrderByField:  createdAtField,
+		SortDirection: datastore.SortAsc,
+	}
+
+	if xPubID != "" {
+		return c.GetTransactionsByXpubID(ctx, xPubID, nil, conditions, queryParams)
+	}
+	return c.GetTransactions(ctx, nil, conditions, queryParams)
+}
+
+func transactionHistoryEntries(tx *Transaction, xPubID string, balances map[string]int64) []*TransactionHistoryEntry {
+	xPubIDs := []string{xPubID}
+	if xPubID == "" {
+		xPubIDs = slices.Sorted(func(yield func(string) bool) {
+			for id := range tx.XpubOutputValue {
+				if !yield(id) {
+					return
+				}
+			}
+		})
+	}
+
+	return lo.Map(xPubIDs, func(id string, _ int) *TransactionHistoryEntry {
+		value := tx.XpubOutputValue[id]
+		balances[id] += value
+
+		direction := TransactionDirectionOut
+		counterpartyKey := "receiver"
+		if value > 0 {
+			direction = TransactionDirectionIn
+			counterpartyKey = "sender"
+		}
+
+		return &TransactionHistoryEntry{
+			Transaction:  tx,
+			XPubID:       id,
+			Value:        value,
+			Direction:    direction,
+			Counterparty: transactionMetadataString(tx, id, counterpartyKey),
+			Balance:      balances[id],
+		}
+	})
+}
+
+// transactionMetadataString returns the string value of the metadata key, preferring the xpub specific metadata
+func transactionMetadataString(tx *Transaction, xPubID, key string) string {
+	if value, ok := tx.XpubMetadata[xPubID][key].(string); ok {
+		return value
+	}
+	value, _ := tx.Metadata[key].(string)
+	return value
+}
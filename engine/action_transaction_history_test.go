@@ -0,0 +1,195 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_TransactionsHistory(t *testing.T) {
+	const otherXPubID = "1111111111111111111111111111111111111111111111111111111111111111"
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	historyTx := func(i int, createdAt time.Time, values XpubOutputValue) *Transaction {
+		tx := &Transaction{
+			Model:           Model{CreatedAt: createdAt, UpdatedAt: createdAt},
+			TransactionBase: TransactionBase{ID: utils.Hash(fmt.Sprintf("history-tx-%d", i))},
+			XpubOutputValue: values,
+			TxStatus:        TxStatusMined,
+		}
+		for xPubID, value := range values {
+			if value > 0 {
+				tx.XpubOutIDs = append(tx.XpubOutIDs, xPubID)
+			} else {
+				tx.XpubInIDs = append(tx.XpubInIDs, xPubID)
+			}
+		}
+		return tx
+	}
+
+	setup := func(t *testing.T, transactions []*Transaction) (context.Context, ClientInterface) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, false, withTaskManagerMockup())
+		t.Cleanup(deferMe)
+
+		require.NoError(t, client.Datastore().DB().CreateInBatches(transactions, 100).Error)
+		return ctx, client
+	}
+
+	collect := func(ctx context.Context, client ClientInterface, xPubID string, from, to *time.Time) ([]*TransactionHistoryEntry, error) {
+		var entries []*TransactionHistoryEntry
+		for entry, err := range client.TransactionsHistory(ctx, xPubID, from, to) {
+			if err != nil {
+				return entries, err
+			}
+			entries = append(entries, entry)
+		}
+		return entries, nil
+	}
+
+	t.Run("iterate over the transactions crossing the batch boundary", func(t *testing.T) {
+		// given:
+		count := transactionsHistoryBatchSize + 1
+		transactions := make([]*Transaction, 0, count)
+		for i := range count {
+			transactions = append(transactions, historyTx(i, start.Add(time.Duration(i)*time.Second), XpubOutputValue{testXPubID: 1}))
+		}
+		ctx, client := setup(t, transactions)
+
+		// when:
+		entries, err := collect(ctx, client, testXPubID, nil, nil)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, entries, count)
+		for i, entry := range entries {
+			assert.Equal(t, transactions[i].ID, entry.Transaction.ID)
+			assert.Equal(t, int64(i+1), entry.Balance)
+		}
+	})
+
+	t.Run("yield every transaction created at the cursor time only once", func(t *testing.T) {
+		// given:
+		var transactions []*Transaction
+		for i := range 400 {
+			transactions = append(transactions, historyTx(i, start.Add(time.Duration(i)*time.Second), XpubOutputValue{testXPubID: 1}))
+		}
+		sameTime := start.Add(time.Hour)
+		for i := 400; i < 600; i++ {
+			transactions = append(transactions, historyTx(i, sameTime, XpubOutputValue{testXPubID: 1}))
+		}
+		ctx, client := setup(t, transactions)
+
+		// when:
+		entries, err := collect(ctx, client, testXPubID, nil, nil)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, entries, len(transactions))
+
+		seen := map[string]bool{}
+		for _, entry := range entries {
+			assert.False(t, seen[entry.Transaction.ID], "transaction %s yielded more than once", entry.Transaction.ID)
+			seen[entry.Transaction.ID] = true
+		}
+		assert.Equal(t, int64(len(transactions)), entries[len(entries)-1].Balance)
+	})
+
+	t.Run("return error when a batch is filled with transactions created at the same time", func(t *testing.T) {
+		// given:
+		var transactions []*Transaction
+		for i := range transactionsHistoryBatchSize + 1 {
+			transactions = append(transactions, historyTx(i, start, XpubOutputValue{testXPubID: 1}))
+		}
+		ctx, client := setup(t, transactions)
+
+		// when:
+		_, err := collect(ctx, client, testXPubID, nil, nil)
+
+		// then:
+		require.ErrorContains(t, err, "too many transactions created at")
+	})
+
+	t.Run("yield the transactions from the given time with the running balance of the whole history", func(t *testing.T) {
+		// given:
+		transactions := []*Transaction{
+			historyTx(0, start, XpubOutputValue{testXPubID: 100}),
+			historyTx(1, start.Add(24*time.Hour), XpubOutputValue{testXPubID: 50}),
+			historyTx(2, start.Add(48*time.Hour), XpubOutputValue{testXPubID: -30}),
+		}
+		ctx, client := setup(t, transactions)
+		from := start.Add(24 * time.Hour)
+
+		// when:
+		entries, err := collect(ctx, client, testXPubID, &from, nil)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		assert.Equal(t, transactions[1].ID, entries[0].Transaction.ID)
+		assert.Equal(t, TransactionDirectionIn, entries[0].Direction)
+		assert.Equal(t, int64(50), entries[0].Value)
+		assert.Equal(t, int64(150), entries[0].Balance)
+
+		assert.Equal(t, transactions[2].ID, entries[1].Transaction.ID)
+		assert.Equal(t, TransactionDirectionOut, entries[1].Direction)
+		assert.Equal(t, int64(-30), entries[1].Value)
+		assert.Equal(t, int64(120), entries[1].Balance)
+	})
+
+	t.Run("yield the transactions of all xpubs from the given time with their opening balances", func(t *testing.T) {
+		// given:
+		transactions := []*Transaction{
+			historyTx(0, start, XpubOutputValue{testXPubID: 100}),
+			historyTx(1, start.Add(time.Second), XpubOutputValue{testXPubID: -40, otherXPubID: 30}),
+			historyTx(2, start.Add(2*time.Second), XpubOutputValue{otherXPubID: 5}),
+		}
+		ctx, client := setup(t, transactions)
+		from := start.Add(2 * time.Second)
+
+		// when:
+		entries, err := collect(ctx, client, "", &from, nil)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		assert.Equal(t, transactions[2].ID, entries[0].Transaction.ID)
+		assert.Equal(t, otherXPubID, entries[0].XPubID)
+		assert.Equal(t, int64(35), entries[0].Balance)
+	})
+
+	t.Run("yield an entry per xpub with separate balances when iterating over all xpubs", func(t *testing.T) {
+		// given:
+		transactions := []*Transaction{
+			historyTx(0, start, XpubOutputValue{testXPubID: 100}),
+			historyTx(1, start.Add(time.Second), XpubOutputValue{testXPubID: -40, otherXPubID: 30}),
+			historyTx(2, start.Add(2*time.Second), XpubOutputValue{otherXPubID: 5}),
+		}
+		ctx, client := setup(t, transactions)
+		to := start.Add(time.Second)
+
+		// when:
+		entries, err := collect(ctx, client, "", nil, &to)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+
+		assert.Equal(t, testXPubID, entries[0].XPubID)
+		assert.Equal(t, int64(100), entries[0].Balance)
+
+		assert.Equal(t, transactions[1].ID, entries[1].Transaction.ID)
+		assert.Equal(t, otherXPubID, entries[1].XPubID)
+		assert.Equal(t, int64(30), entries[1].Balance)
+
+		assert.Equal(t, transactions[1].ID, entries[2].Transaction.ID)
+		assert.Equal(t, testXPubID, entries[2].XPubID)
+		assert.Equal(t, int64(60), entries[2].Balance)
+	})
+}
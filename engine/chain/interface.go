@@ -22,6 +22,7 @@ type ARCService interface {
 type BHSService interface {
 	GetMerkleRoots(ctx context.Context, query url.Values) (*models.MerkleRootsBHSResponse, error)
 	VerifyMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (bool, error)
+	GetChainTip(ctx context.Context) (*chainmodels.ChainTip, error)
 	HealthcheckBHS(ctx context.Context) error
 }
 
@@ -50,3 +50,22 @@ func bhsCfg(url, authToken string) chainmodels.BHSConfig {
 		AuthToken: authToken,
 	}
 }
+
+func bhsMockChainTip(httpCode int, response string) *resty.Client {
+	transport := httpmock.NewMockTransport()
+	client := resty.New()
+	client.GetClient().Transport = transport
+
+	responder := func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Authorization") != "Bearer "+bhsToken {
+			return httpmock.NewStringResponse(http.StatusUnauthorized, ""), nil
+		}
+
+		res := httpmock.NewStringResponse(httpCode, response)
+		res.Header.Set("Content-Type", "application/json")
+		return res, nil
+	}
+
+	transport.RegisterResponder("GET", fmt.Sprintf("%s/api/v1/chain/tip/longest", bhsURL), responder)
+	return client
+}
@@ -0,0 +1,46 @@
+package bhs
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// GetChainTip returns the top block header of the longest chain from Block Header Service
+func (s *Service) GetChainTip(ctx context.Context) (*chainmodels.ChainTip, error) {
+	bhsURL, err := s.createBHSURL("/chain/tip/longest")
+	if err != nil {
+		return nil, err
+	}
+
+	var response chainmodels.ChainTip
+	req := s.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetResult(&response)
+
+	if s.bhsCfg.AuthToken != "" {
+		req.SetAuthToken(s.bhsCfg.AuthToken)
+	} else {
+		s.logger.Warn().Msg("warning creating Block Headers Service url - auth token is not set. Some requests might not work")
+	}
+
+	res, err := req.Get(bhsURL.String())
+	if err != nil {
+		var e net.Error
+		if errors.As(err, &e) {
+			return nil, chainerrors.ErrBHSUnreachable.Wrap(err)
+		}
+		return nil, spverrors.ErrInternal.Wrap(err)
+	}
+
+	if !res.IsSuccess() {
+		return nil, mapBHSErrorResponseToSpverror(res)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,54 @@
+package bhs_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/chain"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChainTip(t *testing.T) {
+	t.Run("Get chain tip", func(t *testing.T) {
+		httpClient := bhsMockChainTip(http.StatusOK, `{
+			"header": {
+				"hash": "00000000000000000f0905597b6cac80031f0f56834e74dce1a714c682a9ed38",
+				"merkleRoot": "f67ae53720205a55f4e99c632debabb68b6df0dc0f68affd200a076aee6e80e6"
+			},
+			"state": "LONGEST_CHAIN",
+			"height": 885803
+		}`)
+		service := chain.NewChainService(tester.Logger(t), httpClient, chainmodels.ARCConfig{}, bhsCfg(bhsURL, bhsToken))
+
+		tip, err := service.GetChainTip(context.Background())
+
+		require.NoError(t, err)
+		require.Equal(t, int64(885803), tip.Height)
+		require.Equal(t, "00000000000000000f0905597b6cac80031f0f56834e74dce1a714c682a9ed38", tip.Header.Hash)
+		require.Equal(t, "f67ae53720205a55f4e99c632debabb68b6df0dc0f68affd200a076aee6e80e6", tip.Header.MerkleRoot)
+	})
+
+	t.Run("Get chain tip with wrong token", func(t *testing.T) {
+		httpClient := bhsMockChainTip(http.StatusOK, `{}`)
+		service := chain.NewChainService(tester.Logger(t), httpClient, chainmodels.ARCConfig{}, bhsCfg(bhsURL, "wrong-token"))
+
+		tip, err := service.GetChainTip(context.Background())
+
+		require.Nil(t, tip)
+		require.ErrorIs(t, err, chainerrors.ErrBHSParsingResponse)
+	})
+
+	t.Run("Get chain tip when BHS is unreachable", func(t *testing.T) {
+		httpClient := bhsMockChainTip(http.StatusOK, `{}`)
+		service := chain.NewChainService(tester.Logger(t), httpClient, chainmodels.ARCConfig{}, bhsCfg("http://not-existing-bhs", bhsToken))
+
+		tip, err := service.GetChainTip(context.Background())
+
+		require.Nil(t, tip)
+		require.Error(t, err)
+	})
+}
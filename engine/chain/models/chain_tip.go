@@ -0,0 +1,13 @@
+package chainmodels
+
+// ChainTip represents the top block header of the longest chain known by Block Headers Service (BHS).
+type ChainTip struct {
+	Header ChainTipHeader `json:"header"`
+	Height int64          `json:"height"`
+}
+
+// ChainTipHeader contains the fields of the block header of the chain tip used by SPV Wallet.
+type ChainTipHeader struct {
+	Hash       string `json:"hash"`
+	MerkleRoot string `json:"merkleRoot"`
+}
@@ -0,0 +1,69 @@
+package chain
+
+import (
+	"context"
+	"iter"
+
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/internal/junglebus"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+)
+
+type sourceTxGetter struct {
+	logger     zerolog.Logger
+	txsGetter  chainmodels.TransactionsGetter
+	arcService ARCService
+}
+
+// NewSourceTxGetter creates a getter of transactions which are not stored in the database.
+// The transactions are fetched from Junglebus and their merkle paths are queried from ARC,
+// so only the mined transactions are returned.
+func NewSourceTxGetter(logger zerolog.Logger, httpClient *resty.Client, arcService ARCService) chainmodels.TransactionsGetter {
+	if httpClient == nil {
+		panic("httpClient is required")
+	}
+
+	return &sourceTxGetter{
+		logger:     logger,
+		txsGetter:  junglebus.NewJunglebusService(logger.With().Str("service", "junglebus").Logger(), httpClient),
+		arcService: arcService,
+	}
+}
+
+// GetTransactions returns the mined transactions with their merkle paths attached, the unknown or not mined transactions are skipped
+func (g *sourceTxGetter) GetTransactions(ctx context.Context, ids iter.Seq[string]) ([]*sdk.Transaction, error) {
+	txs, err := g.txsGetter.GetTransactions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*sdk.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		txID := tx.TxID()
+
+		txInfo, err := g.arcService.QueryTransaction(ctx, txID.String())
+		if err != nil {
+			return nil, err
+		}
+		if !txInfo.Found() || txInfo.MerklePath == "" {
+			g.logger.Debug().Str("txID", txID.String()).Msg("Skipping source transaction without merkle path")
+			continue
+		}
+
+		bump, err := sdk.NewMerklePathFromHex(txInfo.MerklePath)
+		if err != nil {
+			g.logger.Warn().Err(err).Str("txID", txID.String()).Msg("Skipping source transaction with invalid merkle path")
+			continue
+		}
+		if _, err = bump.ComputeRoot(txID); err != nil {
+			g.logger.Warn().Err(err).Str("txID", txID.String()).Msg("Skipping source transaction with merkle path of another transaction")
+			continue
+		}
+
+		tx.MerklePath = bump
+		transactions = append(transactions, tx)
+	}
+	return transactions, nil
+}
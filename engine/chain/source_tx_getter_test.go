@@ -0,0 +1,158 @@
+package chain_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/chainhash"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain"
+	"github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	arcURL   = "https://arc.example.com"
+	arcToken = "arc-token"
+
+	// https://whatsonchain.com/tx/cddeda65f520dfc2494e36528cd56ab3ff88c841d931894be1d7610d874c8ec8
+	minedTxID     = "cddeda65f520dfc2494e36528cd56ab3ff88c841d931894be1d7610d874c8ec8"
+	minedTxBase64 = "AQAAAAHU58f2jMJt3XzGjJEKINLPVzwd2Mr6NDEAq8exla/vIgEAAABrSDBFAiEA3rvUh3L5fGG8nzMdxTW6AoKarzlehm3pHMDDULQ+f0sCIAmo1o/v9WUJD62kTZgsZ3iBYn3AjpkjOG7iWyedxxCxQSEDXI/Xt/qQrisBpMkdoNh/87u8M5DZ3me2n61SqLeP9J3/////AgEAAAAAAAAAGXapFAS8COAvcQwoaykycYzP1nGgyBZEiKwOAAAAAAAAABl2qRRrgpexw82ewTFRyQ0p46lvFHU1poisAAAAAA=="
+
+	unknownTxID = "aaaa1d32c1a02d7797e33d7c4ab2f96fe6699005b6d79e6391bdf5e358232e06"
+)
+
+func TestSourceTxGetter(t *testing.T) {
+	t.Run("get mined transaction with merkle path", func(t *testing.T) {
+		// given:
+		merklePath := singleTxMerklePath(t, minedTxID)
+		getter := newSourceTxGetter(t, func(transport *httpmock.MockTransport) {
+			transport.RegisterResponder("GET", fmt.Sprintf("%s/v1/tx/%s", arcURL, minedTxID), httpmock.NewJsonResponderOrPanic(http.StatusOK, chainmodels.TXInfo{
+				TxID:        minedTxID,
+				TXStatus:    chainmodels.Mined,
+				BlockHeight: 100,
+				MerklePath:  merklePath.Hex(),
+			}))
+		})
+
+		// when:
+		txs, err := getter.GetTransactions(context.Background(), slices.Values([]string{minedTxID}))
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, minedTxID, txs[0].TxID().String())
+		require.Equal(t, merklePath.Hex(), txs[0].MerklePath.Hex())
+	})
+
+	t.Run("skip transaction unknown by ARC", func(t *testing.T) {
+		// given:
+		getter := newSourceTxGetter(t, func(transport *httpmock.MockTransport) {
+			transport.RegisterResponder("GET", fmt.Sprintf("%s/v1/tx/%s", arcURL, minedTxID), httpmock.NewJsonResponderOrPanic(http.StatusNotFound, chainmodels.ArcError{
+				Title:  "Not found",
+				Status: http.StatusNotFound,
+			}))
+		})
+
+		// when:
+		txs, err := getter.GetTransactions(context.Background(), slices.Values([]string{minedTxID}))
+
+		// then:
+		require.NoError(t, err)
+		require.Empty(t, txs)
+	})
+
+	t.Run("skip not mined transaction", func(t *testing.T) {
+		// given:
+		getter := newSourceTxGetter(t, func(transport *httpmock.MockTransport) {
+			transport.RegisterResponder("GET", fmt.Sprintf("%s/v1/tx/%s", arcURL, minedTxID), httpmock.NewJsonResponderOrPanic(http.StatusOK, chainmodels.TXInfo{
+				TxID:     minedTxID,
+				TXStatus: chainmodels.SeenOnNetwork,
+			}))
+		})
+
+		// when:
+		txs, err := getter.GetTransactions(context.Background(), slices.Values([]string{minedTxID}))
+
+		// then:
+		require.NoError(t, err)
+		require.Empty(t, txs)
+	})
+
+	t.Run("skip merkle path of another transaction", func(t *testing.T) {
+		// given:
+		getter := newSourceTxGetter(t, func(transport *httpmock.MockTransport) {
+			transport.RegisterResponder("GET", fmt.Sprintf("%s/v1/tx/%s", arcURL, minedTxID), httpmock.NewJsonResponderOrPanic(http.StatusOK, chainmodels.TXInfo{
+				TxID:       minedTxID,
+				TXStatus:   chainmodels.Mined,
+				MerklePath: twoTxsMerklePath(t, unknownTxID).Hex(),
+			}))
+		})
+
+		// when:
+		txs, err := getter.GetTransactions(context.Background(), slices.Values([]string{minedTxID}))
+
+		// then:
+		require.NoError(t, err)
+		require.Empty(t, txs)
+	})
+
+	t.Run("skip transaction unknown by Junglebus", func(t *testing.T) {
+		// given:
+		getter := newSourceTxGetter(t, nil)
+
+		// when:
+		txs, err := getter.GetTransactions(context.Background(), slices.Values([]string{unknownTxID}))
+
+		// then:
+		require.NoError(t, err)
+		require.Empty(t, txs)
+	})
+}
+
+func newSourceTxGetter(t *testing.T, arcResponders func(transport *httpmock.MockTransport)) chainmodels.TransactionsGetter {
+	transport := httpmock.NewMockTransport()
+	httpClient := resty.New()
+	httpClient.GetClient().Transport = transport
+
+	transport.RegisterResponder("GET", fmt.Sprintf("https://junglebus.gorillapool.io/v1/transaction/get/%s", minedTxID), httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]string{
+		"id":          minedTxID,
+		"transaction": minedTxBase64,
+	}))
+	transport.RegisterResponder("GET", fmt.Sprintf("https://junglebus.gorillapool.io/v1/transaction/get/%s", unknownTxID), httpmock.NewStringResponder(http.StatusNotFound, "tx-not-found"))
+
+	if arcResponders != nil {
+		arcResponders(transport)
+	}
+
+	logger := tester.Logger(t)
+	service := chain.NewChainService(logger, httpClient, chainmodels.ARCConfig{URL: arcURL, Token: arcToken}, chainmodels.BHSConfig{})
+
+	return chain.NewSourceTxGetter(logger, httpClient, service)
+}
+
+func singleTxMerklePath(t *testing.T, txID string) *sdk.MerklePath {
+	hash, err := chainhash.NewHashFromHex(txID)
+	require.NoError(t, err)
+	isTxID := true
+	return sdk.NewMerklePath(100, [][]*sdk.PathElement{{
+		{Offset: 0, Hash: hash, Txid: &isTxID},
+	}})
+}
+
+func twoTxsMerklePath(t *testing.T, txID string) *sdk.MerklePath {
+	hash, err := chainhash.NewHashFromHex(txID)
+	require.NoError(t, err)
+	isTxID := true
+	isDuplicate := true
+	return sdk.NewMerklePath(100, [][]*sdk.PathElement{{
+		{Offset: 0, Hash: hash, Txid: &isTxID},
+		{Offset: 1, Duplicate: &isDuplicate},
+	}})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/banknotes"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/repository"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations"
@@ -62,7 +63,14 @@ type (
 		arcConfig                  chainmodels.ARCConfig // Configuration for ARC
 		bhsConfig                  chainmodels.BHSConfig // Configuration for BHS
 		feeUnit                    *bsv.FeeUnit          // Fee unit for transactions
+		senderPolicies             []SenderPolicy        // Custom policies deciding who can open a transfer intent
+		stablecoinSigner           StablecoinSigner      // Signs the transfer intents and the transfers sent to other paymail hosts
 		stablecoinTransferService  *StablecoinTransferService
+		stablecoinBalanceService   *StablecoinBalanceService
+		stablecoinOperationService *StablecoinOperationService
+		utxoSelectionStrategy      outlines.UTXOSelectionStrategy // Default strategy of selecting UTXOs to fund transactions
+		utxoReservationTTL         time.Duration                  // Time for which the selected UTXOs are reserved for the transaction outline
+		sourceTxGetter             record.SourceTxGetter          // Getter of the source transactions which are not stored in the database
 
 		// v2
 		repositories *repository.All   // Repositories for all db models
@@ -72,6 +80,7 @@ type (
 		operations   *operations.Service
 		txSync       *txsync.Service
 		data         *data.Service
+		banknotes    *banknotes.Service
 		config       *config.AppConfig
 
 		// tokens
@@ -173,8 +182,11 @@ func NewClient(ctx context.Context, opts ...ClientOps) (ClientInterface, error)
 	client.loadPaymailsService()
 	client.loadAddressesService()
 	client.loadDataService()
+	client.loadBanknotesService()
 	client.loadOperationsService()
 	client.loadStablecoinTransferService()
+	client.loadStablecoinBalanceService()
+	client.loadStablecoinOperationService()
 
 	// Load the Paymail client and service (if does not exist)
 	if err = client.loadPaymailComponents(); err != nil {
@@ -395,6 +407,11 @@ func (c *Client) DataService() *data.Service {
 	return c.options.data
 }
 
+// BanknotesService will return the stablecoin banknotes domain service
+func (c *Client) BanknotesService() *banknotes.Service {
+	return c.options.banknotes
+}
+
 // OperationsService will return the operations domain service
 func (c *Client) OperationsService() *operations.Service {
 	return c.options.operations
@@ -410,6 +427,16 @@ func (c *Client) StablecoinTransferService() *StablecoinTransferService {
 	return c.options.stablecoinTransferService
 }
 
+// StablecoinBalanceService will return the stablecoin balance service
+func (c *Client) StablecoinBalanceService() *StablecoinBalanceService {
+	return c.options.stablecoinBalanceService
+}
+
+// StablecoinOperationService will return the stablecoin issue and redeem service
+func (c *Client) StablecoinOperationService() *StablecoinOperationService {
+	return c.options.stablecoinOperationService
+}
+
 // Tokens will return the Token Overlay Client
 func (c *Client) Tokens() tokens.TokenOverlayClient {
 	return c.options.tokenOverlayClient
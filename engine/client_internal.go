@@ -15,6 +15,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/banknotes"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/repository"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations"
@@ -174,11 +175,16 @@ func (c *Client) loadPaymailComponents() (err error) {
 
 func (c *Client) loadTransactionOutlinesService() error {
 	if c.options.transactionOutlinesService == nil {
+		// empty strategy means the default one of the selector
+		if c.options.utxoSelectionStrategy != "" && !c.options.utxoSelectionStrategy.IsValid() {
+			return spverrors.Newf("unsupported utxo selection strategy: %s", c.options.utxoSelectionStrategy)
+		}
+
 		logger := c.Logger().With().Str("subservice", "transactionOutlines").Logger()
-		utxoSelector := utxo.NewSelector(c.Datastore().DB(), c.FeeUnit())
+		utxoSelector := utxo.NewSelector(c.Datastore().DB(), c.FeeUnit(), c.options.utxoSelectionStrategy, c.options.utxoReservationTTL)
 		beefService := beef.NewService(c.Repositories().Transactions)
 
-		c.options.transactionOutlinesService = outlines.NewService(c.PaymailService(), c.options.paymails, beefService, utxoSelector, c.FeeUnit(), logger, c.UsersService())
+		c.options.transactionOutlinesService = outlines.NewService(c.PaymailService(), c.options.paymails, beefService, utxoSelector, c.FeeUnit(), logger, c.UsersService(), c.AddressesService())
 	}
 	return nil
 }
@@ -186,6 +192,10 @@ func (c *Client) loadTransactionOutlinesService() error {
 func (c *Client) loadTransactionRecordService() error {
 	if c.options.transactionRecordService == nil {
 		logger := c.Logger().With().Str("subservice", "transactionRecord").Logger()
+		sourceTxGetter := c.options.sourceTxGetter
+		if sourceTxGetter == nil && c.options.arcConfig.UseJunglebus {
+			sourceTxGetter = chain.NewSourceTxGetter(logger, c.options.httpClient, c.Chain())
+		}
 		c.options.transactionRecordService = record.NewService(
 			logger,
 			c.AddressesService(),
@@ -195,6 +205,7 @@ func (c *Client) loadTransactionRecordService() error {
 			c.Repositories().Transactions,
 			c.Chain(),
 			c.PaymailService(),
+			sourceTxGetter,
 		)
 	}
 	return nil
@@ -230,6 +241,12 @@ func (c *Client) loadDataService() {
 	}
 }
 
+func (c *Client) loadBanknotesService() {
+	if c.options.banknotes == nil {
+		c.options.banknotes = banknotes.NewService(c.Repositories().Banknotes)
+	}
+}
+
 func (c *Client) loadOperationsService() {
 	if c.options.operations == nil {
 		c.options.operations = operations.NewService(c.Repositories().Operations)
@@ -247,7 +264,13 @@ func (c *Client) loadChainService() {
 func (c *Client) loadTxSyncService() {
 	if c.options.txSync == nil {
 		logger := c.Logger().With().Str("subservice", "tx_sync").Logger()
-		c.options.txSync = txsync.NewService(logger, c.Repositories().Transactions)
+		c.options.txSync = txsync.NewService(
+			logger,
+			c.Repositories().Transactions,
+			c.Chain(),
+			c.Notifications(),
+			c.options.config.BHS.ConfirmationDepth,
+		)
 	}
 }
 
@@ -255,8 +278,29 @@ func (c *Client) loadStablecoinTransferService() {
 	if c.options.stablecoinTransferService == nil {
 		logger := c.Logger().With().Str("subservice", "transfer").Logger()
 
-		validator := NewDefaultIntentValidator(&logger, c)
-		c.options.stablecoinTransferService = NewStablecoinTransferService(validator, &logger)
+		policies := newSenderPolicies(c.options.config.Stablecoin.SenderPolicy, c, c.options.httpClient)
+		policies = append(policies, c.options.senderPolicies...)
+
+		validator := NewDefaultIntentValidator(&logger, c, policies...)
+		c.options.stablecoinTransferService = NewStablecoinTransferService(
+			validator, c.options.config.Stablecoin.IntentTTL, c.options.httpClient, c.options.stablecoinSigner,
+			c.options.config.TokenOverlay.BroadcastPolicy, &logger,
+		)
+	}
+}
+
+func (c *Client) loadStablecoinBalanceService() {
+	if c.options.stablecoinBalanceService == nil {
+		logger := c.Logger().With().Str("subservice", "stablecoin-balance").Logger()
+		c.options.stablecoinBalanceService = NewStablecoinBalanceService(&logger)
+	}
+}
+
+// loadStablecoinOperationService will load the stablecoin issue and redeem service
+func (c *Client) loadStablecoinOperationService() {
+	if c.options.stablecoinOperationService == nil {
+		logger := c.Logger().With().Str("subservice", "stablecoin-operation").Logger()
+		c.options.stablecoinOperationService = NewStablecoinOperationService(&logger)
 	}
 }
 
@@ -346,6 +390,10 @@ func (c *Client) askForFeeUnit(ctx context.Context) error {
 }
 
 func (c *Client) loadTokenOverlayClient() error {
+	if c.options.tokenOverlayClient != nil {
+		return nil
+	}
+
 	var tc tokens.TokenOverlayClient
 	var err error
 
@@ -369,6 +417,11 @@ func (c *Client) loadGatewayClient() error {
 		return spverrors.Wrapf(err, "failed to init gateway client")
 	}
 
-	c.options.gatewayClient = gc
+	cached, err := gateway.NewCachedClient(gc, c.Cachestore(), c.options.config.Gateway.RulesCacheTTL, c.Logger())
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to init cached gateway client")
+	}
+
+	c.options.gatewayClient = cached
 	return nil
 }
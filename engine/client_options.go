@@ -14,6 +14,9 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/logging"
 	"github.com/bitcoin-sv/spv-wallet/engine/metrics"
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/record"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/coocood/freecache"
 	"github.com/go-redis/redis/v8"
@@ -142,6 +145,15 @@ func WithHTTPClient(httpClient *resty.Client) ClientOps {
 	}
 }
 
+// WithTokenOverlayClient will set the custom token overlay client
+func WithTokenOverlayClient(client tokens.TokenOverlayClient) ClientOps {
+	return func(c *clientOptions) {
+		if client != nil {
+			c.tokenOverlayClient = client
+		}
+	}
+}
+
 // WithLogger will set the custom logger interface
 func WithLogger(customLogger *zerolog.Logger) ClientOps {
 	return func(c *clientOptions) {
@@ -436,6 +448,29 @@ func WithCustomFeeUnit(feeUnit bsv.FeeUnit) ClientOps {
 	}
 }
 
+// WithUTXOSelectionStrategy will set the default strategy of selecting UTXOs to fund transactions
+func WithUTXOSelectionStrategy(strategy outlines.UTXOSelectionStrategy) ClientOps {
+	return func(c *clientOptions) {
+		c.utxoSelectionStrategy = strategy
+	}
+}
+
+// WithUTXOReservationTTL will set the time for which the UTXOs selected for a transaction outline are reserved
+func WithUTXOReservationTTL(ttl time.Duration) ClientOps {
+	return func(c *clientOptions) {
+		c.utxoReservationTTL = ttl
+	}
+}
+
+// WithSourceTxGetter will set the getter of source transactions which are not stored in the database,
+// it is used when recording raw transactions.
+// Without it, the source transactions are fetched from Junglebus (with merkle paths from ARC) if Junglebus is enabled.
+func WithSourceTxGetter(getter record.SourceTxGetter) ClientOps {
+	return func(c *clientOptions) {
+		c.sourceTxGetter = getter
+	}
+}
+
 // WithARC sets all the ARC options needed for broadcasting, querying transactions etc.
 func WithARC(arcCfg chainmodels.ARCConfig) ClientOps {
 	return func(c *clientOptions) {
@@ -453,6 +488,28 @@ func WithBHS(url, token string) ClientOps {
 	}
 }
 
+// WithSenderPolicies will add the custom policies deciding who can open a stablecoin transfer intent
+//
+// They are checked after the built-in policies enabled in the config
+func WithSenderPolicies(policies ...SenderPolicy) ClientOps {
+	return func(c *clientOptions) {
+		c.senderPolicies = append(c.senderPolicies, policies...)
+	}
+}
+
+// WithStablecoinSigner will set the signer of the stablecoin transfer intents and transfers sent to other paymail hosts
+//
+// The engine does not hold the private keys of the paymails, so without the signer the requests are sent unsigned
+// and they are rejected by the receivers with the sender validation enabled.
+// The signer can delegate the signing to a KMS or an HSM, so the private keys never leave it.
+func WithStablecoinSigner(signer StablecoinSigner) ClientOps {
+	return func(c *clientOptions) {
+		if signer != nil {
+			c.stablecoinSigner = signer
+		}
+	}
+}
+
 // WithAppConfig passes the config struct into engine
 func WithAppConfig(config *config.AppConfig) ClientOps {
 	return func(c *clientOptions) {
@@ -12,6 +12,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester"
 	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/coocood/freecache"
 	"github.com/mrz1836/go-cachestore"
 	"github.com/rs/zerolog"
@@ -477,3 +478,23 @@ func TestWithCustomDatastore(t *testing.T) {
 		_ = os.Remove("datastore.db")
 	})
 }
+
+func TestWithUTXOSelectionStrategy(t *testing.T) {
+	t.Parallel()
+	testLogger := zerolog.Nop()
+
+	t.Run("check type", func(t *testing.T) {
+		opt := WithUTXOSelectionStrategy(outlines.UTXOSelectionStrategyLargestFirst)
+		assert.IsType(t, *new(ClientOps), opt)
+	})
+
+	t.Run("return error for unsupported strategy", func(t *testing.T) {
+		opts := DefaultClientOpts()
+		opts = append(opts, WithUTXOSelectionStrategy("first_come_first_served"))
+		opts = append(opts, WithLogger(&testLogger))
+
+		tc, err := NewClient(context.Background(), opts...)
+		require.ErrorContains(t, err, "unsupported utxo selection strategy")
+		require.Nil(t, tc)
+	})
+}
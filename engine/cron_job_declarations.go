@@ -12,6 +12,12 @@ const (
 	CronJobNameDraftTransactionCleanUp = "draft_transaction_clean_up"
 	CronJobNameSyncTransaction         = "sync_transaction"
 	CronJobNameCalculateMetrics        = "calculate_metrics"
+	CronJobNameGatewayNotifications    = "gateway_notifications"
+	CronJobNameRefreshStablecoinRules  = "refresh_stablecoin_rules"
+	CronJobNameTransferIntentsCleanUp  = "transfer_intents_clean_up"
+	CronJobNameReconcileTokenUtxos     = "reconcile_token_utxos"
+	CronJobNameOverlayRegistrations    = "overlay_registrations"
+	CronJobNameSyncConfirmations       = "sync_confirmations"
 )
 
 type cronJobHandler func(ctx context.Context, client *Client) error
@@ -50,6 +56,42 @@ func (c *Client) cronJobs() taskmanager.CronJobs {
 		5*time.Minute,
 		taskSyncTransactions,
 	)
+	addJob(
+		CronJobNameGatewayNotifications,
+		30*time.Second,
+		taskSendGatewayNotifications,
+	)
+	addJob(
+		CronJobNameOverlayRegistrations,
+		30*time.Second,
+		taskRetryOverlayRegistrations,
+	)
+	addJob(
+		CronJobNameRefreshStablecoinRules,
+		c.options.config.Gateway.RulesCacheTTL/2,
+		taskRefreshStablecoinRules,
+	)
+	addJob(
+		CronJobNameTransferIntentsCleanUp,
+		60*time.Second,
+		taskCleanupTransferIntents,
+	)
+
+	if c.options.config.ExperimentalFeatures.V2 {
+		addJob(
+			CronJobNameSyncConfirmations,
+			1*time.Minute,
+			taskSyncConfirmations,
+		)
+	}
+
+	if interval := c.options.config.TokenOverlay.ReconciliationInterval; interval > 0 {
+		addJob(
+			CronJobNameReconcileTokenUtxos,
+			interval,
+			taskReconcileTokenUtxos,
+		)
+	}
 
 	if _, enabled := c.Metrics(); enabled {
 		addJob(
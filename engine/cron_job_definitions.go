@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"gorm.io/gorm"
 )
@@ -64,6 +65,51 @@ func taskSyncTransactions(ctx context.Context, client *Client) error {
 	return nil
 }
 
+// taskSyncConfirmations will update the confirmations of the mined transactions and handle the chain reorganizations
+func taskSyncConfirmations(ctx context.Context, client *Client) error {
+	client.Logger().Info().Msg("running sync confirmations task...")
+
+	return client.TxSyncService().SyncConfirmations(ctx)
+}
+
+// taskSendGatewayNotifications will send pending stablecoin transfer notifications to the gateway
+func taskSendGatewayNotifications(ctx context.Context, client *Client) error {
+	client.Logger().Info().Msg("running send gateway notifications task...")
+
+	return client.StablecoinTransferService().ProcessGatewayNotifications(ctx, client)
+}
+
+// taskRetryOverlayRegistrations will retry the pending overlay registrations of the token transactions
+func taskRetryOverlayRegistrations(ctx context.Context, client *Client) error {
+	client.Logger().Info().Msg("running retry overlay registrations task...")
+
+	return client.StablecoinTransferService().ProcessOverlayRegistrations(ctx, client)
+}
+
+// taskRefreshStablecoinRules will refresh the cached stablecoin rules before they expire
+func taskRefreshStablecoinRules(ctx context.Context, client *Client) error {
+	client.Logger().Info().Msg("running refresh stablecoin rules task...")
+
+	if cachedClient, ok := client.GatewayClient().(gateway.CachedClient); ok {
+		cachedClient.RefreshStablecoinRules(ctx)
+	}
+	return nil
+}
+
+// taskCleanupTransferIntents will expire all stale stablecoin transfer intents
+func taskCleanupTransferIntents(ctx context.Context, client *Client) error {
+	client.Logger().Info().Msg("running cleanup transfer intents task...")
+
+	return client.StablecoinTransferService().ExpireTransferIntents(ctx, client)
+}
+
+// taskReconcileTokenUtxos will compare the token utxos of the wallet with the token overlay
+func taskReconcileTokenUtxos(ctx context.Context, client *Client) error {
+	client.Logger().Info().Msg("running reconcile token utxos task...")
+
+	return client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+}
+
 func taskCalculateMetrics(ctx context.Context, client *Client) error {
 	m, enabled := client.Metrics()
 	if !enabled {
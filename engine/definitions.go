@@ -22,6 +22,26 @@ const (
 	version                    = "v0.14.2"                // SPV Wallet Engine version
 )
 
+// Defaults for gateway notifications outbox
+const (
+	gatewayNotificationBatchSize     = 20               // Max number of notifications sent in one run of the cron job
+	gatewayNotificationMaxAttempts   = 12               // Number of failed attempts after which the notification is dead-lettered
+	gatewayNotificationRetryDelay    = 30 * time.Second // Delay after the first failed attempt, doubled on every next one
+	gatewayNotificationMaxRetryDelay = 2 * time.Hour    // Upper limit of the delay between attempts
+)
+
+// Defaults for overlay registrations outbox
+const (
+	overlayRegistrationBatchSize     = 20               // Max number of registrations retried in one run of the cron job
+	overlayRegistrationRetryDelay    = 30 * time.Second // Delay after the first failed attempt, doubled on every next one
+	overlayRegistrationMaxRetryDelay = 30 * time.Minute // Upper limit of the delay between attempts
+)
+
+// Defaults for stablecoin transfer intents
+const (
+	transferIntentsExpireBatchSize = 100 // Max number of stale intents expired in one run of the cron job
+)
+
 // All the base models
 const (
 	ModelAccessKey        ModelName = "access_key"
@@ -36,6 +56,13 @@ const (
 	ModelContact          ModelName = "contact"
 	ModelWebhook          ModelName = "webhook"
 	ModelTransferIntent   ModelName = "transfer_intent"
+
+	ModelGatewayNotification ModelName = "gateway_notification"
+	ModelStablecoinOperation ModelName = "stablecoin_operation"
+	ModelTokenDivergence     ModelName = "token_divergence"
+	ModelOverlayRegistration ModelName = "overlay_registration"
+
+	ModelStablecoinDailyVolume ModelName = "stablecoin_daily_volume"
 )
 
 // AllModelNames is a list of all models
@@ -64,6 +91,11 @@ const (
 	tableContacts                  = "contacts"
 	tableWebhooks                  = "webhooks"
 	tableStablecoinTransferIntents = "stablecoin_transfer_intents"
+	tableGatewayNotifications      = "gateway_notifications"
+	tableStablecoinOperations      = "stablecoin_operations"
+	tableTokenDivergences          = "token_divergences"
+	tableOverlayRegistrations      = "overlay_registrations"
+	tableStablecoinDailyVolumes    = "stablecoin_daily_volumes"
 )
 
 const (
@@ -77,8 +109,11 @@ const (
 	currentBalanceField  = "current_balance"
 	domainField          = "domain"
 	draftIDField         = "draft_id"
+	expiresAtField       = "expires_at"
 	idField              = "id"
+	kindField            = "kind"
 	metadataField        = "metadata"
+	nextAttemptAtField   = "next_attempt_at"
 	nextExternalNumField = "next_external_num"
 	nextInternalNumField = "next_internal_num"
 	satoshisField        = "satoshis"
@@ -88,6 +123,7 @@ const (
 	xPubIDField          = "xpub_id"
 	xPubMetadataField    = "xpub_metadata"
 	paymailField         = "paymail"
+	resolvedAtField      = "resolved_at"
 	contactStatusField   = "status"
 
 	// Universal statuses
@@ -128,6 +164,11 @@ func AllDBModels(v2 bool) []any {
 		&Webhook{},
 		&PaymailAddress{},
 		&StablecoinTransferIntent{},
+		&GatewayNotification{},
+		&StablecoinOperation{},
+		&TokenDivergence{},
+		&OverlayRegistration{},
+		&StablecoinDailyVolume{},
 	}
 
 	if !v2 {
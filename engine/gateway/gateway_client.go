@@ -1,8 +1,13 @@
 package gateway
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/go-resty/resty/v2"
 	"github.com/rs/zerolog"
 )
@@ -22,11 +27,52 @@ type StablecoinRule struct {
 	TokenId   string           `json:"tokenId"`
 	EmitterID string           `json:"emitterId"`
 	Fees      []*StablecoinFee `json:"fees"`
+
+	// Version is the version of the rules reported by the gateway (if any)
+	Version string `json:"version,omitempty"`
+	// Hash is the hash of the rules content, calculated by the client, used to audit the fee calculations
+	Hash string `json:"hash,omitempty"`
+}
+
+// TransferDirection tells whether the notified transfer was sent or received by the wallet
+type TransferDirection string
+
+const (
+	// TransferIncoming is a transfer received by one of the wallet's paymails
+	TransferIncoming TransferDirection = "incoming"
+	// TransferOutgoing is a transfer sent by one of the wallet's paymails
+	TransferOutgoing TransferDirection = "outgoing"
+)
+
+// TransferBanknote represents a token output transferred to the receiver
+type TransferBanknote struct {
+	Vout   uint32 `json:"vout"`
+	Serial string `json:"serial"`
+	Amount uint64 `json:"amount"`
+}
+
+// TransferFeeOutput represents a token output paying the fee to the commission recipient
+type TransferFeeOutput struct {
+	Vout      uint32 `json:"vout"`
+	Serial    string `json:"serial"`
+	Amount    uint64 `json:"amount"`
+	Recipient string `json:"recipient"`
+}
+
+// TransferNotification is the settlement record of an accepted stablecoin transfer sent to the gateway
+type TransferNotification struct {
+	RefID        string               `json:"refId"`
+	TxID         string               `json:"txId"`
+	StablecoinID string               `json:"stablecoinId"`
+	Direction    TransferDirection    `json:"direction"`
+	Banknotes    []*TransferBanknote  `json:"banknotes"`
+	FeeOutputs   []*TransferFeeOutput `json:"feeOutputs"`
 }
 
 // Client represents an interface of gateway client
 type Client interface {
-	GetStablecoinRules(tokenID string) (*StablecoinRule, error)
+	GetStablecoinRules(ctx context.Context, tokenID string) (*StablecoinRule, error)
+	NotifyTransfer(ctx context.Context, notification *TransferNotification) error
 }
 
 type gatewayClient struct {
@@ -44,20 +90,32 @@ func NewGatewayClient(logger *zerolog.Logger, gatewayURL string, httpClient *res
 	}, nil
 }
 
-func (c *gatewayClient) GetStablecoinRules(tokenID string) (*StablecoinRule, error) {
-	url := fmt.Sprintf("%s/coins/bsv21/rules?tokenId=%s", c.gatewayURL, tokenID)
+// GetStablecoinRules fetches the stablecoin rules from the gateway
+// It fails closed - any error or non-2xx response is returned as an error, so the transfer cannot silently become fee-free
+func (c *gatewayClient) GetStablecoinRules(ctx context.Context, tokenID string) (*StablecoinRule, error) {
+	url := fmt.Sprintf("%s/coins/bsv21/rules", c.gatewayURL)
 	var response StablecoinRule
-	_, err := c.httpClient.R().
+	resp, err := c.httpClient.R().
+		SetContext(ctx).
+		SetQueryParam("tokenId", tokenID).
 		SetResult(&response).
 		Get(url)
 	if err != nil {
-		return nil, err
+		c.log.Err(err).Ctx(ctx).Str("tokenID", tokenID).Msg("Failed to get stablecoin rules")
+		return nil, spverrors.ErrStablecoinRulesUnavailable.Wrap(err)
+	}
+
+	if resp.IsError() {
+		c.log.Error().Ctx(ctx).Str("tokenID", tokenID).Int("statusCode", resp.StatusCode()).
+			Msg("Gateway responded with an error to the stablecoin rules request")
+		return nil, spverrors.ErrStablecoinRulesUnavailable.Wrap(fmt.Errorf("gateway responded with status code %d: %s", resp.StatusCode(), resp.String()))
 	}
 
 	result := &StablecoinRule{
 		CoinSym:   response.CoinSym,
 		TokenId:   response.TokenId,
 		EmitterID: response.EmitterID,
+		Version:   response.Version,
 	}
 
 	for _, r := range response.Fees {
@@ -66,5 +124,46 @@ func (c *gatewayClient) GetStablecoinRules(tokenID string) (*StablecoinRule, err
 		}
 	}
 
+	result.Hash, err = result.calculateHash()
+	if err != nil {
+		return nil, spverrors.ErrStablecoinRulesUnavailable.Wrap(err)
+	}
+
 	return result, nil
 }
+
+// calculateHash returns the sha256 hash of the rules content (without the hash itself)
+func (r *StablecoinRule) calculateHash() (string, error) {
+	content := *r
+	content.Hash = ""
+
+	bytes, err := json.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal stablecoin rules: %w", err)
+	}
+
+	hash := sha256.Sum256(bytes)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// NotifyTransfer sends the settlement record of an accepted transfer to the gateway
+// Any non-2xx response is treated as a failure, so the caller can retry the notification
+func (c *gatewayClient) NotifyTransfer(ctx context.Context, notification *TransferNotification) error {
+	url := fmt.Sprintf("%s/coins/bsv21/transfers", c.gatewayURL)
+	resp, err := c.httpClient.R().
+		SetContext(ctx).
+		SetBody(notification).
+		Post(url)
+	if err != nil {
+		c.log.Err(err).Ctx(ctx).Str("refID", notification.RefID).Msg("Failed to send transfer notification")
+		return err
+	}
+
+	if resp.IsError() {
+		c.log.Error().Ctx(ctx).Str("refID", notification.RefID).Int("statusCode", resp.StatusCode()).
+			Msg("Gateway rejected transfer notification")
+		return fmt.Errorf("gateway responded with status code %d: %s", resp.StatusCode(), resp.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/mrz1836/go-cachestore"
+	"github.com/rs/zerolog"
+)
+
+const cacheKeyStablecoinRules = "stablecoin-rules-"
+
+// CachedClient is a gateway client which keeps the stablecoin rules in the cache
+type CachedClient interface {
+	Client
+	RefreshStablecoinRules(ctx context.Context)
+}
+
+type cachedClient struct {
+	Client
+	cache cachestore.ClientInterface
+	ttl   time.Duration
+	log   zerolog.Logger
+
+	tokenIDsMtx sync.Mutex
+	tokenIDs    map[string]struct{}
+}
+
+// NewCachedClient wraps the gateway client with the cache of stablecoin rules.
+// Cached rules are valid for the given ttl, RefreshStablecoinRules should be called periodically to refresh them before they expire.
+func NewCachedClient(client Client, cache cachestore.ClientInterface, ttl time.Duration, logger *zerolog.Logger) (CachedClient, error) {
+	if cache == nil {
+		return nil, spverrors.Newf("cache is required to create a new cached gateway client")
+	}
+
+	return &cachedClient{
+		Client:   client,
+		cache:    cache,
+		ttl:      ttl,
+		log:      logger.With().Str("subservice", "gateway-rules-cache").Logger(),
+		tokenIDs: make(map[string]struct{}),
+	}, nil
+}
+
+// GetStablecoinRules returns the cached stablecoin rules or fetches them from the gateway if they are missing or expired
+func (c *cachedClient) GetStablecoinRules(ctx context.Context, tokenID string) (*StablecoinRule, error) {
+	c.track(tokenID)
+
+	rules, err := c.loadFromCache(ctx, tokenID)
+	if err != nil {
+		c.log.Warn().Err(err).Str("tokenID", tokenID).Msg("Failed to load stablecoin rules from cache")
+	}
+	if rules != nil {
+		return rules, nil
+	}
+
+	return c.fetch(ctx, tokenID)
+}
+
+// RefreshStablecoinRules fetches again the rules of all the stablecoins used so far.
+// On failure the previously cached rules are kept until they expire.
+func (c *cachedClient) RefreshStablecoinRules(ctx context.Context) {
+	for _, tokenID := range c.trackedTokenIDs() {
+		if _, err := c.fetch(ctx, tokenID); err != nil {
+			c.log.Warn().Err(err).Str("tokenID", tokenID).Msg("Failed to refresh stablecoin rules")
+		}
+	}
+}
+
+func (c *cachedClient) fetch(ctx context.Context, tokenID string) (*StablecoinRule, error) {
+	rules, err := c.Client.GetStablecoinRules(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.cache.SetModel(ctx, cacheKeyStablecoinRules+tokenID, rules, c.ttl); err != nil {
+		c.log.Warn().Err(err).Str("tokenID", tokenID).Msg("Failed to store stablecoin rules in cache")
+	}
+
+	return rules, nil
+}
+
+func (c *cachedClient) loadFromCache(ctx context.Context, tokenID string) (*StablecoinRule, error) {
+	rules := new(StablecoinRule)
+	err := c.cache.GetModel(ctx, cacheKeyStablecoinRules+tokenID, rules)
+	if errors.Is(err, cachestore.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get stablecoin rules from cachestore")
+	}
+
+	if rules.Hash == "" {
+		return nil, nil
+	}
+
+	return rules, nil
+}
+
+func (c *cachedClient) track(tokenID string) {
+	c.tokenIDsMtx.Lock()
+	defer c.tokenIDsMtx.Unlock()
+
+	c.tokenIDs[tokenID] = struct{}{}
+}
+
+func (c *cachedClient) trackedTokenIDs() []string {
+	c.tokenIDsMtx.Lock()
+	defer c.tokenIDsMtx.Unlock()
+
+	tokenIDs := make([]string, 0, len(c.tokenIDs))
+	for tokenID := range c.tokenIDs {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+
+	return tokenIDs
+}
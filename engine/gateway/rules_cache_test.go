@@ -0,0 +1,160 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenID = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"
+
+type gatewayMock struct {
+	server   *httptest.Server
+	calls    atomic.Int32
+	status   atomic.Int32
+	feeValue atomic.Int32
+}
+
+func newGatewayMock(t *testing.T) *gatewayMock {
+	mock := &gatewayMock{}
+	mock.status.Store(http.StatusOK)
+	mock.feeValue.Store(1)
+
+	mock.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mock.calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(int(mock.status.Load()))
+		if mock.status.Load() != http.StatusOK {
+			return
+		}
+		_ = json.NewEncoder(w).Encode(gateway.StablecoinRule{
+			CoinSym:   "USDC",
+			TokenId:   r.URL.Query().Get("tokenId"),
+			EmitterID: "emitter@example.com",
+			Version:   "1",
+			Fees: []*gateway.StablecoinFee{{
+				CommissionRecipient: "fee@example.com",
+				From:                0,
+				To:                  1000,
+				Type:                "fixed",
+				Value:               float64(mock.feeValue.Load()),
+			}},
+		})
+	}))
+	t.Cleanup(mock.server.Close)
+
+	return mock
+}
+
+func newCachedClient(t *testing.T, url string) gateway.CachedClient {
+	logger := tester.Logger(t)
+	client, err := gateway.NewGatewayClient(&logger, url, resty.New())
+	require.NoError(t, err)
+
+	cached, err := gateway.NewCachedClient(client, tester.CacheStore(), time.Minute, &logger)
+	require.NoError(t, err)
+
+	return cached
+}
+
+func TestNewCachedClient(t *testing.T) {
+	t.Run("fail without cache", func(t *testing.T) {
+		// given:
+		logger := tester.Logger(t)
+		client, err := gateway.NewGatewayClient(&logger, "http://localhost", resty.New())
+		require.NoError(t, err)
+
+		// when:
+		cached, err := gateway.NewCachedClient(client, nil, time.Minute, &logger)
+
+		// then:
+		require.Error(t, err)
+		assert.Nil(t, cached)
+	})
+}
+
+func TestCachedClientGetStablecoinRules(t *testing.T) {
+	t.Run("fetch rules once and then use the cache", func(t *testing.T) {
+		// given:
+		mock := newGatewayMock(t)
+		client := newCachedClient(t, mock.server.URL)
+
+		// when:
+		first, err := client.GetStablecoinRules(context.Background(), tokenID)
+		require.NoError(t, err)
+		second, err := client.GetStablecoinRules(context.Background(), tokenID)
+		require.NoError(t, err)
+
+		// then:
+		assert.Equal(t, int32(1), mock.calls.Load())
+		assert.Equal(t, tokenID, first.TokenId)
+		assert.Equal(t, "1", first.Version)
+		assert.Len(t, first.Hash, 64)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("fail closed when gateway returns an error", func(t *testing.T) {
+		// given:
+		mock := newGatewayMock(t)
+		mock.status.Store(http.StatusInternalServerError)
+		client := newCachedClient(t, mock.server.URL)
+
+		// when:
+		rules, err := client.GetStablecoinRules(context.Background(), tokenID)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinRulesUnavailable)
+		assert.Nil(t, rules)
+	})
+
+	t.Run("refresh updates the cached rules", func(t *testing.T) {
+		// given:
+		mock := newGatewayMock(t)
+		client := newCachedClient(t, mock.server.URL)
+		before, err := client.GetStablecoinRules(context.Background(), tokenID)
+		require.NoError(t, err)
+
+		// and:
+		mock.feeValue.Store(2)
+
+		// when:
+		client.RefreshStablecoinRules(context.Background())
+
+		// then:
+		after, err := client.GetStablecoinRules(context.Background(), tokenID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), mock.calls.Load())
+		assert.InDelta(t, 2, after.Fees[0].Value, 0)
+		assert.NotEqual(t, before.Hash, after.Hash)
+	})
+
+	t.Run("keep cached rules when refresh fails", func(t *testing.T) {
+		// given:
+		mock := newGatewayMock(t)
+		client := newCachedClient(t, mock.server.URL)
+		before, err := client.GetStablecoinRules(context.Background(), tokenID)
+		require.NoError(t, err)
+
+		// and:
+		mock.status.Store(http.StatusServiceUnavailable)
+
+		// when:
+		client.RefreshStablecoinRules(context.Background())
+
+		// then:
+		after, err := client.GetStablecoinRules(context.Background(), tokenID)
+		require.NoError(t, err)
+		assert.Equal(t, before, after)
+	})
+}
@@ -2,7 +2,9 @@ package engine
 
 import (
 	"context"
+	"iter"
 	"net/http"
+	"time"
 
 	"github.com/bitcoin-sv/go-paymail"
 	"github.com/bitcoin-sv/spv-wallet/engine/chain"
@@ -16,6 +18,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/banknotes"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/data"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/repository"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations"
@@ -131,6 +134,7 @@ type TransactionService interface {
 		queryParams *datastore.QueryParams) ([]*Transaction, error)
 	GetTransactionsByXpubIDCount(ctx context.Context, xPubID string, metadata *Metadata,
 		conditions map[string]interface{}) (int64, error)
+	TransactionsHistory(ctx context.Context, xPubID string, from, to *time.Time) iter.Seq2[*TransactionHistoryEntry, error]
 	NewTransaction(ctx context.Context, rawXpubKey string, config *TransactionConfig,
 		opts ...ModelOps) (*DraftTransaction, error)
 	RecordTransaction(ctx context.Context, xPubKey, txHex, draftID string,
@@ -169,6 +173,7 @@ type V2 interface {
 	PaymailsService() *paymails.Service
 	AddressesService() *addresses.Service
 	DataService() *data.Service
+	BanknotesService() *banknotes.Service
 	OperationsService() *operations.Service
 	TxSyncService() *txsync.Service
 }
@@ -207,4 +212,6 @@ type ClientInterface interface {
 	Tokens() tokens.TokenOverlayClient
 	GatewayClient() gateway.Client
 	StablecoinTransferService() *StablecoinTransferService
+	StablecoinBalanceService() *StablecoinBalanceService
+	StablecoinOperationService() *StablecoinOperationService
 }
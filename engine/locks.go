@@ -9,11 +9,12 @@ import (
 )
 
 const (
-	lockKeyProcessBroadcastTx = "process-broadcast-transaction-%s" // + Tx ID
-	lockKeyProcessP2PTx       = "process-p2p-transaction-%s"       // + Tx ID
-	lockKeyProcessSyncTx      = "process-sync-transaction-task"
-	lockKeyRecordTx           = "action-record-transaction-%s" // + Tx ID
-	lockKeyReserveUtxo        = "utxo-reserve-xpub-id-%s"      // + Xpub ID
+	lockKeyProcessBroadcastTx    = "process-broadcast-transaction-%s" // + Tx ID
+	lockKeyProcessP2PTx          = "process-p2p-transaction-%s"       // + Tx ID
+	lockKeyProcessSyncTx         = "process-sync-transaction-task"
+	lockKeyProcessTransferIntent = "process-transfer-intent-%s"   // + Ref ID
+	lockKeyRecordTx              = "action-record-transaction-%s" // + Tx ID
+	lockKeyReserveUtxo           = "utxo-reserve-xpub-id-%s"      // + Xpub ID
 )
 
 // newWriteLock will take care of creating a lock and defer
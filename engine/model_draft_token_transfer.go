@@ -0,0 +1,159 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/4chain-AG/gateway-overlay/pkg/token_engine/bsv21"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+)
+
+// TokenTransfer is the high-level stablecoin transfer of the draft transaction
+// Only the stablecoin, the amount and the receiver are given, the banknotes are selected from the token utxos of the xPub.
+type TokenTransfer struct {
+	StablecoinID string `json:"stablecoin_id" toml:"stablecoin_id" yaml:"stablecoin_id"` // The stablecoin to send
+	Amount       uint64 `json:"amount" toml:"amount" yaml:"amount"`                      // The amount of the stablecoin to send
+	To           string `json:"to" toml:"to" yaml:"to"`                                  // The paymail of the receiver
+
+	// Operation is set only by the issue and redeem of the StablecoinOperationService
+	Operation StablecoinOperationType `json:"operation,omitempty" toml:"operation" yaml:"operation"`
+}
+
+// selectedBanknote is the banknote picked for the token transfer together with its utxo
+type selectedBanknote struct {
+	*StablecoinBanknote
+	utxo *Utxo
+}
+
+// processTokenTransfer selects and reserves the banknotes for the token transfer and builds the token outputs
+// The outputs and the token metadata are the same as the ones set by the clients building the token transaction on their own,
+// so the intent and fee negotiation in UpdateTokenTxOutputs works for both.
+func (m *DraftTransaction) processTokenTransfer(ctx context.Context, paymailFrom string) error {
+	transfer := m.Configuration.TokenTransfer
+	if transfer.StablecoinID == "" || transfer.Amount == 0 || !strings.Contains(transfer.To, "@") ||
+		len(m.Configuration.Outputs) > 0 || m.Configuration.SendAllTo != nil {
+		return spverrors.ErrStablecoinTokenTransferInvalid
+	}
+
+	banknotes, err := m.reserveBanknotes(ctx, transfer.StablecoinID, transfer.Amount)
+	if err != nil {
+		return err
+	}
+
+	cfg := &tokenTransactionConfig{StablecoinID: transfer.StablecoinID, Operation: transfer.Operation}
+	remaining := transfer.Amount
+	for _, banknote := range banknotes {
+		m.Configuration.IncludeUtxos = append(m.Configuration.IncludeUtxos, &banknote.utxo.UtxoPointer)
+
+		amount := min(banknote.Amount, remaining)
+		remaining -= amount
+
+		output, err := newTokenOutput(transfer.To, banknote.Serial, amount)
+		if err != nil {
+			return err
+		}
+		cfg.TxOutputs = append(cfg.TxOutputs, len(m.Configuration.Outputs))
+		m.Configuration.Outputs = append(m.Configuration.Outputs, output)
+
+		// the rest of the banknote goes back to the sender
+		if change := banknote.Amount - amount; change > 0 {
+			if output, err = newTokenOutput(paymailFrom, banknote.Serial, change); err != nil {
+				return err
+			}
+			cfg.ChangeOutputs = append(cfg.ChangeOutputs, len(m.Configuration.Outputs))
+			m.Configuration.Outputs = append(m.Configuration.Outputs, output)
+		}
+	}
+
+	if m.Metadata == nil {
+		m.Metadata = make(Metadata)
+	}
+	m.Metadata["isTokenTransaction"] = true
+	m.Metadata[TransactionConfigKey] = cfg
+
+	return nil
+}
+
+// reserveBanknotes reserves the largest unreserved banknotes of the stablecoin until they cover the amount
+func (m *DraftTransaction) reserveBanknotes(ctx context.Context, stablecoinID string, amount uint64) ([]*selectedBanknote, error) {
+	c := m.Client()
+	opts := m.GetOptions(false)
+
+	// the same lock as for the fee utxos, so parallel drafts cannot pick the same banknote
+	unlock, err := newWaitWriteLock(
+		ctx, fmt.Sprintf(lockKeyReserveUtxo, m.XpubID), c.Cachestore(),
+	)
+	defer unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	utxos, err := getSpendableUtxos(
+		ctx, m.XpubID, utils.ScriptTypePubKeyHashInscription, nil, nil, opts...,
+	)
+	if errors.Is(err, spverrors.ErrMissingUTXOsSpendable) {
+		return nil, spverrors.ErrStablecoinNotEnoughBanknotes
+	} else if err != nil {
+		return nil, err
+	}
+
+	balanceService := c.StablecoinBalanceService()
+	stablecoins := make(map[string]string)
+	candidates := make([]*selectedBanknote, 0, len(utxos))
+	for _, utxo := range utxos {
+		banknote, err := balanceService.banknoteFromUtxo(ctx, c, utxo, stablecoins)
+		if err != nil || banknote.StablecoinID != stablecoinID {
+			continue
+		}
+		candidates = append(candidates, &selectedBanknote{StablecoinBanknote: banknote, utxo: utxo})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Amount > candidates[j].Amount
+	})
+
+	var covered uint64
+	selected := make([]*selectedBanknote, 0)
+	for _, candidate := range candidates {
+		if covered >= amount {
+			break
+		}
+		selected = append(selected, candidate)
+		covered += candidate.Amount
+	}
+	if covered < amount {
+		return nil, spverrors.ErrStablecoinNotEnoughBanknotes
+	}
+
+	for _, banknote := range selected {
+		banknote.utxo.DraftID.Valid = true
+		banknote.utxo.DraftID.String = m.ID
+		banknote.utxo.ReservedAt.Valid = true
+		banknote.utxo.ReservedAt.Time = time.Now().UTC()
+
+		if err = banknote.utxo.Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return selected, nil
+}
+
+// newTokenOutput creates the 1 satoshi paymail output with the BSV-21 transfer inscription of the banknote
+func newTokenOutput(to, serial string, amount uint64) (*TransactionOutput, error) {
+	inscription, err := bsv21.NewBsv21Transfer(bsv21.TokenID(serial), amount)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to create token inscription")
+	}
+
+	return &TransactionOutput{
+		To:       to,
+		Satoshis: 1,
+		Script:   inscription.String(),
+	}, nil
+}
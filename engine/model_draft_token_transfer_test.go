@@ -0,0 +1,163 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDraftTransaction_processTokenTransfer(t *testing.T) {
+	const sender = "alice@" + testStablecoinSenderDomain
+	receiver := "bob@" + testStablecoinReceiverDomain
+
+	// given banknotes of 100 and 50 of testStablecoinID and a banknote of 1000 of testOtherStablecoinID
+	seed := func(t *testing.T, ctx context.Context, client ClientInterface) {
+		transaction, err := txFromHex(testTxHex, append(client.DefaultModelOptions(), New(), WithMetadatas(map[string]interface{}{
+			TransactionConfigKey: tokenTransactionConfig{StablecoinID: testStablecoinID},
+		}))...)
+		require.NoError(t, err)
+		require.NoError(t, transaction.Save(ctx))
+
+		utxos := []*Utxo{
+			newUtxo(testXPubID, transaction.ID, testTokenLockingScript(t, testOtherBanknoteSerial, 50), 0, 1, append(client.DefaultModelOptions(), New())...),
+			newUtxo(testXPubID, transaction.ID, testTokenLockingScript(t, testBanknoteSerial, 100), 1, 1, append(client.DefaultModelOptions(), New())...),
+			newUtxo(testXPubID, testTxID2, testTokenLockingScript(t, testOtherStablecoinID, 1000), 0, 1, append(client.DefaultModelOptions(), New())...),
+		}
+		for _, utxo := range utxos {
+			require.NoError(t, utxo.Save(ctx))
+		}
+	}
+
+	setup := func(t *testing.T) (context.Context, ClientInterface) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, false, withTaskManagerMockup())
+		t.Cleanup(deferMe)
+		seed(t, ctx, client)
+
+		return ctx, client
+	}
+
+	newDraft := func(client ClientInterface, transfer *TokenTransfer) *DraftTransaction {
+		return &DraftTransaction{
+			Model: *NewBaseModel(
+				ModelDraftTransaction,
+				append(client.DefaultModelOptions(), WithXPub(testXPub))...,
+			),
+			TransactionBase: TransactionBase{ID: testDraftID},
+			XpubID:          testXPubID,
+			Configuration:   TransactionConfig{TokenTransfer: transfer},
+		}
+	}
+
+	t.Run("select the largest banknote and return the change to the sender", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+		draft := newDraft(client, &TokenTransfer{StablecoinID: testStablecoinID, Amount: 70, To: receiver})
+
+		// when:
+		err := draft.processTokenTransfer(ctx, sender)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, draft.Configuration.Outputs, 2)
+		assert.Equal(t, receiver, draft.Configuration.Outputs[0].To)
+		assert.Equal(t, testTokenLockingScript(t, testBanknoteSerial, 70)[len(testLockingScript):], draft.Configuration.Outputs[0].Script)
+		assert.Equal(t, sender, draft.Configuration.Outputs[1].To)
+		assert.Equal(t, testTokenLockingScript(t, testBanknoteSerial, 30)[len(testLockingScript):], draft.Configuration.Outputs[1].Script)
+
+		assert.Equal(t, []*UtxoPointer{{TransactionID: testTxID, OutputIndex: 1}}, draft.Configuration.IncludeUtxos)
+		assert.Equal(t, true, draft.Metadata["isTokenTransaction"])
+		assert.Equal(t, &tokenTransactionConfig{StablecoinID: testStablecoinID, TxOutputs: []int{0}, ChangeOutputs: []int{1}}, draft.Metadata[TransactionConfigKey])
+
+		// and then:
+		utxo, err := getUtxo(ctx, testTxID, 1, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, testDraftID, utxo.DraftID.String)
+	})
+
+	t.Run("select more banknotes without change", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+		draft := newDraft(client, &TokenTransfer{StablecoinID: testStablecoinID, Amount: 150, To: receiver})
+
+		// when:
+		err := draft.processTokenTransfer(ctx, sender)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, draft.Configuration.Outputs, 2)
+		assert.Equal(t, receiver, draft.Configuration.Outputs[0].To)
+		assert.Equal(t, receiver, draft.Configuration.Outputs[1].To)
+		assert.Len(t, draft.Configuration.IncludeUtxos, 2)
+		assert.Equal(t, &tokenTransactionConfig{StablecoinID: testStablecoinID, TxOutputs: []int{0, 1}}, draft.Metadata[TransactionConfigKey])
+	})
+
+	t.Run("fail when the banknotes of the stablecoin do not cover the amount", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+		draft := newDraft(client, &TokenTransfer{StablecoinID: testStablecoinID, Amount: 151, To: receiver})
+
+		// when:
+		err := draft.processTokenTransfer(ctx, sender)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinNotEnoughBanknotes)
+		assert.Empty(t, draft.Configuration.Outputs)
+
+		// and then:
+		utxo, err := getUtxo(ctx, testTxID, 1, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.False(t, utxo.DraftID.Valid)
+	})
+
+	t.Run("fail when the banknotes are reserved by other draft", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+		err := newDraft(client, &TokenTransfer{StablecoinID: testStablecoinID, Amount: 100, To: receiver}).processTokenTransfer(ctx, sender)
+		require.NoError(t, err)
+
+		draft := newDraft(client, &TokenTransfer{StablecoinID: testStablecoinID, Amount: 100, To: receiver})
+		draft.ID = testDraftID2
+
+		// when:
+		err = draft.processTokenTransfer(ctx, sender)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinNotEnoughBanknotes)
+	})
+
+	t.Run("fail for token transfer combined with outputs", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+		draft := newDraft(client, &TokenTransfer{StablecoinID: testStablecoinID, Amount: 10, To: receiver})
+		draft.Configuration.Outputs = []*TransactionOutput{{To: testExternalAddress, Satoshis: 1000}}
+
+		// when:
+		err := draft.processTokenTransfer(ctx, sender)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinTokenTransferInvalid)
+	})
+
+	t.Run("release the banknotes when the receiver does not accept the intent", func(t *testing.T) {
+		// given:
+		ctx, client, paymailClient := newStablecoinTransferTestClient(t)
+		paymailClient.WillRespondWithP2PCapabilities()
+		seed(t, ctx, client)
+
+		// when:
+		_, err := newDraftTransaction(testXPub, &TransactionConfig{
+			TokenTransfer: &TokenTransfer{StablecoinID: testStablecoinID, Amount: 10, To: receiver},
+		}, append(client.DefaultModelOptions(), New())...)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrCapabilitiesStablecoinTransferUnsupported)
+
+		// and then:
+		utxo, err := getUtxo(ctx, testTxID, 1, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.False(t, utxo.DraftID.Valid)
+	})
+}
@@ -29,8 +29,6 @@ import (
 const (
 	// TransactionFeeFreeKey is the key used in metadata to indicate that the transaction is fee-free
 	TransactionFeeFreeKey string = "fee-free"
-	// TransactionOperationKey is the key used in metadata to indicate the operation type of the transaction
-	TransactionOperationKey string = "operation"
 	// TransactionConfigKey is the key used in metadata to indicate the transfer config
 	TransactionConfigKey string = "tokenTransactionConfig"
 )
@@ -60,6 +58,10 @@ type tokenTransactionConfig struct {
 	ChangeOutputs  []int  `json:"changeOutputs"`
 	FinalTxOutputs []int  `json:"finalTxOutputs"`
 	FeeTxOutputs   []int  `json:"feeTxOutputs"`
+
+	// SenderID is the paymail which has sent (and signed) the transfer intent
+	SenderID  string                  `json:"senderId,omitempty"`
+	Operation StablecoinOperationType `json:"operation,omitempty"`
 }
 
 // newDraftTransaction will start a new draft tx
@@ -187,6 +189,13 @@ func (m *DraftTransaction) processConfigOutputs(ctx context.Context) error {
 
 	paymailService := c.PaymailService()
 
+	// The token outputs and the token metadata are built from the automatically selected banknotes
+	if m.Configuration.TokenTransfer != nil {
+		if err := m.processTokenTransfer(ctx, paymailFrom); err != nil {
+			return err
+		}
+	}
+
 	// Special case where we are sending all funds to a single (address, paymail, handle)
 	if m.Configuration.SendAllTo != nil {
 		outputs := m.Configuration.Outputs
@@ -219,13 +228,10 @@ func (m *DraftTransaction) processConfigOutputs(ctx context.Context) error {
 
 			m.setTokenOutputs(metadataConfig)
 
-			// isSpecialOperation is a placeholder to not update the outputs when the transaction is issue or redeem
-			_, isSpecialOperation := m.Metadata[TransactionOperationKey]
-			if !isSpecialOperation {
-				err := m.UpdateTokenTxOutputs(senderPaymail, metadataConfig)
-				if err != nil {
-					return fmt.Errorf("failed to update token tx outputs: %w", err)
-				}
+			// issue and redeem are negotiated with the receiver as well, the receiver validates them against the emitter
+			err := m.UpdateTokenTxOutputs(ctx, paymailFrom, metadataConfig)
+			if err != nil {
+				return fmt.Errorf("failed to update token tx outputs: %w", err)
 			}
 		}
 
@@ -247,7 +253,7 @@ func (m *DraftTransaction) processConfigOutputs(ctx context.Context) error {
 }
 
 // UpdateTokenTxOutputs will update the transaction outputs to include token transaction metadata
-func (m *DraftTransaction) UpdateTokenTxOutputs(senderPaymail string, metadataConfig *tokenTransactionConfig) error {
+func (m *DraftTransaction) UpdateTokenTxOutputs(ctx context.Context, senderPaymail string, metadataConfig *tokenTransactionConfig) error {
 	receiver, banknotes, amount, err := m.getBanknotes(metadataConfig)
 	if err != nil {
 		return fmt.Errorf("failed to get banknotes: %w", err)
@@ -265,6 +271,7 @@ func (m *DraftTransaction) UpdateTokenTxOutputs(senderPaymail string, metadataCo
 		StablecoinID: metadataConfig.StablecoinID,
 		Banknotes:    banknotes,
 		Amount:       amount,
+		Operation:    metadataConfig.Operation,
 	}
 
 	if _, ok := m.Metadata[TransactionFeeFreeKey]; ok {
@@ -273,12 +280,13 @@ func (m *DraftTransaction) UpdateTokenTxOutputs(senderPaymail string, metadataCo
 		}
 	}
 
-	resp, err := m.client.StablecoinTransferService().SendTransferIntent(intent)
+	resp, err := m.client.StablecoinTransferService().SendTransferIntent(ctx, m.client, intent)
 	if err != nil {
 		return fmt.Errorf("failed to send transfer intent: %w", err)
 	}
 
 	// Add new tx outputs to easier track balance in transactions
+	metadataConfig.SenderID = senderPaymail
 	metadataConfig.FinalTxOutputs = resp.TransferIndexes
 	metadataConfig.FeeTxOutputs = resp.FeeIndexes
 	m.Metadata[TransactionConfigKey] = metadataConfig
@@ -368,7 +376,7 @@ func (m *DraftTransaction) updateTokenTxOutputs(tTxCfg *tokenTransactionConfig,
 // createTransactionHex will create the transaction with the given inputs and outputs
 func (m *DraftTransaction) createTransactionHex(ctx context.Context) (err error) {
 	// Check that we have outputs
-	if len(m.Configuration.Outputs) == 0 && m.Configuration.SendAllTo == nil {
+	if len(m.Configuration.Outputs) == 0 && m.Configuration.SendAllTo == nil && m.Configuration.TokenTransfer == nil {
 		return spverrors.ErrMissingTransactionOutputs
 	}
 
@@ -376,8 +384,13 @@ func (m *DraftTransaction) createTransactionHex(ctx context.Context) (err error)
 	opts := m.GetOptions(false)
 
 	// Process the outputs first
-	// if an error occurs in processing the outputs, we have at least not made any reservations yet
+	// if an error occurs in processing the outputs, we have at most reserved the banknotes of the token transfer
 	if err = m.processConfigOutputs(ctx); err != nil {
+		if m.Configuration.TokenTransfer != nil {
+			if utxoErr := unReserveUtxos(ctx, m.XpubID, m.ID, opts...); utxoErr != nil {
+				err = spverrors.Wrapf(err, "%s", utxoErr.Error())
+			}
+		}
 		return
 	}
 
@@ -0,0 +1,281 @@
+package engine
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	trx "github.com/bsv-blockchain/go-sdk/transaction"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// GatewayNotificationStatus is the delivery status of the gateway notification
+type GatewayNotificationStatus string
+
+const (
+	// GatewayNotificationPending is when the notification waits for (another) delivery attempt
+	GatewayNotificationPending GatewayNotificationStatus = "pending"
+	// GatewayNotificationSent is when the gateway has accepted the notification
+	GatewayNotificationSent GatewayNotificationStatus = "sent"
+	// GatewayNotificationDead is when all delivery attempts have failed and the notification requires manual handling
+	GatewayNotificationDead GatewayNotificationStatus = "dead"
+)
+
+// GatewayNotification is an outbox record of the stablecoin transfer which has to be reported to the gateway
+//
+// Gorm related models & indexes: https://gorm.io/docs/models.html - https://gorm.io/docs/indexes.html
+type GatewayNotification struct {
+	// Base model
+	Model
+
+	// Model specific fields
+	ID            string                     `json:"id" toml:"id" yaml:"id" gorm:"<-:create;type:char(64);primaryKey;comment:This is the unique notification id"`
+	RefID         string                     `json:"ref_id" toml:"ref_id" yaml:"ref_id" gorm:"<-:create;type:char(64);index;comment:This is the reference ID of the transfer intent"`
+	TxID          string                     `json:"tx_id" toml:"tx_id" yaml:"tx_id" gorm:"<-:create;type:char(64);index;comment:This is the transaction ID of the transfer"`
+	StablecoinID  string                     `json:"stablecoin_id" toml:"stablecoin_id" yaml:"stablecoin_id" gorm:"<-:create;comment:This is the stablecoin identifier"`
+	Direction     gateway.TransferDirection  `json:"direction" toml:"direction" yaml:"direction" gorm:"<-:create;type:varchar(10);comment:This is the direction of the transfer"`
+	Payload       GatewayNotificationPayload `json:"payload" toml:"payload" yaml:"payload" gorm:"<-:create;comment:This is the banknotes and fee outputs of the transfer"`
+	Status        GatewayNotificationStatus  `json:"status" toml:"status" yaml:"status" gorm:"<-;type:varchar(10);index;comment:This is the delivery status of the notification"`
+	Attempts      int                        `json:"attempts" toml:"attempts" yaml:"attempts" gorm:"<-;comment:This is the number of failed delivery attempts"`
+	NextAttemptAt time.Time                  `json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at" gorm:"<-;index;comment:Time of the next delivery attempt"`
+	LastError     string                     `json:"last_error,omitempty" toml:"last_error" yaml:"last_error" gorm:"<-;type:text;comment:This is the error of the last delivery attempt"`
+}
+
+// GatewayNotificationPayload holds the token outputs reported to the gateway
+type GatewayNotificationPayload struct {
+	Banknotes  []*gateway.TransferBanknote  `json:"banknotes"`
+	FeeOutputs []*gateway.TransferFeeOutput `json:"feeOutputs"`
+}
+
+// newGatewayNotification will start a new pending gateway notification
+func newGatewayNotification(direction gateway.TransferDirection, refID, txID, stablecoinID string, payload GatewayNotificationPayload, opts ...ModelOps) *GatewayNotification {
+	return &GatewayNotification{
+		ID:            utils.Hash(string(direction) + refID + txID),
+		RefID:         refID,
+		TxID:          txID,
+		StablecoinID:  stablecoinID,
+		Direction:     direction,
+		Payload:       payload,
+		Status:        GatewayNotificationPending,
+		NextAttemptAt: time.Now().UTC(),
+		Model:         *NewBaseModel(ModelGatewayNotification, opts...),
+	}
+}
+
+// newGatewayNotificationPayload collects the token outputs of the transfer transaction
+//
+// outputs are the intent (or draft) outputs, where the index of the output is the vout in the transaction,
+// they are used to distinguish the fee and the change outputs from the banknotes sent to the receiver
+func newGatewayNotificationPayload(tx *trx.Transaction, outputs TransactionOutputs) GatewayNotificationPayload {
+	payload := GatewayNotificationPayload{
+		Banknotes:  make([]*gateway.TransferBanknote, 0),
+		FeeOutputs: make([]*gateway.TransferFeeOutput, 0),
+	}
+
+	for vout, txOut := range tx.Outputs {
+		operation, err := tokens.ParseTokenScript(txOut.LockingScript)
+		if err != nil {
+			continue
+		}
+
+		var out *TransactionOutput
+		if vout < len(outputs) {
+			out = outputs[vout]
+		}
+
+		switch {
+		case out != nil && out.TokenChange:
+			continue
+		case out != nil && out.TokenFee:
+			payload.FeeOutputs = append(payload.FeeOutputs, &gateway.TransferFeeOutput{
+				Vout:      uint32(vout),
+				Serial:    string(operation.ID),
+				Amount:    operation.Amount,
+				Recipient: out.To,
+			})
+		default:
+			payload.Banknotes = append(payload.Banknotes, &gateway.TransferBanknote{
+				Vout:   uint32(vout),
+				Serial: string(operation.ID),
+				Amount: operation.Amount,
+			})
+		}
+	}
+
+	return payload
+}
+
+// getGatewayNotificationByID will get the gateway notification with the given id
+func getGatewayNotificationByID(ctx context.Context, id string, opts ...ModelOps) (*GatewayNotification, error) {
+	conditions := map[string]interface{}{
+		idField: id,
+	}
+
+	notification := &GatewayNotification{Model: *NewBaseModel(ModelGatewayNotification, opts...)}
+	if err := Get(ctx, notification, conditions, false, defaultDatabaseReadTimeout, true); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+// getGatewayNotificationsToSend will get the pending notifications which are due for the delivery attempt
+func getGatewayNotificationsToSend(ctx context.Context, limit int, opts ...ModelOps) ([]*GatewayNotification, error) {
+	var models []GatewayNotification
+	conditions := map[string]interface{}{
+		statusField: GatewayNotificationPending,
+		nextAttemptAtField: map[string]interface{}{
+			"$lte": time.Now().UTC(),
+		},
+	}
+
+	queryParams := &datastore.QueryParams{
+		Page:          1,
+		PageSize:      limit,
+		OrderByField:  nextAttemptAtField,
+		SortDirection: datastore.SortAsc,
+	}
+
+	notification := &GatewayNotification{Model: *NewBaseModel(ModelGatewayNotification, opts...)}
+	if err := getModels(
+		ctx, notification.Client().Datastore(),
+		&models, conditions, queryParams, defaultDatabaseReadTimeout,
+	); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	notifications := make([]*GatewayNotification, 0, len(models))
+	for index := range models {
+		models[index].enrich(ModelGatewayNotification, opts...)
+		notifications = append(notifications, &models[index])
+	}
+
+	return notifications, nil
+}
+
+// toTransferNotification maps the outbox record into the gateway request
+func (m *GatewayNotification) toTransferNotification() *gateway.TransferNotification {
+	return &gateway.TransferNotification{
+		RefID:        m.RefID,
+		TxID:         m.TxID,
+		StablecoinID: m.StablecoinID,
+		Direction:    m.Direction,
+		Banknotes:    m.Payload.Banknotes,
+		FeeOutputs:   m.Payload.FeeOutputs,
+	}
+}
+
+// markSent will mark the notification as delivered
+func (m *GatewayNotification) markSent() {
+	m.Status = GatewayNotificationSent
+	m.LastError = ""
+}
+
+// markFailed will register the failed delivery attempt and schedule the next one using exponential backoff,
+// after reaching the max attempts the notification is moved to the dead letter status
+func (m *GatewayNotification) markFailed(cause error) {
+	m.Attempts++
+	m.LastError = cause.Error()
+
+	if m.Attempts >= gatewayNotificationMaxAttempts {
+		m.Status = GatewayNotificationDead
+		return
+	}
+
+	m.NextAttemptAt = time.Now().UTC().Add(gatewayNotificationBackoff(m.Attempts))
+}
+
+// gatewayNotificationBackoff returns the delay before the next delivery attempt
+func gatewayNotificationBackoff(attempts int) time.Duration {
+	delay := gatewayNotificationRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= gatewayNotificationMaxRetryDelay {
+			return gatewayNotificationMaxRetryDelay
+		}
+	}
+	return delay
+}
+
+// GetModelName will get the name of the current model
+func (m *GatewayNotification) GetModelName() string {
+	return ModelGatewayNotification.String()
+}
+
+// GetModelTableName will get the db table name of the current model
+func (m *GatewayNotification) GetModelTableName() string {
+	return tableGatewayNotifications
+}
+
+// Save will save the model into the Datastore
+func (m *GatewayNotification) Save(ctx context.Context) error {
+	return Save(ctx, m)
+}
+
+// GetID will get the model ID
+func (m *GatewayNotification) GetID() string {
+	return m.ID
+}
+
+// BeforeCreating will fire before the model is being inserted into the Datastore
+func (m *GatewayNotification) BeforeCreating(_ context.Context) error {
+	return nil
+}
+
+// PostMigrate is called after the model is migrated
+func (m *GatewayNotification) PostMigrate(client datastore.ClientInterface) error {
+	err := client.IndexMetadata(client.GetTableName(tableGatewayNotifications), metadataField)
+	return spverrors.Wrapf(err, "failed to index metadata column on model %s", m.GetModelName())
+}
+
+// GormDataType type in gorm
+func (p GatewayNotificationPayload) GormDataType() string {
+	return gormTypeText
+}
+
+// Scan scan value into JSON, implements sql.Scanner interface
+func (p *GatewayNotificationPayload) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	byteValue, err := utils.ToByteArray(value)
+	if err != nil {
+		return nil
+	}
+
+	err = json.Unmarshal(byteValue, &p)
+	return spverrors.Wrapf(err, "failed to parse GatewayNotificationPayload from JSON, data: %v", value)
+}
+
+// Value return json value, implement driver.Valuer interface
+func (p GatewayNotificationPayload) Value() (driver.Value, error) {
+	marshal, err := json.Marshal(p)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to convert GatewayNotificationPayload to JSON, data: %v", p)
+	}
+
+	return string(marshal), nil
+}
+
+// GormDBDataType the gorm data type for metadata
+func (GatewayNotificationPayload) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	if db.Dialector.Name() == datastore.Postgres {
+		return datastore.JSONB
+	}
+	return datastore.JSON
+}
@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayNotificationBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, gatewayNotificationRetryDelay, gatewayNotificationBackoff(1))
+	assert.Equal(t, 2*gatewayNotificationRetryDelay, gatewayNotificationBackoff(2))
+	assert.Equal(t, 4*gatewayNotificationRetryDelay, gatewayNotificationBackoff(3))
+	assert.Equal(t, gatewayNotificationMaxRetryDelay, gatewayNotificationBackoff(gatewayNotificationMaxAttempts))
+}
+
+func TestGatewayNotification_markFailed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("schedule next attempt", func(t *testing.T) {
+		notification := newGatewayNotification(gateway.TransferIncoming, "ref", "tx", "coin", GatewayNotificationPayload{})
+
+		notification.markFailed(errors.New("gateway is down"))
+
+		assert.Equal(t, GatewayNotificationPending, notification.Status)
+		assert.Equal(t, 1, notification.Attempts)
+		assert.Equal(t, "gateway is down", notification.LastError)
+		assert.True(t, notification.NextAttemptAt.After(time.Now().UTC()))
+	})
+
+	t.Run("dead letter after max attempts", func(t *testing.T) {
+		notification := newGatewayNotification(gateway.TransferOutgoing, "ref", "tx", "coin", GatewayNotificationPayload{})
+
+		for i := 0; i < gatewayNotificationMaxAttempts; i++ {
+			notification.markFailed(errors.New("gateway is down"))
+		}
+
+		assert.Equal(t, GatewayNotificationDead, notification.Status)
+		assert.Equal(t, gatewayNotificationMaxAttempts, notification.Attempts)
+	})
+
+	t.Run("mark sent clears last error", func(t *testing.T) {
+		notification := newGatewayNotification(gateway.TransferOutgoing, "ref", "tx", "coin", GatewayNotificationPayload{})
+		notification.markFailed(errors.New("gateway is down"))
+
+		notification.markSent()
+
+		assert.Equal(t, GatewayNotificationSent, notification.Status)
+		assert.Empty(t, notification.LastError)
+	})
+}
+
+func TestGatewayNotificationPayload_Scan(t *testing.T) {
+	t.Parallel()
+
+	payload := GatewayNotificationPayload{
+		Banknotes:  []*gateway.TransferBanknote{{Vout: 0, Serial: "serial_0", Amount: 100}},
+		FeeOutputs: []*gateway.TransferFeeOutput{{Vout: 1, Serial: "serial_0", Amount: 1, Recipient: "fee@example.com"}},
+	}
+
+	value, err := payload.Value()
+	require.NoError(t, err)
+
+	var scanned GatewayNotificationPayload
+	err = scanned.Scan(value)
+	require.NoError(t, err)
+	assert.Equal(t, payload, scanned)
+}
+
+func TestGatewayNotification_uniqueID(t *testing.T) {
+	t.Parallel()
+
+	incoming := newGatewayNotification(gateway.TransferIncoming, "ref", "tx", "coin", GatewayNotificationPayload{})
+	outgoing := newGatewayNotification(gateway.TransferOutgoing, "ref", "tx", "coin", GatewayNotificationPayload{})
+	again := newGatewayNotification(gateway.TransferIncoming, "ref", "tx", "coin", GatewayNotificationPayload{})
+
+	assert.NotEqual(t, incoming.ID, outgoing.ID)
+	assert.Equal(t, incoming.ID, again.ID)
+}
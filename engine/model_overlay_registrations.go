@@ -0,0 +1,224 @@
+package engine
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// OverlayRegistrationStatus is the status of the registration of the token transaction in the overlay
+type OverlayRegistrationStatus string
+
+const (
+	// OverlayRegistrationPending is when the overlay has not confirmed the token transaction yet and the registration is retried
+	OverlayRegistrationPending OverlayRegistrationStatus = "pending"
+	// OverlayRegistrationRegistered is when the overlay has confirmed the token transaction
+	OverlayRegistrationRegistered OverlayRegistrationStatus = "registered"
+)
+
+// OverlayRegistration is an outbox record of the token transaction accepted by the receiver but not yet registered in the overlay
+// It is retried until the overlay confirms the transfer, there is no dead letter as the transfer is already accepted.
+//
+// Gorm related models & indexes: https://gorm.io/docs/models.html - https://gorm.io/docs/indexes.html
+type OverlayRegistration struct {
+	// Base model
+	Model
+
+	// Model specific fields
+	ID            string                     `json:"id" toml:"id" yaml:"id" gorm:"<-:create;type:char(64);primaryKey;comment:This is the transaction ID of the token transfer"`
+	XpubID        string                     `json:"xpub_id" toml:"xpub_id" yaml:"xpub_id" gorm:"<-:create;type:char(64);index;comment:This is the xPub of the sender"`
+	AssetID       string                     `json:"asset_id" toml:"asset_id" yaml:"asset_id" gorm:"<-:create;comment:This is the stablecoin identifier"`
+	Request       OverlayRegistrationRequest `json:"request" toml:"request" yaml:"request" gorm:"<-:create;comment:This is the transfer request sent to the overlay"`
+	HoldBroadcast bool                       `json:"hold_broadcast" toml:"hold_broadcast" yaml:"hold_broadcast" gorm:"<-;comment:Whether the broadcast waits for the registration"`
+	Status        OverlayRegistrationStatus  `json:"status" toml:"status" yaml:"status" gorm:"<-;type:varchar(10);index;comment:This is the status of the registration"`
+	Attempts      int                        `json:"attempts" toml:"attempts" yaml:"attempts" gorm:"<-;comment:This is the number of failed registration attempts"`
+	NextAttemptAt time.Time                  `json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at" gorm:"<-;index;comment:Time of the next registration attempt"`
+	LastError     string                     `json:"last_error,omitempty" toml:"last_error" yaml:"last_error" gorm:"<-;type:text;comment:This is the error of the last registration attempt"`
+	RegisteredAt  *time.Time                 `json:"registered_at,omitempty" toml:"registered_at" yaml:"registered_at" gorm:"<-;comment:When the overlay has confirmed the registration"`
+}
+
+// OverlayRegistrationRequest holds the transfer request to be sent to the overlay
+type OverlayRegistrationRequest struct {
+	*tokens.TransferRequest
+}
+
+// newOverlayRegistration will start a new pending overlay registration of the token transaction
+func newOverlayRegistration(xPubID, txID string, request *tokens.TransferRequest, holdBroadcast bool, opts ...ModelOps) *OverlayRegistration {
+	return &OverlayRegistration{
+		ID:            txID,
+		XpubID:        xPubID,
+		AssetID:       request.AssetID,
+		Request:       OverlayRegistrationRequest{TransferRequest: request},
+		HoldBroadcast: holdBroadcast,
+		Status:        OverlayRegistrationPending,
+		NextAttemptAt: time.Now().UTC(),
+		Model:         *NewBaseModel(ModelOverlayRegistration, opts...),
+	}
+}
+
+// getOverlayRegistrationByID will get the overlay registration of the transaction
+func getOverlayRegistrationByID(ctx context.Context, txID string, opts ...ModelOps) (*OverlayRegistration, error) {
+	conditions := map[string]interface{}{
+		idField: txID,
+	}
+
+	registration := &OverlayRegistration{Model: *NewBaseModel(ModelOverlayRegistration, opts...)}
+	if err := Get(ctx, registration, conditions, false, defaultDatabaseReadTimeout, true); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return registration, nil
+}
+
+// getOverlayRegistrationsToRetry will get the pending registrations which are due for the next attempt
+func getOverlayRegistrationsToRetry(ctx context.Context, limit int, opts ...ModelOps) ([]*OverlayRegistration, error) {
+	var models []OverlayRegistration
+	conditions := map[string]interface{}{
+		statusField: OverlayRegistrationPending,
+		nextAttemptAtField: map[string]interface{}{
+			"$lte": time.Now().UTC(),
+		},
+	}
+
+	queryParams := &datastore.QueryParams{
+		Page:          1,
+		PageSize:      limit,
+		OrderByField:  nextAttemptAtField,
+		SortDirection: datastore.SortAsc,
+	}
+
+	registration := &OverlayRegistration{Model: *NewBaseModel(ModelOverlayRegistration, opts...)}
+	if err := getModels(
+		ctx, registration.Client().Datastore(),
+		&models, conditions, queryParams, defaultDatabaseReadTimeout,
+	); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	registrations := make([]*OverlayRegistration, 0, len(models))
+	for index := range models {
+		models[index].enrich(ModelOverlayRegistration, opts...)
+		registrations = append(registrations, &models[index])
+	}
+
+	return registrations, nil
+}
+
+// transferRequest returns the request to be sent to the overlay
+func (m *OverlayRegistration) transferRequest() *tokens.TransferRequest {
+	request := *m.Request.TransferRequest
+	request.AssetID = m.AssetID
+	return &request
+}
+
+// markRegistered will mark the registration as confirmed by the overlay
+func (m *OverlayRegistration) markRegistered() {
+	now := time.Now().UTC()
+	m.Status = OverlayRegistrationRegistered
+	m.RegisteredAt = &now
+	m.LastError = ""
+}
+
+// markFailed will register the failed attempt and schedule the next one using exponential backoff
+func (m *OverlayRegistration) markFailed(cause error) {
+	m.Attempts++
+	m.LastError = cause.Error()
+	m.NextAttemptAt = time.Now().UTC().Add(overlayRegistrationBackoff(m.Attempts))
+}
+
+// overlayRegistrationBackoff returns the delay before the next registration attempt
+func overlayRegistrationBackoff(attempts int) time.Duration {
+	delay := overlayRegistrationRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= overlayRegistrationMaxRetryDelay {
+			return overlayRegistrationMaxRetryDelay
+		}
+	}
+	return delay
+}
+
+// GetModelName will get the name of the current model
+func (m *OverlayRegistration) GetModelName() string {
+	return ModelOverlayRegistration.String()
+}
+
+// GetModelTableName will get the db table name of the current model
+func (m *OverlayRegistration) GetModelTableName() string {
+	return tableOverlayRegistrations
+}
+
+// Save will save the model into the Datastore
+func (m *OverlayRegistration) Save(ctx context.Context) error {
+	return Save(ctx, m)
+}
+
+// GetID will get the model ID
+func (m *OverlayRegistration) GetID() string {
+	return m.ID
+}
+
+// BeforeCreating will fire before the model is being inserted into the Datastore
+func (m *OverlayRegistration) BeforeCreating(_ context.Context) error {
+	return nil
+}
+
+// PostMigrate is called after the model is migrated
+func (m *OverlayRegistration) PostMigrate(client datastore.ClientInterface) error {
+	err := client.IndexMetadata(client.GetTableName(tableOverlayRegistrations), metadataField)
+	return spverrors.Wrapf(err, "failed to index metadata column on model %s", m.GetModelName())
+}
+
+// GormDataType type in gorm
+func (r OverlayRegistrationRequest) GormDataType() string {
+	return gormTypeText
+}
+
+// Scan scan value into JSON, implements sql.Scanner interface
+func (r *OverlayRegistrationRequest) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	byteValue, err := utils.ToByteArray(value)
+	if err != nil {
+		return nil
+	}
+
+	r.TransferRequest = &tokens.TransferRequest{}
+	err = json.Unmarshal(byteValue, r.TransferRequest)
+	return spverrors.Wrapf(err, "failed to parse OverlayRegistrationRequest from JSON, data: %v", value)
+}
+
+// Value return json value, implement driver.Valuer interface
+func (r OverlayRegistrationRequest) Value() (driver.Value, error) {
+	marshal, err := json.Marshal(r.TransferRequest)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to convert OverlayRegistrationRequest to JSON, data: %v", r)
+	}
+
+	return string(marshal), nil
+}
+
+// GormDBDataType the gorm data type for metadata
+func (OverlayRegistrationRequest) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	if db.Dialector.Name() == datastore.Postgres {
+		return datastore.JSONB
+	}
+	return datastore.JSON
+}
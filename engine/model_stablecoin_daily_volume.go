@@ -0,0 +1,147 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// dailyVolumeRole tells whether the volume is sent or received by the party
+type dailyVolumeRole string
+
+const (
+	dailyVolumeSender   dailyVolumeRole = "sender"
+	dailyVolumeReceiver dailyVolumeRole = "receiver"
+
+	dailyVolumeDayLayout = time.DateOnly
+)
+
+// StablecoinDailyVolume is the stablecoin volume reserved by the transfer intents of a single sender or receiver within a day (UTC)
+// The volume is increased with a conditional update, so the concurrent intents cannot exceed the daily limit together.
+//
+// Gorm related models & indexes: https://gorm.io/docs/models.html - https://gorm.io/docs/indexes.html
+type StablecoinDailyVolume struct {
+	// Base model
+	Model
+
+	// Model specific fields
+	ID           string          `json:"id" toml:"id" yaml:"id" gorm:"<-:create;type:char(64);primaryKey;comment:This is the hash of the role, party, stablecoin and day"`
+	Role         dailyVolumeRole `json:"role" toml:"role" yaml:"role" gorm:"<-:create;type:varchar(10);comment:Whether the volume is sent or received by the party"`
+	Party        string          `json:"party" toml:"party" yaml:"party" gorm:"<-:create;index;comment:This is the paymail of the sender or the receiver"`
+	StablecoinID string          `json:"stablecoin_id" toml:"stablecoin_id" yaml:"stablecoin_id" gorm:"<-:create;comment:This is the stablecoin identifier"`
+	Day          string          `json:"day" toml:"day" yaml:"day" gorm:"<-:create;type:char(10);comment:This is the day (UTC) of the volume"`
+	Volume       uint64          `json:"volume" toml:"volume" yaml:"volume" gorm:"<-;comment:This is the amount reserved by the pending and consumed intents"`
+}
+
+// dailyVolumeID returns the ID of the volume of the party
+func dailyVolumeID(role dailyVolumeRole, party, stablecoinID, day string) string {
+	return utils.Hash(string(role) + party + stablecoinID + day)
+}
+
+// dailyVolumeParty returns the sanitized paymail of the party, so the differently written addresses share the same volume
+func dailyVolumeParty(paymailAddress string) string {
+	_, _, address := paymail.SanitizePaymail(paymailAddress)
+	return address
+}
+
+// reserveDailyVolume adds the amount to the daily volume of the party if the volume stays within the limit, 0 means no limit.
+// It returns false when the reservation would exceed the limit.
+func reserveDailyVolume(ctx context.Context, db *gorm.DB, role dailyVolumeRole, party, stablecoinID, day string, amount, limit uint64) (bool, error) {
+	party = dailyVolumeParty(party)
+	id := dailyVolumeID(role, party, stablecoinID, day)
+	now := time.Now().UTC()
+
+	err := db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&StablecoinDailyVolume{
+			Model:        Model{CreatedAt: now, UpdatedAt: now},
+			ID:           id,
+			Role:         role,
+			Party:        party,
+			StablecoinID: stablecoinID,
+			Day:          day,
+		}).Error
+	if err != nil {
+		return false, spverrors.Wrapf(err, "failed to create daily stablecoin volume")
+	}
+
+	query := db.WithContext(ctx).
+		Model(&StablecoinDailyVolume{}).
+		Where("id = ?", id)
+	if limit > 0 {
+		query = query.Where("volume + ? <= ?", amount, limit)
+	}
+
+	result := query.Updates(map[string]any{
+		"volume":     gorm.Expr("volume + ?", amount),
+		"updated_at": now,
+	})
+	if result.Error != nil {
+		return false, spverrors.Wrapf(result.Error, "failed to reserve daily stablecoin volume")
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// releaseDailyVolume subtracts the amount of the intent which has not been used by the transfer from the daily volume of the party
+func releaseDailyVolume(ctx context.Context, db *gorm.DB, role dailyVolumeRole, party, stablecoinID, day string, amount uint64) error {
+	party = dailyVolumeParty(party)
+	err := db.WithContext(ctx).
+		Model(&StablecoinDailyVolume{}).
+		Where("id = ? AND volume >= ?", dailyVolumeID(role, party, stablecoinID, day), amount).
+		Updates(map[string]any{
+			"volume":     gorm.Expr("volume - ?", amount),
+			"updated_at": time.Now().UTC(),
+		}).Error
+	return spverrors.Wrapf(err, "failed to release daily stablecoin volume")
+}
+
+// releaseIntentDailyVolume releases the volume reserved for the intent by the daily volume policy (if any)
+func releaseIntentDailyVolume(ctx context.Context, c ClientInterface, senderID, receiverID, stablecoinID, day string, amount uint64) error {
+	if day == "" {
+		return nil
+	}
+
+	db := c.Datastore().DB()
+	if err := releaseDailyVolume(ctx, db, dailyVolumeSender, senderID, stablecoinID, day, amount); err != nil {
+		return err
+	}
+	return releaseDailyVolume(ctx, db, dailyVolumeReceiver, receiverID, stablecoinID, day, amount)
+}
+
+// GetModelName will get the name of the current model
+func (m *StablecoinDailyVolume) GetModelName() string {
+	return ModelStablecoinDailyVolume.String()
+}
+
+// GetModelTableName will get the db table name of the current model
+func (m *StablecoinDailyVolume) GetModelTableName() string {
+	return tableStablecoinDailyVolumes
+}
+
+// Save will save the model into the Datastore
+func (m *StablecoinDailyVolume) Save(ctx context.Context) error {
+	return Save(ctx, m)
+}
+
+// GetID will get the model ID
+func (m *StablecoinDailyVolume) GetID() string {
+	return m.ID
+}
+
+// BeforeCreating will fire before the model is being inserted into the Datastore
+func (m *StablecoinDailyVolume) BeforeCreating(_ context.Context) error {
+	return nil
+}
+
+// PostMigrate is called after the model is migrated
+func (m *StablecoinDailyVolume) PostMigrate(client datastore.ClientInterface) error {
+	err := client.IndexMetadata(client.GetTableName(tableStablecoinDailyVolumes), metadataField)
+	return spverrors.Wrapf(err, "failed to index metadata column on model %s", m.GetModelName())
+}
@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/notifications"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"gorm.io/gorm"
+)
+
+// StablecoinOperationType is the type of the stablecoin operation performed together with the emitter
+type StablecoinOperationType string
+
+const (
+	// StablecoinOperationIssue is when the emitter sends the newly minted banknotes to the user
+	StablecoinOperationIssue StablecoinOperationType = "issue"
+	// StablecoinOperationRedeem is when the user burns the banknotes by sending them back to the emitter
+	StablecoinOperationRedeem StablecoinOperationType = "redeem"
+)
+
+// StablecoinOperationStatus is the lifecycle status of the stablecoin operation
+type StablecoinOperationStatus string
+
+const (
+	// StablecoinOperationPending is when the draft transaction of the operation waits to be signed and recorded
+	StablecoinOperationPending StablecoinOperationStatus = "pending"
+	// StablecoinOperationCompleted is when the receiver has accepted the transfer of the operation
+	StablecoinOperationCompleted StablecoinOperationStatus = "completed"
+	// StablecoinOperationFailed is when the transfer of the operation has been rejected or could not be validated
+	StablecoinOperationFailed StablecoinOperationStatus = "failed"
+)
+
+// StablecoinOperation is the issue or redeem of the stablecoin sent or received by the xPub
+// The ID of the sent operation is the ID of its draft transaction, the ID of the received one is the reference ID of the transfer intent.
+//
+// Gorm related models & indexes: https://gorm.io/docs/models.html - https://gorm.io/docs/indexes.html
+type StablecoinOperation struct {
+	// Base model
+	Model
+
+	// Model specific fields
+	ID           string                    `json:"id" toml:"id" yaml:"id" gorm:"<-:create;type:char(64);primaryKey;comment:This is the ID of the draft transaction or the transfer intent of the operation"`
+	XpubID       string                    `json:"xpub_id" toml:"xpub_id" yaml:"xpub_id" gorm:"<-:create;type:char(64);index;comment:This is the related xPub"`
+	Type         StablecoinOperationType   `json:"type" toml:"type" yaml:"type" gorm:"<-:create;type:varchar(10);index;comment:This is the type of the operation"`
+	StablecoinID string                    `json:"stablecoin_id" toml:"stablecoin_id" yaml:"stablecoin_id" gorm:"<-:create;comment:This is the stablecoin identifier"`
+	Amount       uint64                    `json:"amount" toml:"amount" yaml:"amount" gorm:"<-:create;comment:This is the amount of the stablecoin"`
+	SenderID     string                    `json:"sender_id" toml:"sender_id" yaml:"sender_id" gorm:"<-:create;comment:This is the paymail of the sender"`
+	ReceiverID   string                    `json:"receiver_id" toml:"receiver_id" yaml:"receiver_id" gorm:"<-:create;comment:This is the paymail of the receiver"`
+	Status       StablecoinOperationStatus `json:"status" toml:"status" yaml:"status" gorm:"<-;type:varchar(10);index;comment:This is the status of the operation"`
+	TxID         string                    `json:"tx_id,omitempty" toml:"tx_id" yaml:"tx_id" gorm:"<-;type:char(64);comment:This is the ID of the transaction of the operation"`
+	Error        string                    `json:"error,omitempty" toml:"error" yaml:"error" gorm:"<-;type:text;comment:This is the reason of the failure"`
+}
+
+// newStablecoinOperation will start a new pending stablecoin operation of the token transfer
+func newStablecoinOperation(id, xPubID, senderID string, transfer *TokenTransfer, opts ...ModelOps) *StablecoinOperation {
+	return &StablecoinOperation{
+		ID:           id,
+		XpubID:       xPubID,
+		Type:         transfer.Operation,
+		StablecoinID: transfer.StablecoinID,
+		Amount:       transfer.Amount,
+		SenderID:     senderID,
+		ReceiverID:   transfer.To,
+		Status:       StablecoinOperationPending,
+		Model:        *NewBaseModel(ModelStablecoinOperation, opts...),
+	}
+}
+
+// getStablecoinOperationByID will get the stablecoin operation with the given id
+// xPubID (optional) limits the operation to the given xPub
+func getStablecoinOperationByID(ctx context.Context, xPubID, id string, opts ...ModelOps) (*StablecoinOperation, error) {
+	conditions := map[string]interface{}{
+		idField: id,
+	}
+	if xPubID != "" {
+		conditions[xPubIDField] = xPubID
+	}
+
+	operation := &StablecoinOperation{Model: *NewBaseModel(ModelStablecoinOperation, opts...)}
+	if err := Get(ctx, operation, conditions, false, defaultDatabaseReadTimeout, false); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return operation, nil
+}
+
+// markCompleted will mark the operation as accepted by the receiver
+func (m *StablecoinOperation) markCompleted(txID string) {
+	m.Status = StablecoinOperationCompleted
+	m.TxID = txID
+	m.Error = ""
+}
+
+// markFailed will mark the operation as failed with the given cause
+func (m *StablecoinOperation) markFailed(txID string, cause error) {
+	m.Status = StablecoinOperationFailed
+	m.TxID = txID
+	m.Error = cause.Error()
+}
+
+// GetModelName will get the name of the current model
+func (m *StablecoinOperation) GetModelName() string {
+	return ModelStablecoinOperation.String()
+}
+
+// GetModelTableName will get the db table name of the current model
+func (m *StablecoinOperation) GetModelTableName() string {
+	return tableStablecoinOperations
+}
+
+// Save will save the model into the Datastore
+func (m *StablecoinOperation) Save(ctx context.Context) error {
+	return Save(ctx, m)
+}
+
+// GetID will get the model ID
+func (m *StablecoinOperation) GetID() string {
+	return m.ID
+}
+
+// BeforeCreating will fire before the model is being inserted into the Datastore
+func (m *StablecoinOperation) BeforeCreating(_ context.Context) error {
+	return nil
+}
+
+// AfterCreated will fire after the model is created in the Datastore
+func (m *StablecoinOperation) AfterCreated(_ context.Context) error {
+	m.notify()
+	return nil
+}
+
+// AfterUpdated will fire after the model is updated in the Datastore
+func (m *StablecoinOperation) AfterUpdated(_ context.Context) error {
+	m.notify()
+	return nil
+}
+
+// PostMigrate is called after the model is migrated
+func (m *StablecoinOperation) PostMigrate(client datastore.ClientInterface) error {
+	err := client.IndexMetadata(client.GetTableName(tableStablecoinOperations), metadataField)
+	return spverrors.Wrapf(err, "failed to index metadata column on model %s", m.GetModelName())
+}
+
+func (m *StablecoinOperation) notify() {
+	if n := m.Client().Notifications(); n != nil {
+		notifications.Notify(n, &models.StablecoinOperationEvent{
+			UserEvent: models.UserEvent{
+				XPubID: m.XpubID,
+			},
+			OperationID:   m.ID,
+			Operation:     string(m.Type),
+			Status:        string(m.Status),
+			StablecoinID:  m.StablecoinID,
+			Amount:        m.Amount,
+			TransactionID: m.TxID,
+		})
+	}
+}
@@ -6,26 +6,52 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"time"
 
 	crypto "github.com/bitcoin-sv/go-sdk/primitives/hash"
 	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"gorm.io/gorm"
 )
 
+// TransferIntentStatus is the lifecycle status of the stablecoin transfer intent
+type TransferIntentStatus string
+
+const (
+	// TransferIntentStatusPending is when the intent waits for the transfer
+	TransferIntentStatusPending TransferIntentStatus = "pending"
+	// TransferIntentStatusConsumed is when the transfer referencing the intent has been accepted
+	TransferIntentStatusConsumed TransferIntentStatus = "consumed"
+	// TransferIntentStatusExpired is when the intent was not used before its expiration time
+	TransferIntentStatusExpired TransferIntentStatus = statusExpired
+	// TransferIntentStatusCanceled is when the intent has been canceled and cannot be used anymore
+	TransferIntentStatusCanceled TransferIntentStatus = statusCanceled
+)
+
 // StablecoinTransferIntent is the model for validating a transfer request
 type StablecoinTransferIntent struct {
 	// Base model
 	Model
 
-	ID           string             `json:"id" toml:"id" yaml:"id" gorm:"primaryKey;type:char(64);not null;uniqueIndex" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5"`
-	SenderID     string             `json:"senderId" example:"example@spv-wallet.com" toml:"senderId" yaml:"senderId" gorm:"<-;comment:Sender identifier"`
-	ReceiverID   string             `json:"receiverId" example:"example@spv-wallet.com" toml:"receiverId" yaml:"receiverId" gorm:"<-;comment:Receiver identifier"`
-	Nonce        string             `json:"nonce" example:"1234567890abcdef" toml:"nonce" yaml:"nonce" gorm:"<-;comment:Nonce for the transfer request"`
-	StablecoinID string             `json:"stablecoinId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0" toml:"stablecoinId" yaml:"stablecoinId" gorm:"<-;comment:Stablecoin identifier"`
-	Amount       uint64             `json:"amount" example:"1000000" toml:"amount" yaml:"amount" gorm:"<-;comment:Amount of tokens to be transferred"`
-	Banknotes    Banknotes          `json:"banknotes" toml:"banknotes" yaml:"banknotes" gorm:"<-;type:json;comment:List of banknotes involved in the transfer"`
-	Outputs      TransactionOutputs `json:"outputs" toml:"outputs" yaml:"outputs" gorm:"<-;type:json;comment:List of outputs involved in the transfer"`
+	ID           string               `json:"id" toml:"id" yaml:"id" gorm:"primaryKey;type:char(64);not null;uniqueIndex" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5"`
+	SenderID     string               `json:"senderId" example:"example@spv-wallet.com" toml:"senderId" yaml:"senderId" gorm:"<-;comment:Sender identifier"`
+	ReceiverID   string               `json:"receiverId" example:"example@spv-wallet.com" toml:"receiverId" yaml:"receiverId" gorm:"<-;comment:Receiver identifier"`
+	Nonce        string               `json:"nonce" example:"1234567890abcdef" toml:"nonce" yaml:"nonce" gorm:"<-;comment:Nonce for the transfer request"`
+	StablecoinID string               `json:"stablecoinId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0" toml:"stablecoinId" yaml:"stablecoinId" gorm:"<-;comment:Stablecoin identifier"`
+	Amount       uint64               `json:"amount" example:"1000000" toml:"amount" yaml:"amount" gorm:"<-;comment:Amount of tokens to be transferred"`
+	Banknotes    Banknotes            `json:"banknotes" toml:"banknotes" yaml:"banknotes" gorm:"<-;type:json;comment:List of banknotes involved in the transfer"`
+	Outputs      TransactionOutputs   `json:"outputs" toml:"outputs" yaml:"outputs" gorm:"<-;type:json;comment:List of outputs involved in the transfer"`
+	RulesVersion string               `json:"rulesVersion,omitempty" toml:"rulesVersion" yaml:"rulesVersion" gorm:"<-;comment:Version of the stablecoin rules used to calculate the fee"`
+	RulesHash    string               `json:"rulesHash,omitempty" toml:"rulesHash" yaml:"rulesHash" gorm:"<-;type:char(64);comment:Hash of the stablecoin rules used to calculate the fee"`
+	Status       TransferIntentStatus `json:"status" toml:"status" yaml:"status" gorm:"<-;type:varchar(10);index;comment:Lifecycle status of the transfer intent"`
+	ExpiresAt    time.Time            `json:"expiresAt" toml:"expiresAt" yaml:"expiresAt" gorm:"<-:create;index;comment:Time when the transfer intent expires"`
+	TxID         string               `json:"txId,omitempty" toml:"txId" yaml:"txId" gorm:"<-;type:char(64);comment:ID of the transaction which consumed the transfer intent"`
+	VolumeDay    string               `json:"-" toml:"-" yaml:"-" gorm:"<-:create;type:char(10);comment:Day of the volume reserved by the daily volume policy, empty if nothing has been reserved"`
+
+	Operation StablecoinOperationType `json:"operation,omitempty" toml:"operation" yaml:"operation" gorm:"<-:create;type:varchar(10);comment:Issue or redeem performed with the emitter, empty for the regular transfer"`
+
+	gatewayNotification *GatewayNotification `gorm:"-"` // Notification about the transfer saved together with the consumed intent
 }
 
 // BeforeCreating is a hook that is called before the model is created in the database
@@ -61,8 +87,19 @@ func (m *StablecoinTransferIntent) Save(ctx context.Context) error {
 	return Save(ctx, m)
 }
 
-// CreateStablecoinTransferIntent creates a new StablecoinTransferIntent with the provided parameters
-func CreateStablecoinTransferIntent(intent *Intent, outputs []*TransactionOutput, opts ...ModelOps) (*StablecoinTransferIntent, error) {
+// ChildModels will get any related sub models
+func (m *StablecoinTransferIntent) ChildModels() (childModels []ModelInterface) {
+	if m.gatewayNotification != nil {
+		childModels = append(childModels, m.gatewayNotification)
+	}
+
+	return
+}
+
+// CreateStablecoinTransferIntent creates a new pending StablecoinTransferIntent with the provided parameters
+// rules are the stablecoin rules used to calculate the fee outputs, they are nil for fee-free intents
+// ttl is the time after which the intent expires if it is not consumed by the transfer
+func CreateStablecoinTransferIntent(intent *Intent, outputs []*TransactionOutput, rules *gateway.StablecoinRule, ttl time.Duration, opts ...ModelOps) (*StablecoinTransferIntent, error) {
 	if intent == nil {
 		return nil, errors.New("transfer intent cannot be nil")
 	}
@@ -85,10 +122,19 @@ func CreateStablecoinTransferIntent(intent *Intent, outputs []*TransactionOutput
 		Amount:       intent.Amount,
 		Banknotes:    intent.Banknotes,
 		Outputs:      outputs,
+		Status:       TransferIntentStatusPending,
+		ExpiresAt:    time.Now().UTC().Add(ttl),
+		Operation:    intent.Operation,
+		VolumeDay:    intent.volumeDay,
 
 		Model: *NewBaseModel(ModelTransferIntent, opts...),
 	}
 
+	if rules != nil {
+		sti.RulesVersion = rules.Version
+		sti.RulesHash = rules.Hash
+	}
+
 	return sti, nil
 }
 
@@ -120,3 +166,80 @@ func getStablecoinTransferIntentByID(ctx context.Context, id string, opts ...Mod
 
 	return sti, nil
 }
+
+// getExpiredStablecoinTransferIntents will get the pending intents which have passed their expiration time
+func getExpiredStablecoinTransferIntents(ctx context.Context, limit int, opts ...ModelOps) ([]*StablecoinTransferIntent, error) {
+	var models []StablecoinTransferIntent
+	conditions := map[string]interface{}{
+		statusField: TransferIntentStatusPending,
+		expiresAtField: map[string]interface{}{
+			"$lte": time.Now().UTC(),
+		},
+	}
+
+	queryParams := &datastore.QueryParams{
+		Page:          1,
+		PageSize:      limit,
+		OrderByField:  expiresAtField,
+		SortDirection: datastore.SortAsc,
+	}
+
+	sti := &StablecoinTransferIntent{Model: *NewBaseModel(ModelTransferIntent, opts...)}
+	if err := getModels(
+		ctx, sti.Client().Datastore(),
+		&models, conditions, queryParams, defaultDatabaseReadTimeout,
+	); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	intents := make([]*StablecoinTransferIntent, 0, len(models))
+	for index := range models {
+		models[index].enrich(ModelTransferIntent, opts...)
+		intents = append(intents, &models[index])
+	}
+
+	return intents, nil
+}
+
+// checkUsable returns an error if the intent cannot be used for the transfer anymore
+func (m *StablecoinTransferIntent) checkUsable() error {
+	switch m.Status {
+	case TransferIntentStatusConsumed:
+		return spverrors.ErrTransferIntentAlreadyConsumed
+	case TransferIntentStatusCanceled:
+		return spverrors.ErrTransferIntentCanceled
+	case TransferIntentStatusExpired:
+		return spverrors.ErrTransferIntentExpired
+	}
+
+	// intents created before the lifecycle was introduced have no expiration time, so they are treated as expired
+	if !time.Now().UTC().Before(m.ExpiresAt) {
+		return spverrors.ErrTransferIntentExpired
+	}
+
+	return nil
+}
+
+// markConsumed will mark the intent as used by the transfer with the given transaction ID
+func (m *StablecoinTransferIntent) markConsumed(txID string) {
+	m.Status = TransferIntentStatusConsumed
+	m.TxID = txID
+}
+
+// markExpired will mark the intent as expired
+func (m *StablecoinTransferIntent) markExpired() {
+	m.Status = TransferIntentStatusExpired
+}
+
+// markCanceled will mark the intent as canceled
+func (m *StablecoinTransferIntent) markCanceled() {
+	m.Status = TransferIntentStatusCanceled
+}
+
+// releaseDailyVolume gives back the volume reserved by the intent which has not been used by the transfer
+func (m *StablecoinTransferIntent) releaseDailyVolume(ctx context.Context) error {
+	return releaseIntentDailyVolume(ctx, m.Client(), m.SenderID, m.ReceiverID, m.StablecoinID, m.VolumeDay, m.Amount)
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStablecoinTransferIntent_checkUsable(t *testing.T) {
+	t.Parallel()
+
+	newIntent := func(t *testing.T, ttl time.Duration) *StablecoinTransferIntent {
+		sti, err := CreateStablecoinTransferIntent(&Intent{Nonce: "nonce"}, nil, nil, ttl)
+		require.NoError(t, err)
+		return sti
+	}
+
+	t.Run("pending intent before expiration is usable", func(t *testing.T) {
+		sti := newIntent(t, time.Minute)
+
+		assert.Equal(t, TransferIntentStatusPending, sti.Status)
+		require.NoError(t, sti.checkUsable())
+	})
+
+	t.Run("pending intent after expiration is rejected", func(t *testing.T) {
+		sti := newIntent(t, -time.Second)
+
+		require.ErrorIs(t, sti.checkUsable(), spverrors.ErrTransferIntentExpired)
+	})
+
+	t.Run("intent without expiration time is rejected", func(t *testing.T) {
+		sti := newIntent(t, time.Minute)
+		sti.ExpiresAt = time.Time{}
+
+		require.ErrorIs(t, sti.checkUsable(), spverrors.ErrTransferIntentExpired)
+	})
+
+	t.Run("consumed intent is rejected", func(t *testing.T) {
+		sti := newIntent(t, time.Minute)
+
+		sti.markConsumed("txID")
+
+		assert.Equal(t, "txID", sti.TxID)
+		require.ErrorIs(t, sti.checkUsable(), spverrors.ErrTransferIntentAlreadyConsumed)
+	})
+
+	t.Run("expired intent is rejected", func(t *testing.T) {
+		sti := newIntent(t, time.Minute)
+
+		sti.markExpired()
+
+		require.ErrorIs(t, sti.checkUsable(), spverrors.ErrTransferIntentExpired)
+	})
+
+	t.Run("canceled intent is rejected", func(t *testing.T) {
+		sti := newIntent(t, time.Minute)
+
+		sti.markCanceled()
+
+		require.ErrorIs(t, sti.checkUsable(), spverrors.ErrTransferIntentCanceled)
+	})
+}
@@ -0,0 +1,126 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	customTypes "github.com/bitcoin-sv/spv-wallet/engine/datastore/customtypes"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"gorm.io/gorm"
+)
+
+// TokenDivergenceKind tells how the token output of the wallet differs from the overlay state
+type TokenDivergenceKind string
+
+const (
+	// TokenDivergenceUnknown is when the overlay does not know the unspent token output of the wallet
+	TokenDivergenceUnknown TokenDivergenceKind = "unknown"
+	// TokenDivergenceInvalid is when the overlay considers the unspent token output of the wallet invalid
+	TokenDivergenceInvalid TokenDivergenceKind = "invalid"
+	// TokenDivergenceSpent is when the overlay considers the unspent token output of the wallet already spent
+	TokenDivergenceSpent TokenDivergenceKind = "spent"
+	// TokenDivergenceAmount is when the overlay holds a different amount for the token output
+	TokenDivergenceAmount TokenDivergenceKind = "amount"
+	// TokenDivergenceMissing is when the overlay holds the unspent token output of the holder which the wallet does not have
+	TokenDivergenceMissing TokenDivergenceKind = "missing"
+)
+
+// TokenDivergence flags the token output whose state in the wallet differs from the token overlay
+// There is at most one divergence per outpoint, it is resolved when the reconciliation finds the states matching again.
+//
+// Gorm related models & indexes: https://gorm.io/docs/models.html - https://gorm.io/docs/indexes.html
+type TokenDivergence struct {
+	// Base model
+	Model
+
+	// Model specific fields
+	ID           string               `json:"id" toml:"id" yaml:"id" gorm:"<-:create;type:char(64);primaryKey;comment:This is the hash of the outpoint"`
+	XpubID       string               `json:"xpub_id" toml:"xpub_id" yaml:"xpub_id" gorm:"<-:create;type:char(64);index;comment:This is the related xPub"`
+	StablecoinID string               `json:"stablecoin_id" toml:"stablecoin_id" yaml:"stablecoin_id" gorm:"<-:create;comment:This is the stablecoin identifier"`
+	Outpoint     string               `json:"outpoint" toml:"outpoint" yaml:"outpoint" gorm:"<-:create;comment:This is the outpoint of the token output"`
+	Kind         TokenDivergenceKind  `json:"kind" toml:"kind" yaml:"kind" gorm:"<-;type:varchar(10);comment:This is the kind of the divergence"`
+	Details      string               `json:"details,omitempty" toml:"details" yaml:"details" gorm:"<-;type:text;comment:This is the description of the divergence"`
+	ResolvedAt   customTypes.NullTime `json:"resolved_at" toml:"resolved_at" yaml:"resolved_at" gorm:"<-;index;comment:When the states matched again"`
+}
+
+// newTokenDivergence will create a new divergence of the token output
+func newTokenDivergence(xPubID, stablecoinID, outpoint string, opts ...ModelOps) *TokenDivergence {
+	return &TokenDivergence{
+		ID:           utils.Hash(outpoint),
+		XpubID:       xPubID,
+		StablecoinID: stablecoinID,
+		Outpoint:     outpoint,
+		Model:        *NewBaseModel(ModelTokenDivergence, opts...),
+	}
+}
+
+// getTokenDivergence will get the divergence of the token output (if any)
+func getTokenDivergence(ctx context.Context, outpoint string, opts ...ModelOps) (*TokenDivergence, error) {
+	divergence := &TokenDivergence{Model: *NewBaseModel(ModelTokenDivergence, opts...)}
+	conditions := map[string]interface{}{
+		idField: utils.Hash(outpoint),
+	}
+	if err := Get(ctx, divergence, conditions, false, defaultDatabaseReadTimeout, false); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return divergence, nil
+}
+
+// flag will (re)open the divergence with the given kind
+// It returns false when the same divergence was already open.
+func (m *TokenDivergence) flag(kind TokenDivergenceKind, details string) bool {
+	changed := !m.isOpen() || m.Kind != kind || m.Details != details
+
+	m.Kind = kind
+	m.Details = details
+	m.ResolvedAt.Valid = false
+	return changed
+}
+
+// resolve will close the divergence
+func (m *TokenDivergence) resolve() {
+	m.ResolvedAt.Valid = true
+	m.ResolvedAt.Time = time.Now().UTC()
+}
+
+func (m *TokenDivergence) isOpen() bool {
+	return m.Kind != "" && !m.ResolvedAt.Valid
+}
+
+// GetModelName will get the name of the current model
+func (m *TokenDivergence) GetModelName() string {
+	return ModelTokenDivergence.String()
+}
+
+// GetModelTableName will get the db table name of the current model
+func (m *TokenDivergence) GetModelTableName() string {
+	return tableTokenDivergences
+}
+
+// Save will save the model into the Datastore
+func (m *TokenDivergence) Save(ctx context.Context) error {
+	return Save(ctx, m)
+}
+
+// GetID will get the model ID
+func (m *TokenDivergence) GetID() string {
+	return m.ID
+}
+
+// BeforeCreating will fire before the model is being inserted into the Datastore
+func (m *TokenDivergence) BeforeCreating(_ context.Context) error {
+	return nil
+}
+
+// PostMigrate is called after the model is migrated
+func (m *TokenDivergence) PostMigrate(client datastore.ClientInterface) error {
+	err := client.IndexMetadata(client.GetTableName(tableTokenDivergences), metadataField)
+	return spverrors.Wrapf(err, "failed to index metadata column on model %s", m.GetModelName())
+}
@@ -37,6 +37,8 @@ type TransactionConfig struct {
 	Outputs                    []*TransactionOutput `json:"outputs" toml:"outputs" yaml:"outputs"`                         // All transaction outputs
 	SendAllTo                  *TransactionOutput   `json:"send_all_to,omitempty" toml:"send_all_to" yaml:"send_all_to"`   // Send ALL utxos to the output
 	Sync                       *SyncConfig          `json:"sync" toml:"sync" yaml:"sync"`                                  // Sync config for broadcasting and on-chain sync
+	// Send the stablecoin amount to the paymail, the banknotes are selected from the token utxos automatically
+	TokenTransfer *TokenTransfer `json:"token_transfer,omitempty" toml:"token_transfer" yaml:"token_transfer"`
 	// Future ideas:
 	// Conditions (utxo strategy, chain limit, split utxos)
 	// NlockTime uint32
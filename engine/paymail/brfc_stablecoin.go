@@ -0,0 +1,11 @@
+package paymail
+
+// BRFC IDs of the stablecoin transfer capabilities exposed by the paymail server.
+// The IDs are generated from the BRFC spec (title, author and version) with paymail.BRFCSpec.Generate().
+const (
+	// BRFCStablecoinTransferIntent is the capability validating the stablecoin transfer intent (title: "Stablecoin Transfer Intent", author: "spv-wallet", version: "1")
+	BRFCStablecoinTransferIntent = "2f2a781ab7ff"
+
+	// BRFCStablecoinTransfer is the capability receiving the stablecoin transfer (title: "Stablecoin Transfer", author: "spv-wallet", version: "1")
+	BRFCStablecoinTransfer = "7049d044a11f"
+)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/bitcoin-sv/go-paymail"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
@@ -36,8 +37,6 @@ func (strategy *internalIncomingTx) Execute(ctx context.Context, c ClientInterfa
 			return nil, spverrors.ErrTokenValidationFailed.Wrap(err)
 		}
 		logger.Info().Str("strategy", "internal incoming").Msg("Token transaction successfully VALIDATED")
-
-		c.StablecoinTransferService().NotifyGatewayAboutTransfer()
 	}
 
 	if err := broadcastTransaction(ctx, transaction); err != nil {
@@ -127,7 +126,8 @@ func buildStablecoinTransferMessage(t *Transaction) (*tokens.TransferRequest, er
 	}, nil
 }
 
-func _sendStablecoinTransfer(ctx context.Context, c ClientInterface, transfer Transfer, receiverDomain string) error {
+func _sendStablecoinTransfer(ctx context.Context, c ClientInterface, transfer Transfer, receiverPaymail string) error {
+	_, receiverDomain, _ := paymail.SanitizePaymail(receiverPaymail)
 	if c.GetPaymailConfig().IsAllowedDomain(receiverDomain) {
 		if _, err := c.StablecoinTransferService().IncomingTransfer(ctx, c, transfer); err != nil {
 			return spverrors.ErrTokenValidationFailed.Wrap(err)
@@ -136,7 +136,7 @@ func _sendStablecoinTransfer(ctx context.Context, c ClientInterface, transfer Tr
 		return nil
 	}
 
-	if err := c.StablecoinTransferService().SendTransfer(receiverDomain, transfer); err != nil {
+	if err := c.StablecoinTransferService().SendTransfer(ctx, c, receiverPaymail, transfer); err != nil {
 		return spverrors.ErrTokenValidationFailed.Wrap(err)
 	}
 
@@ -8,7 +8,9 @@ import (
 
 	"github.com/4chain-AG/gateway-overlay/pkg/token_engine/specifications"
 	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
 	trx "github.com/bsv-blockchain/go-sdk/transaction"
 )
 
@@ -58,9 +60,10 @@ func (strategy *outgoingTx) Execute(ctx context.Context, c ClientInterface, opts
 		return nil, spverrors.ErrInternal.Wrap(err)
 	}
 
-	if transaction.TxStatus == TxStatusBroadcasted {
+	if transaction.TxStatus == TxStatusBroadcasted || transaction.TxStatus == TxStatusHeld {
 		// no need to broadcast twice
-		// this also means that if the transaction contained the token - it was validated in overlay
+		// this also means that if the transaction contained the token - it was accepted by the receiver,
+		// the held transaction is broadcast once the overlay registers it
 		return transaction, nil
 	}
 
@@ -73,41 +76,45 @@ func (strategy *outgoingTx) Execute(ctx context.Context, c ClientInterface, opts
 			return nil, spverrors.ErrTokenValidationFailed.Wrap(err)
 		}
 
-		receiverDomain, err := _getPaymailDomainFromMetadata(transaction.Metadata)
+		receiverPaymail, err := _getTokenReceiverPaymail(transaction)
 		if err != nil {
-			logger.Error().Err(err).Str("strategy", "outgoing").Msg("Failed to get receiver domain from metadata")
+			logger.Error().Err(err).Str("strategy", "outgoing").Msg("Failed to get receiver paymail from metadata")
 			return nil, spverrors.ErrTokenValidationFailed.Wrap(err)
 		}
 
 		transfer := Transfer{
-			RefID: transaction.draftTransaction.RefID,
-			TxHex: transaction.Hex,
+			RefID:    transaction.draftTransaction.RefID,
+			TxHex:    transaction.Hex,
+			SenderID: _getTokenSenderPaymail(transaction),
 		}
 
-		operation, isSpecialOperation := transaction.Metadata[TransactionOperationKey]
-		if isSpecialOperation {
-			transfer.SpecialOperation = operation.(string)
-		}
+		tm, err := _sendStablecoinTransferToReceiver(ctx, c, transaction, transfer, receiverPaymail)
+
+		// the issue and redeem operations of the draft are finished regardless of the result
+		c.StablecoinOperationService().finishSentOperation(ctx, c, transaction.DraftID, transaction.ID, err)
 
-		err = _sendStablecoinTransfer(ctx, c, transfer, receiverDomain)
 		if err != nil {
 			logger.Error().Err(err).Str("strategy", "outgoing").Msg("Failed to send transfer")
 			return nil, spverrors.ErrTokenValidationFailed.Wrap(err)
 		}
+		logger.Info().Str("strategy", "outgoing").Msg("Token transaction ACCEPTED by the receiver")
 
-		tm, err := buildStablecoinTransferMessage(transaction)
-		if err != nil {
-			return nil, spverrors.ErrTokenValidationFailed.Wrap(err)
+		// the receiver has already accepted the transfer, so the failed gateway notification doesn't abort the recording
+		if err = c.StablecoinTransferService().NotifyGatewayAboutTransfer(
+			ctx, c, gateway.TransferOutgoing, transfer.RefID, strategy.SDKTx, tm.AssetID, transaction.draftTransaction.Configuration.Outputs,
+		); err != nil {
+			logger.Error().Err(err).Str("strategy", "outgoing").Str("txID", transaction.ID).Msg("Failed to enqueue gateway notification")
 		}
 
-		logger.Info().Str("strategy", "outgoing").Any("transfer-data", tm).Msg("")
-
-		err = c.Tokens().VerifyAndSaveTokenTransfer(ctx, tm)
-		// TODO: should we ignore the error and broadcast anyway if the receiver accepted?
-		if err != nil {
-			return nil, spverrors.ErrTokenValidationFailed.Wrap(err)
+		// the receiver has already accepted the transfer, so the failed overlay registration is retried instead of aborting the recording
+		if broadcast := c.StablecoinTransferService().RegisterTokenTransfer(ctx, c, transaction.XPubID, transaction.ID, tm); !broadcast {
+			logger.Warn().Str("txID", transaction.ID).Msg("Broadcast held until the overlay registers the token transaction")
+			transaction.TxStatus = TxStatusHeld
+			if err = transaction.Save(ctx); err != nil {
+				logger.Error().Str("txID", transaction.ID).Err(err).Msg("Held token transaction failed save to db")
+			}
+			return transaction, nil
 		}
-		logger.Info().Str("strategy", "outgoing").Msg("Token transaction successfully VALIDATED")
 	}
 
 	if err = broadcastTransaction(ctx, transaction); err != nil {
@@ -277,16 +284,53 @@ func _ensureTxHasDraft(tx *Transaction, draftTx *DraftTransaction, strategy *out
 	return nil
 }
 
-func _getPaymailDomainFromMetadata(metadata map[string]interface{}) (string, error) {
+// _sendStablecoinTransferToReceiver sends the transfer to the receiver and builds the transfer request for the overlay
+func _sendStablecoinTransferToReceiver(ctx context.Context, c ClientInterface, transaction *Transaction, transfer Transfer, receiverPaymail string) (*tokens.TransferRequest, error) {
+	logger := c.Logger()
+
+	if err := _sendStablecoinTransfer(ctx, c, transfer, receiverPaymail); err != nil {
+		return nil, err
+	}
+
+	tm, err := buildStablecoinTransferMessage(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("strategy", "outgoing").Any("transfer-data", tm).Msg("")
+
+	return tm, nil
+}
+
+// _getTokenReceiverPaymail returns the receiver of the token transfer,
+// the receiver of the automatically selected banknotes is known from the draft, otherwise it has to be given in the metadata
+func _getTokenReceiverPaymail(tx *Transaction) (string, error) {
+	if transfer := tx.draftTransaction.Configuration.TokenTransfer; transfer != nil {
+		return transfer.To, nil
+	}
+
+	return _getReceiverPaymailFromMetadata(tx.Metadata)
+}
+
+// _getTokenSenderPaymail returns the sender of the transfer intent of the draft, with a fallback to the sender from the metadata
+func _getTokenSenderPaymail(tx *Transaction) string {
+	if cfg := tx.draftTransaction.mapMetadata(); cfg != nil && cfg.SenderID != "" {
+		return cfg.SenderID
+	}
+
+	sender, _ := tx.Metadata["sender"].(string)
+	return sender
+}
+
+func _getReceiverPaymailFromMetadata(metadata map[string]interface{}) (string, error) {
 	receiverPaymail, ok := metadata["receiver"].(string)
 	if !ok || receiverPaymail == "" {
 		return "", spverrors.ErrTokenValidationFailed.Wrap(errors.New("receiver paymail is missing in transaction metadata"))
 	}
 
-	receiverDomain, err := paymail.SanitizeDomain(receiverPaymail)
-	if err != nil {
+	if err := paymail.ValidatePaymail(receiverPaymail); err != nil {
 		return "", spverrors.ErrTokenValidationFailed.Wrap(err)
 	}
 
-	return receiverDomain, nil
+	return receiverPaymail, nil
 }
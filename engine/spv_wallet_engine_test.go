@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	compat "github.com/bitcoin-sv/go-sdk/compat/bip32"
 	"github.com/bitcoin-sv/spv-wallet/config"
@@ -48,6 +49,25 @@ func (tc *TestingClient) Close(ctx context.Context) {
 	}
 }
 
+// testAppConfig will return the minimal application config required to load the new client
+func testAppConfig() *config.AppConfig {
+	return &config.AppConfig{
+		TokenOverlay: &config.TokenOverlayConfig{
+			URL: "http://localhost:3091",
+		},
+		Gateway: &config.GatewayConfig{
+			URL: "http://localhost:8090",
+		},
+		Stablecoin: &config.StablecoinConfig{
+			IntentTTL: 15 * time.Minute,
+		},
+		BHS: &config.BHSConfig{
+			ConfirmationDepth: 6,
+		},
+		ExperimentalFeatures: &config.ExperimentalConfig{},
+	}
+}
+
 // DefaultClientOpts will return a default set of client options required to load the new client
 func DefaultClientOpts() []ClientOps {
 	tqc := taskmanager.DefaultTaskQConfig(tester.RandomTablePrefix())
@@ -60,14 +80,7 @@ func DefaultClientOpts() []ClientOps {
 		WithTaskqConfig(tqc),
 		WithSQLite(tester.SQLiteTestConfig()),
 		WithCustomFeeUnit(mockFeeUnit),
-		WithAppConfig(&config.AppConfig{
-			TokenOverlay: &config.TokenOverlayConfig{
-				URL: "http://localhost:3091",
-			},
-			Gateway: &config.GatewayConfig{
-				URL: "http://localhost:8090",
-			},
-		}),
+		WithAppConfig(testAppConfig()),
 	)
 
 	return opts
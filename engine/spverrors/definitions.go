@@ -228,6 +228,9 @@ var ErrCapabilitiesPkiUnsupported = models.SPVError{Message: "server doesn't sup
 // ErrCapabilitiesPikeUnsupported is when PIKE is not supported for given paymail domain
 var ErrCapabilitiesPikeUnsupported = models.SPVError{Message: "server doesn't support PIKE", StatusCode: 400, Code: "error-capabilities-pike-unsupported"}
 
+// ErrCapabilitiesStablecoinTransferUnsupported is when stablecoin transfers are not supported for given paymail domain
+var ErrCapabilitiesStablecoinTransferUnsupported = models.SPVError{Message: "server doesn't support stablecoin transfers", StatusCode: 400, Code: "error-capabilities-stablecoin-transfer-unsupported"}
+
 // ErrGetCapabilities is when getting capabilities failed
 var ErrGetCapabilities = models.SPVError{Message: "failed to get paymail capabilities", StatusCode: 400, Code: "error-capabilities-failed-to-get"}
 
@@ -483,5 +486,88 @@ var ErrDataNotFound = models.SPVError{Message: "data not found", StatusCode: 404
 // ErrInvalidDataID is when data id is invalid
 var ErrInvalidDataID = models.SPVError{Message: "invalid data id", StatusCode: 400, Code: "error-invalid-data-id"}
 
+// ErrExportFormatInvalid is when the requested format of the history export is not supported
+var ErrExportFormatInvalid = models.SPVError{Message: "invalid export format, supported formats are csv and jsonl", StatusCode: 400, Code: "error-export-format-invalid"}
+
+// ErrExportTimeRangeInvalid is when the start of the time range of the history export is after its end
+var ErrExportTimeRangeInvalid = models.SPVError{Message: "invalid export time range, from cannot be after to", StatusCode: 400, Code: "error-export-time-range-invalid"}
+
+// ErrBalancePointAmbiguous is when both the time and the block height of the balance are given
+var ErrBalancePointAmbiguous = models.SPVError{Message: "only one of at and blockHeight can be given", StatusCode: 400, Code: "error-balance-point-ambiguous"}
+
+// ErrBalanceHistoryRangeInvalid is when the range of the daily balance history is malformed or too long
+var ErrBalanceHistoryRangeInvalid = models.SPVError{Message: "invalid balance history range, historyFrom is required, cannot be after historyTo and the range cannot exceed 366 days", StatusCode: 400, Code: "error-balance-history-range-invalid"}
+
+// ErrOperationNotFound is when operation of the user on the transaction cannot be found
+var ErrOperationNotFound = models.SPVError{Message: "operation not found", StatusCode: 404, Code: "error-operation-not-found"}
+
 // ErrInvalidTransferNoTransfer is when data id is invalid
 var ErrInvalidTransferNoTransfer = models.SPVError{Message: "invalid transfer data, no transfer output", StatusCode: 400, Code: "error-invalid-transfer-data"}
+
+// ////////////////////////////////// STABLECOIN ERRORS
+
+// ErrStablecoinRulesUnavailable is when stablecoin rules cannot be retrieved from the gateway
+var ErrStablecoinRulesUnavailable = models.SPVError{Message: "stablecoin rules are unavailable", StatusCode: 503, Code: "error-stablecoin-rules-unavailable"}
+
+// ErrTransferIntentNotFound is when the transfer references the intent which does not exist
+var ErrTransferIntentNotFound = models.SPVError{Message: "transfer intent not found", StatusCode: 404, Code: "error-transfer-intent-not-found"}
+
+// ErrTransferIntentExpired is when the transfer references the intent which has already expired
+var ErrTransferIntentExpired = models.SPVError{Message: "transfer intent has expired", StatusCode: 400, Code: "error-transfer-intent-expired"}
+
+// ErrTransferIntentCanceled is when the transfer references the intent which has been canceled
+var ErrTransferIntentCanceled = models.SPVError{Message: "transfer intent has been canceled", StatusCode: 400, Code: "error-transfer-intent-canceled"}
+
+// ErrTransferIntentAlreadyConsumed is when the transfer references the intent which has already been used by another transfer
+var ErrTransferIntentAlreadyConsumed = models.SPVError{Message: "transfer intent has already been consumed", StatusCode: 409, Code: "error-transfer-intent-already-consumed"}
+
+// ErrTransferIntentInProgress is when another transfer referencing the same intent is being processed right now
+var ErrTransferIntentInProgress = models.SPVError{Message: "transfer for this intent is already being processed", StatusCode: 409, Code: "error-transfer-intent-in-progress"}
+
+// ErrGatewayNotificationEnqueue is when the notification about the accepted transfer cannot be stored in the outbox
+var ErrGatewayNotificationEnqueue = models.SPVError{Message: "failed to enqueue the gateway notification about the transfer", StatusCode: 500, Code: "error-gateway-notification-enqueue"}
+
+// ErrTransferOutputsMismatch is when the transfer transaction outputs do not match the outputs of the intent
+var ErrTransferOutputsMismatch = models.SPVError{Message: "transfer outputs do not match the intent", StatusCode: 400, Code: "error-transfer-outputs-mismatch"}
+
+// ErrStablecoinReceiverMismatch is when the receiver of the transfer intent differs from the paymail address it was sent to
+var ErrStablecoinReceiverMismatch = models.SPVError{Message: "receiver of the transfer intent does not match the paymail address", StatusCode: 400, Code: "error-stablecoin-receiver-mismatch"}
+
+// ErrStablecoinReceiverRejected is when the receiver's paymail host does not accept the transfer intent or the transfer
+var ErrStablecoinReceiverRejected = models.SPVError{Message: "receiver paymail host rejected the stablecoin transfer", StatusCode: 400, Code: "error-stablecoin-receiver-rejected"}
+
+// ErrStablecoinSignatureMissing is when the transfer intent or the transfer is not signed while the sender validation is enabled
+var ErrStablecoinSignatureMissing = models.SPVError{Message: "stablecoin request is not signed by the sender", StatusCode: 401, Code: "error-stablecoin-signature-missing"}
+
+// ErrStablecoinSignatureInvalid is when the signature of the transfer intent or the transfer cannot be verified with the sender's PKI
+var ErrStablecoinSignatureInvalid = models.SPVError{Message: "invalid signature of the stablecoin request", StatusCode: 401, Code: "error-stablecoin-signature-invalid"}
+
+// ErrStablecoinSenderNotAllowed is when the sender policy rejects the transfer intent
+var ErrStablecoinSenderNotAllowed = models.SPVError{Message: "sender is not allowed to transfer the stablecoin", StatusCode: 403, Code: "error-stablecoin-sender-not-allowed"}
+
+// ErrStablecoinDailyLimitExceeded is when the transfer intent would exceed the daily volume limit of the sender or the receiver
+var ErrStablecoinDailyLimitExceeded = models.SPVError{Message: "daily stablecoin transfer limit exceeded", StatusCode: 403, Code: "error-stablecoin-daily-limit-exceeded"}
+
+// ErrStablecoinTokenTransferInvalid is when the token transfer of the draft transaction is incomplete or combined with other outputs
+var ErrStablecoinTokenTransferInvalid = models.SPVError{Message: "invalid token transfer, stablecoinId, amount and paymail receiver are required and no other outputs are allowed", StatusCode: 400, Code: "error-stablecoin-token-transfer-invalid"}
+
+// ErrStablecoinNotEnoughBanknotes is when the unreserved banknotes of the xPub do not cover the amount of the token transfer
+var ErrStablecoinNotEnoughBanknotes = models.SPVError{Message: "not enough banknotes of the stablecoin to cover the amount", StatusCode: 400, Code: "error-stablecoin-not-enough-banknotes"}
+
+// ErrStablecoinEmitterOnly is when the issue is not sent by the emitter or the redeem is not sent to the emitter of the stablecoin
+var ErrStablecoinEmitterOnly = models.SPVError{Message: "operation is allowed only together with the emitter of the stablecoin", StatusCode: 403, Code: "error-stablecoin-emitter-only"}
+
+// ErrStablecoinOperationInvalid is when the stablecoin operation of the transfer intent is not known
+var ErrStablecoinOperationInvalid = models.SPVError{Message: "invalid stablecoin operation", StatusCode: 400, Code: "error-stablecoin-operation-invalid"}
+
+// ErrStablecoinOperationNotFound is when the stablecoin operation cannot be found
+var ErrStablecoinOperationNotFound = models.SPVError{Message: "stablecoin operation not found", StatusCode: 404, Code: "error-stablecoin-operation-not-found"}
+
+// ErrStablecoinSenderPolicyUnavailable is when the external sender policy service cannot be asked for the decision
+var ErrStablecoinSenderPolicyUnavailable = models.SPVError{Message: "sender policy service is unavailable", StatusCode: 503, Code: "error-stablecoin-sender-policy-unavailable"}
+
+// ErrTokenOverlayUnavailable is when the token overlay cannot be queried
+var ErrTokenOverlayUnavailable = models.SPVError{Message: "token overlay is unavailable", StatusCode: 503, Code: "error-token-overlay-unavailable"}
+
+// ErrTokenOverlayNotFound is when the token overlay does not know the requested token output, holder or asset
+var ErrTokenOverlayNotFound = models.SPVError{Message: "token not found in the overlay", StatusCode: 404, Code: "error-token-overlay-not-found"}
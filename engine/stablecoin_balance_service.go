@@ -0,0 +1,267 @@
+package engine
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StablecoinBanknote is the unspent BSV-21 token output (banknote) owned by the xPub
+type StablecoinBanknote struct {
+	UtxoPointer
+
+	Serial       string    `json:"serial"`
+	Amount       uint64    `json:"amount"`
+	StablecoinID string    `json:"stablecoinId"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// StablecoinBalance is the sum of the unspent banknotes of the stablecoin owned by the xPub
+type StablecoinBalance struct {
+	StablecoinID string `json:"stablecoinId"`
+	Amount       uint64 `json:"amount"`
+	Banknotes    int    `json:"banknotes"`
+}
+
+// StablecoinBalanceService lists the stablecoin banknotes and balances of the xPubs
+// The banknotes are the unspent token utxos, parsed with the same inscription parser as the transfers,
+// and their stablecoin is read from the token metadata of the transactions in the same query.
+type StablecoinBalanceService struct {
+	log *zerolog.Logger
+}
+
+// NewStablecoinBalanceService creates a new instance of StablecoinBalanceService
+func NewStablecoinBalanceService(log *zerolog.Logger) *StablecoinBalanceService {
+	return &StablecoinBalanceService{
+		log: log,
+	}
+}
+
+// GetBanknotes returns the page of unspent banknotes of the xPub and the total number of the banknotes matching the filter
+// stablecoinID (optional) limits the banknotes to the given stablecoin
+func (s *StablecoinBalanceService) GetBanknotes(ctx context.Context, c ClientInterface, xPubID, stablecoinID string,
+	queryParams *datastore.QueryParams,
+) ([]*StablecoinBanknote, int64, error) {
+	query := s.unspentBanknotesQuery(ctx, c, xPubID, stablecoinID)
+
+	var count int64
+	if err := query.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, spverrors.Wrapf(err, "failed to count stablecoin banknotes")
+	}
+
+	banknotes, err := s.unspentBanknotes(c, query, queryParams)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return banknotes, count, nil
+}
+
+// GetBalances returns the balances of the stablecoins held by the xPub, sorted by stablecoin ID
+// stablecoinID (optional) limits the balances to the given stablecoin
+// The amounts are a part of the inscriptions, so they are summed up after parsing the banknotes.
+func (s *StablecoinBalanceService) GetBalances(ctx context.Context, c ClientInterface, xPubID, stablecoinID string) ([]*StablecoinBalance, error) {
+	banknotes, err := s.unspentBanknotes(c, s.unspentBanknotesQuery(ctx, c, xPubID, stablecoinID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]*StablecoinBalance)
+	for _, banknote := range banknotes {
+		balance, ok := balances[banknote.StablecoinID]
+		if !ok {
+			balance = &StablecoinBalance{StablecoinID: banknote.StablecoinID}
+			balances[banknote.StablecoinID] = balance
+		}
+		balance.Amount += banknote.Amount
+		balance.Banknotes++
+	}
+
+	result := make([]*StablecoinBalance, 0, len(balances))
+	for _, balance := range balances {
+		result = append(result, balance)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StablecoinID < result[j].StablecoinID
+	})
+
+	return result, nil
+}
+
+// banknoteRow is the token utxo together with the stablecoin ID from the token metadata of its transaction
+type banknoteRow struct {
+	Utxo
+	MetadataStablecoinID *string
+}
+
+// unspentBanknotesQuery selects the unspent token utxos of the xPub joined with the stablecoin ID from the metadata of their transactions
+// The banknotes without the token metadata are identified by their token ID (serial),
+// so they are matched by the hex of the stablecoin ID in the inscription of the locking script.
+func (s *StablecoinBalanceService) unspentBanknotesQuery(ctx context.Context, c ClientInterface, xPubID, stablecoinID string) *gorm.DB {
+	ds := c.Datastore()
+	utxos := ds.GetTableName(tableUTXOs)
+	transactions := ds.GetTableName(tableTransactions)
+
+	query := ds.DB().WithContext(ctx).
+		Table(utxos).
+		Joins(fmt.Sprintf("LEFT JOIN %s ON %s.id = %s.transaction_id", transactions, transactions, utxos)).
+		Where(fmt.Sprintf("%s.xpub_id = ? AND %s.type = ? AND %s.spending_tx_id IS NULL", utxos, utxos, utxos),
+			xPubID, utils.ScriptTypePubKeyHashInscription)
+
+	if stablecoinID != "" {
+		expr, args := metadataStablecoinIDExpr(ds.Engine(), utxos, transactions)
+		serialPattern := "%" + hex.EncodeToString([]byte(stablecoinID)) + "%"
+		query = query.Where(
+			fmt.Sprintf("(%s = ? OR (%s IS NULL AND %s.script_pub_key LIKE ?))", expr, expr, utxos),
+			slices.Concat(args, []any{stablecoinID}, args, []any{serialPattern})...,
+		)
+	}
+
+	return query
+}
+
+// metadataStablecoinIDExpr returns the SQL expression reading the stablecoin ID from the token metadata of the utxo's transaction,
+// the metadata of the utxo's xPub takes precedence over the metadata of the transaction
+func metadataStablecoinIDExpr(engine datastore.Engine, utxos, transactions string) (string, []any) {
+	if engine == datastore.PostgreSQL {
+		return fmt.Sprintf(
+			"COALESCE(%[2]s.xpub_metadata -> %[1]s.xpub_id -> ? ->> 'stablecoinId', %[2]s.metadata -> ? ->> 'stablecoinId')", utxos, transactions,
+		), []any{TransactionConfigKey, TransactionConfigKey}
+	}
+
+	return fmt.Sprintf(
+		`COALESCE(json_extract(%[2]s.xpub_metadata, '$."' || %[1]s.xpub_id || '".' || ?), json_extract(%[2]s.metadata, ?))`, utxos, transactions,
+	), []any{TransactionConfigKey + ".stablecoinId", "$." + TransactionConfigKey + ".stablecoinId"}
+}
+
+// unspentBanknotes reads the page of the banknotes selected by the query (all of them if the page is not set)
+// The inscriptions of the token utxos are parsed with the same parser as the transfers.
+func (s *StablecoinBalanceService) unspentBanknotes(c ClientInterface, query *gorm.DB,
+	queryParams *datastore.QueryParams,
+) ([]*StablecoinBanknote, error) {
+	ds := c.Datastore()
+	utxos := ds.GetTableName(tableUTXOs)
+	expr, args := metadataStablecoinIDExpr(ds.Engine(), utxos, ds.GetTableName(tableTransactions))
+	query = query.Select(fmt.Sprintf("%s.*, %s AS metadata_stablecoin_id", utxos, expr), args...)
+
+	if queryParams != nil {
+		if queryParams.OrderByField != "" {
+			query = query.Order(clause.OrderByColumn{
+				Column: clause.Column{Table: utxos, Name: queryParams.OrderByField},
+				Desc:   strings.ToLower(queryParams.SortDirection) == datastore.SortDesc,
+			})
+		}
+		if queryParams.Page > 0 && queryParams.PageSize > 0 {
+			query = query.Limit(queryParams.PageSize).Offset((queryParams.Page - 1) * queryParams.PageSize)
+		}
+	}
+
+	var rows []*banknoteRow
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get stablecoin banknotes")
+	}
+
+	banknotes := make([]*StablecoinBanknote, 0, len(rows))
+	for _, row := range rows {
+		banknote, err := parseBanknote(&row.Utxo, lo.FromPtr(row.MetadataStablecoinID))
+		if err != nil {
+			s.log.Warn().Err(err).Str("utxoID", row.ID).Msg("Skipping token utxo which cannot be parsed")
+			continue
+		}
+		banknotes = append(banknotes, banknote)
+	}
+
+	return banknotes, nil
+}
+
+// banknoteFromUtxo parses the BSV-21 inscription of the utxo
+// stablecoins caches the stablecoin IDs of the already resolved transactions
+func (s *StablecoinBalanceService) banknoteFromUtxo(ctx context.Context, c ClientInterface, utxo *Utxo, stablecoins map[string]string) (*StablecoinBanknote, error) {
+	stablecoinID, ok := stablecoins[utxo.TransactionID]
+	if !ok {
+		stablecoinID = s.stablecoinIDOfTransaction(ctx, c, utxo.XpubID, utxo.TransactionID)
+		stablecoins[utxo.TransactionID] = stablecoinID
+	}
+
+	return parseBanknote(utxo, stablecoinID)
+}
+
+// parseBanknote parses the BSV-21 inscription of the token utxo of the given stablecoin
+func parseBanknote(utxo *Utxo, stablecoinID string) (*StablecoinBanknote, error) {
+	lockingScript, err := script.NewFromHex(utxo.ScriptPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOperation, err := tokens.ParseTokenScript(lockingScript)
+	if err != nil {
+		return nil, err
+	}
+
+	serial := string(tokenOperation.ID)
+	if stablecoinID == "" {
+		// without the transfer metadata, the token ID is the best known identifier of the stablecoin
+		stablecoinID = serial
+	}
+
+	return &StablecoinBanknote{
+		UtxoPointer:  utxo.UtxoPointer,
+		Serial:       serial,
+		Amount:       tokenOperation.Amount,
+		StablecoinID: stablecoinID,
+		CreatedAt:    utxo.CreatedAt,
+	}, nil
+}
+
+// stablecoinIDOfTransaction reads the stablecoin ID from the token metadata stored with the transaction
+func (s *StablecoinBalanceService) stablecoinIDOfTransaction(ctx context.Context, c ClientInterface, xPubID, txID string) string {
+	transaction, err := getTransactionByID(ctx, "", txID, c.DefaultModelOptions()...)
+	if err != nil || transaction == nil {
+		s.log.Warn().Err(err).Str("txID", txID).Msg("Cannot find transaction of the token utxo")
+		return ""
+	}
+
+	if cfg := tokenTransactionConfigFromMetadata(transaction.XpubMetadata[xPubID]); cfg != nil && cfg.StablecoinID != "" {
+		return cfg.StablecoinID
+	}
+	if cfg := tokenTransactionConfigFromMetadata(transaction.Metadata); cfg != nil {
+		return cfg.StablecoinID
+	}
+
+	return ""
+}
+
+// tokenTransactionConfigFromMetadata returns the token transaction config stored in the metadata (if any)
+func tokenTransactionConfigFromMetadata(metadata Metadata) *tokenTransactionConfig {
+	value, ok := metadata[TransactionConfigKey]
+	if !ok {
+		return nil
+	}
+
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var cfg tokenTransactionConfig
+	if err = json.Unmarshal(jsonBytes, &cfg); err != nil {
+		return nil
+	}
+
+	return &cfg
+}
@@ -0,0 +1,178 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/4chain-AG/gateway-overlay/pkg/token_engine/bsv21"
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBanknoteSerial      = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_1"
+	testOtherBanknoteSerial = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_2"
+	testOtherStablecoinID   = "a0f5c6f4a3a2b7b3e5d3b6a1c1e9b5f3e8d2c1b0a9f8e7d6c5b4a3f2e1d0c9b8_0"
+)
+
+// testTokenLockingScript creates the P2PKH locking script with the BSV-21 inscription of the banknote
+func testTokenLockingScript(t *testing.T, serial string, amount uint64) string {
+	s, err := bsv21.NewBsv21Transfer(bsv21.TokenID(serial), amount)
+	require.NoError(t, err)
+	return testLockingScript + s.String()
+}
+
+func TestStablecoinBalanceService(t *testing.T) {
+	// given banknotes of testStablecoinID (with token metadata), testOtherStablecoinID (without any transaction) and a spent one
+	setup := func(t *testing.T) (context.Context, ClientInterface) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, false, withTaskManagerMockup())
+		t.Cleanup(deferMe)
+
+		transaction, err := txFromHex(testTxHex, append(client.DefaultModelOptions(), New(), WithMetadatas(map[string]interface{}{
+			TransactionConfigKey: tokenTransactionConfig{StablecoinID: testStablecoinID},
+		}))...)
+		require.NoError(t, err)
+		require.NoError(t, transaction.Save(ctx))
+
+		utxos := []*Utxo{
+			newUtxo(testXPubID, transaction.ID, testTokenLockingScript(t, testBanknoteSerial, 100), 0, 1, append(client.DefaultModelOptions(), New())...),
+			newUtxo(testXPubID, transaction.ID, testTokenLockingScript(t, testOtherBanknoteSerial, 50), 1, 1, append(client.DefaultModelOptions(), New())...),
+			newUtxo(testXPubID, testTxID2, testTokenLockingScript(t, testOtherStablecoinID, 7), 0, 1, append(client.DefaultModelOptions(), New())...),
+			newUtxo(testXPubID, testTxID2, testLockingScript, 1, 1000, append(client.DefaultModelOptions(), New())...),
+		}
+		spent := newUtxo(testXPubID, testTxID2, testTokenLockingScript(t, testOtherStablecoinID, 1000), 2, 1, append(client.DefaultModelOptions(), New())...)
+		spent.SpendingTxID.Valid = true
+		spent.SpendingTxID.String = testTxID
+		utxos = append(utxos, spent)
+
+		for _, utxo := range utxos {
+			require.NoError(t, utxo.Save(ctx))
+		}
+
+		return ctx, client
+	}
+
+	t.Run("list unspent banknotes", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+
+		// when:
+		banknotes, count, err := client.StablecoinBalanceService().GetBanknotes(ctx, client, testXPubID, "", nil)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+		require.Len(t, banknotes, 3)
+
+		bySerial := make(map[string]*StablecoinBanknote)
+		for _, banknote := range banknotes {
+			bySerial[banknote.Serial] = banknote
+		}
+		assert.Equal(t, testStablecoinID, bySerial[testBanknoteSerial].StablecoinID)
+		assert.Equal(t, uint64(100), bySerial[testBanknoteSerial].Amount)
+		assert.Equal(t, testStablecoinID, bySerial[testOtherBanknoteSerial].StablecoinID)
+		assert.Equal(t, uint32(1), bySerial[testOtherBanknoteSerial].OutputIndex)
+		assert.Equal(t, testOtherStablecoinID, bySerial[testOtherStablecoinID].StablecoinID)
+		assert.Equal(t, testTxID2, bySerial[testOtherStablecoinID].TransactionID)
+	})
+
+	t.Run("filter banknotes by stablecoin and page them", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+		queryParams := &datastore.QueryParams{Page: 2, PageSize: 1, OrderByField: "output_index", SortDirection: datastore.SortAsc}
+
+		// when:
+		banknotes, count, err := client.StablecoinBalanceService().GetBanknotes(ctx, client, testXPubID, testStablecoinID, queryParams)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		require.Len(t, banknotes, 1)
+		assert.Equal(t, testOtherBanknoteSerial, banknotes[0].Serial)
+	})
+
+	t.Run("filter banknotes without token metadata by their token ID", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+
+		// when:
+		banknotes, count, err := client.StablecoinBalanceService().GetBanknotes(ctx, client, testXPubID, testOtherStablecoinID, nil)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+		require.Len(t, banknotes, 1)
+		assert.Equal(t, testOtherStablecoinID, banknotes[0].StablecoinID)
+		assert.Equal(t, uint64(7), banknotes[0].Amount)
+	})
+
+	t.Run("read stablecoin of banknote from token metadata of the xpub", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+
+		transaction, err := txFromHex(testTx2Hex, append(client.DefaultModelOptions(), New(), WithMetadatas(map[string]interface{}{
+			TransactionConfigKey: tokenTransactionConfig{StablecoinID: testStablecoinID},
+		}))...)
+		require.NoError(t, err)
+		transaction.XpubMetadata = XpubMetadata{testXPubID: Metadata{
+			TransactionConfigKey: tokenTransactionConfig{StablecoinID: testOtherStablecoinID},
+		}}
+		require.NoError(t, transaction.Save(ctx))
+
+		utxo := newUtxo(testXPubID, transaction.ID, testTokenLockingScript(t, testBanknoteSerial, 3), 3, 1, append(client.DefaultModelOptions(), New())...)
+		require.NoError(t, utxo.Save(ctx))
+
+		// when:
+		banknotes, count, err := client.StablecoinBalanceService().GetBanknotes(ctx, client, testXPubID, testOtherStablecoinID, nil)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		require.Len(t, banknotes, 2)
+		for _, banknote := range banknotes {
+			assert.Equal(t, testOtherStablecoinID, banknote.StablecoinID)
+		}
+	})
+
+	t.Run("aggregate balances per stablecoin", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+
+		// when:
+		balances, err := client.StablecoinBalanceService().GetBalances(ctx, client, testXPubID, "")
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, []*StablecoinBalance{
+			{StablecoinID: testStablecoinID, Amount: 150, Banknotes: 2},
+			{StablecoinID: testOtherStablecoinID, Amount: 7, Banknotes: 1},
+		}, balances)
+	})
+
+	t.Run("aggregate balance of the given stablecoin", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+
+		// when:
+		balances, err := client.StablecoinBalanceService().GetBalances(ctx, client, testXPubID, testStablecoinID)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, []*StablecoinBalance{
+			{StablecoinID: testStablecoinID, Amount: 150, Banknotes: 2},
+		}, balances)
+	})
+
+	t.Run("return no balances for other xpub", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t)
+
+		// when:
+		balances, err := client.StablecoinBalanceService().GetBalances(ctx, client, "other-xpub-id", "")
+
+		// then:
+		require.NoError(t, err)
+		assert.Empty(t, balances)
+	})
+}
@@ -0,0 +1,183 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"github.com/rs/zerolog"
+)
+
+// StablecoinOperationService creates and tracks the issue and redeem operations of the stablecoins
+// Both are the token transfers negotiated with the intent, the receiver checks them against the emitter from the gateway rules.
+type StablecoinOperationService struct {
+	log *zerolog.Logger
+}
+
+// NewStablecoinOperationService creates a new instance of StablecoinOperationService
+func NewStablecoinOperationService(log *zerolog.Logger) *StablecoinOperationService {
+	return &StablecoinOperationService{
+		log: log,
+	}
+}
+
+// Issue creates the draft transaction sending the stablecoin from the emitter to the receiver
+// It is allowed only for the xPub owning the paymail of the emitter.
+func (s *StablecoinOperationService) Issue(ctx context.Context, c ClientInterface, rawXpubKey, stablecoinID string, amount uint64, to string,
+	opts ...ModelOps,
+) (*StablecoinOperation, *DraftTransaction, error) {
+	rules, err := c.GatewayClient().GetStablecoinRules(ctx, stablecoinID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paymails, err := s.paymailsOfXpub(ctx, c, utils.Hash(rawXpubKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !containsPaymail(paymails, rules.EmitterID) {
+		s.log.Warn().Str("stablecoinID", stablecoinID).Str("emitterID", rules.EmitterID).Msg("Issue requested by other xPub than the emitter")
+		return nil, nil, spverrors.ErrStablecoinEmitterOnly
+	}
+
+	return s.newOperation(ctx, c, rawXpubKey, rules.EmitterID, &TokenTransfer{
+		StablecoinID: stablecoinID,
+		Amount:       amount,
+		To:           to,
+		Operation:    StablecoinOperationIssue,
+	}, opts...)
+}
+
+// Redeem creates the draft transaction sending the stablecoin of the xPub back to the emitter
+func (s *StablecoinOperationService) Redeem(ctx context.Context, c ClientInterface, rawXpubKey, stablecoinID string, amount uint64,
+	opts ...ModelOps,
+) (*StablecoinOperation, *DraftTransaction, error) {
+	rules, err := c.GatewayClient().GetStablecoinRules(ctx, stablecoinID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paymails, err := s.paymailsOfXpub(ctx, c, utils.Hash(rawXpubKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(paymails) == 0 {
+		return nil, nil, spverrors.ErrCouldNotFindPaymail
+	}
+
+	return s.newOperation(ctx, c, rawXpubKey, paymails[0], &TokenTransfer{
+		StablecoinID: stablecoinID,
+		Amount:       amount,
+		To:           rules.EmitterID,
+		Operation:    StablecoinOperationRedeem,
+	}, opts...)
+}
+
+// GetOperation returns the stablecoin operation of the xPub
+func (s *StablecoinOperationService) GetOperation(ctx context.Context, c ClientInterface, xPubID, id string) (*StablecoinOperation, error) {
+	operation, err := getStablecoinOperationByID(ctx, xPubID, id, c.DefaultModelOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	if operation == nil {
+		return nil, spverrors.ErrStablecoinOperationNotFound
+	}
+
+	return operation, nil
+}
+
+func (s *StablecoinOperationService) newOperation(ctx context.Context, c ClientInterface, rawXpubKey, senderID string, transfer *TokenTransfer,
+	opts ...ModelOps,
+) (*StablecoinOperation, *DraftTransaction, error) {
+	draft, err := c.NewTransaction(ctx, rawXpubKey, &TransactionConfig{TokenTransfer: transfer},
+		append(opts, WithMetadatas(map[string]interface{}{"sender": senderID}))...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	operation := newStablecoinOperation(draft.ID, draft.XpubID, senderID, transfer, c.DefaultModelOptions(New())...)
+	if err = operation.Save(ctx); err != nil {
+		return nil, nil, spverrors.Wrapf(err, "failed to save stablecoin operation")
+	}
+
+	s.log.Info().Str("operationID", operation.ID).Str("operation", string(operation.Type)).Str("stablecoinID", operation.StablecoinID).
+		Uint64("amount", operation.Amount).Msg("Stablecoin operation created")
+
+	return operation, draft, nil
+}
+
+// finishSentOperation updates the operation of the draft transaction after its transfer has been sent to the receiver
+// The drafts of the regular transfers have no operation, so nothing is updated for them.
+func (s *StablecoinOperationService) finishSentOperation(ctx context.Context, c ClientInterface, draftID, txID string, cause error) {
+	log := s.log.With().Str("operationID", draftID).Str("txID", txID).Logger()
+
+	operation, err := getStablecoinOperationByID(ctx, "", draftID, c.DefaultModelOptions()...)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get stablecoin operation")
+		return
+	}
+	if operation == nil {
+		return
+	}
+
+	if cause != nil {
+		operation.markFailed(txID, cause)
+	} else {
+		operation.markCompleted(txID)
+	}
+
+	if err = operation.Save(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to save stablecoin operation")
+		return
+	}
+
+	log.Info().Str("status", string(operation.Status)).Msg("Stablecoin operation finished")
+}
+
+// recordReceivedOperation stores the completed operation of the accepted transfer for the xPub of the receiver
+func (s *StablecoinOperationService) recordReceivedOperation(ctx context.Context, c ClientInterface, sti *StablecoinTransferIntent) {
+	log := s.log.With().Str("refID", sti.ID).Str("txID", sti.TxID).Logger()
+
+	receiver, err := getPaymailAddress(ctx, sti.ReceiverID, c.DefaultModelOptions()...)
+	if err != nil || receiver == nil {
+		log.Error().Err(err).Str("receiverID", sti.ReceiverID).Msg("Failed to find the receiver of the stablecoin operation")
+		return
+	}
+
+	operation := newStablecoinOperation(sti.ID, receiver.XpubID, sti.SenderID, &TokenTransfer{
+		StablecoinID: sti.StablecoinID,
+		Amount:       sti.Amount,
+		To:           sti.ReceiverID,
+		Operation:    sti.Operation,
+	}, c.DefaultModelOptions(New())...)
+	operation.markCompleted(sti.TxID)
+
+	if err = operation.Save(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to save received stablecoin operation")
+	}
+}
+
+func (s *StablecoinOperationService) paymailsOfXpub(ctx context.Context, c ClientInterface, xPubID string) ([]string, error) {
+	addresses, err := c.GetPaymailAddressesByXPubID(ctx, xPubID, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	paymails := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		paymails = append(paymails, fmt.Sprintf("%s@%s", address.Alias, address.Domain))
+	}
+	return paymails, nil
+}
+
+func containsPaymail(paymails []string, address string) bool {
+	for _, p := range paymails {
+		if strings.EqualFold(p, address) {
+			return true
+		}
+	}
+	return false
+}
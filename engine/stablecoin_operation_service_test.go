@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStablecoinEmitter = "emitter@tester.com"
+
+// newStablecoinOperationTestClient creates the client with the gateway responding with the rules of the testStablecoinEmitter
+func newStablecoinOperationTestClient(t *testing.T) (context.Context, ClientInterface) {
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(http.MethodGet, "http://localhost:8090/coins/bsv21/rules",
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, gateway.StablecoinRule{
+			CoinSym:   "USDC",
+			TokenId:   testStablecoinID,
+			EmitterID: testStablecoinEmitter,
+		}),
+	)
+
+	httpClient := resty.New()
+	httpClient.SetTransport(mockTransport)
+
+	ctx, client, deferMe := CreateTestSQLiteClient(t, false, false, withTaskManagerMockup(), WithHTTPClient(httpClient))
+	t.Cleanup(deferMe)
+
+	_, err := client.NewXpub(ctx, testXPub, client.DefaultModelOptions()...)
+	require.NoError(t, err)
+
+	return ctx, client
+}
+
+func TestStablecoinOperationService_Issue(t *testing.T) {
+	t.Run("reject the issue of other xPub than the emitter", func(t *testing.T) {
+		// given:
+		ctx, client := newStablecoinOperationTestClient(t)
+		_, err := client.NewPaymailAddress(ctx, testXPub, testPaymail, testPublicName, testAvatar, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+
+		// when:
+		operation, draft, err := client.StablecoinOperationService().Issue(ctx, client, testXPub, testStablecoinID, 100, "bob@"+testStablecoinReceiverDomain)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinEmitterOnly)
+		assert.Nil(t, operation)
+		assert.Nil(t, draft)
+	})
+}
+
+func TestStablecoinOperationService_Redeem(t *testing.T) {
+	t.Run("reject the redeem of xPub without paymail", func(t *testing.T) {
+		// given:
+		ctx, client := newStablecoinOperationTestClient(t)
+
+		// when:
+		operation, draft, err := client.StablecoinOperationService().Redeem(ctx, client, testXPub, testStablecoinID, 100)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrCouldNotFindPaymail)
+		assert.Nil(t, operation)
+		assert.Nil(t, draft)
+	})
+}
+
+func TestStablecoinOperationService_finishSentOperation(t *testing.T) {
+	transfer := &TokenTransfer{
+		StablecoinID: testStablecoinID,
+		Amount:       100,
+		To:           testStablecoinEmitter,
+		Operation:    StablecoinOperationRedeem,
+	}
+
+	t.Run("complete the operation of the accepted transfer", func(t *testing.T) {
+		// given:
+		ctx, client := newStablecoinOperationTestClient(t)
+		require.NoError(t, newStablecoinOperation(testDraftID, testXPubID, testPaymail, transfer, append(client.DefaultModelOptions(), New())...).Save(ctx))
+
+		// when:
+		client.StablecoinOperationService().finishSentOperation(ctx, client, testDraftID, testTxID, nil)
+
+		// then:
+		operation, err := client.StablecoinOperationService().GetOperation(ctx, client, testXPubID, testDraftID)
+		require.NoError(t, err)
+		assert.Equal(t, StablecoinOperationCompleted, operation.Status)
+		assert.Equal(t, testTxID, operation.TxID)
+		assert.Empty(t, operation.Error)
+	})
+
+	t.Run("fail the operation of the rejected transfer", func(t *testing.T) {
+		// given:
+		ctx, client := newStablecoinOperationTestClient(t)
+		require.NoError(t, newStablecoinOperation(testDraftID, testXPubID, testPaymail, transfer, append(client.DefaultModelOptions(), New())...).Save(ctx))
+
+		// when:
+		client.StablecoinOperationService().finishSentOperation(ctx, client, testDraftID, testTxID, errors.New("transfer rejected"))
+
+		// then:
+		operation, err := client.StablecoinOperationService().GetOperation(ctx, client, testXPubID, testDraftID)
+		require.NoError(t, err)
+		assert.Equal(t, StablecoinOperationFailed, operation.Status)
+		assert.Equal(t, "transfer rejected", operation.Error)
+	})
+
+	t.Run("return not found for the operation of other xPub", func(t *testing.T) {
+		// given:
+		ctx, client := newStablecoinOperationTestClient(t)
+		require.NoError(t, newStablecoinOperation(testDraftID, testXPubID, testPaymail, transfer, append(client.DefaultModelOptions(), New())...).Save(ctx))
+
+		// when:
+		operation, err := client.StablecoinOperationService().GetOperation(ctx, client, testXPubID+"0", testDraftID)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinOperationNotFound)
+		assert.Nil(t, operation)
+	})
+}
+
+func TestValidateOperation(t *testing.T) {
+	rules := &gateway.StablecoinRule{EmitterID: testStablecoinEmitter}
+
+	tests := map[string]struct {
+		intent *Intent
+		err    error
+	}{
+		"issue sent by the emitter": {
+			intent: &Intent{Operation: StablecoinOperationIssue, SenderID: testStablecoinEmitter, ReceiverID: testPaymail},
+		},
+		"issue sent by other paymail": {
+			intent: &Intent{Operation: StablecoinOperationIssue, SenderID: testPaymail, ReceiverID: testPaymail},
+			err:    spverrors.ErrStablecoinEmitterOnly,
+		},
+		"redeem sent to the emitter": {
+			intent: &Intent{Operation: StablecoinOperationRedeem, SenderID: testPaymail, ReceiverID: testStablecoinEmitter},
+		},
+		"redeem sent to other paymail": {
+			intent: &Intent{Operation: StablecoinOperationRedeem, SenderID: testPaymail, ReceiverID: testPaymail},
+			err:    spverrors.ErrStablecoinEmitterOnly,
+		},
+		"unknown operation": {
+			intent: &Intent{Operation: "mint", SenderID: testStablecoinEmitter, ReceiverID: testPaymail},
+			err:    spverrors.ErrStablecoinOperationInvalid,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateOperation(test.intent, rules)
+
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
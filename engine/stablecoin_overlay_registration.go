@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+)
+
+// RegisterTokenTransfer registers the token transaction accepted by the receiver in the overlay
+// When the overlay does not confirm it, the registration is persisted and retried by the cron job.
+// It returns whether the transaction can be broadcast now, which depends on the overlay broadcast policy.
+// If the registration cannot be persisted, nothing would release the held transaction, so it is broadcast anyway.
+func (s *StablecoinTransferService) RegisterTokenTransfer(ctx context.Context, c ClientInterface, xPubID, txID string, request *tokens.TransferRequest) bool {
+	log := s.log.With().Str("txID", txID).Logger()
+
+	cause := c.Tokens().VerifyAndSaveTokenTransfer(ctx, request)
+	if cause == nil {
+		return true
+	}
+
+	holdBroadcast := s.broadcastPolicy == config.OverlayBroadcastHold
+	registration := newOverlayRegistration(xPubID, txID, request, holdBroadcast, c.DefaultModelOptions(New())...)
+	registration.markFailed(cause)
+
+	existing, err := getOverlayRegistrationByID(ctx, txID, c.DefaultModelOptions()...)
+	if err != nil {
+		log.Error().Err(cause).AnErr("persistenceError", err).Msg("Failed to check if the overlay registration already exists, broadcasting the token transaction")
+		return true
+	}
+	if existing == nil {
+		if err = registration.Save(ctx); err != nil {
+			log.Error().Err(cause).AnErr("persistenceError", err).Msg("Failed to save pending overlay registration, broadcasting the token transaction")
+			return true
+		}
+	}
+
+	log.Warn().Err(cause).Bool("holdBroadcast", holdBroadcast).Time("nextAttemptAt", registration.NextAttemptAt).
+		Msg("Overlay has not registered the token transaction, will retry")
+
+	return !holdBroadcast
+}
+
+// ProcessOverlayRegistrations retries the pending overlay registrations of the token transactions
+// The held transactions are broadcast once the overlay has confirmed them.
+func (s *StablecoinTransferService) ProcessOverlayRegistrations(ctx context.Context, c ClientInterface) error {
+	registrations, err := getOverlayRegistrationsToRetry(ctx, overlayRegistrationBatchSize, c.DefaultModelOptions()...)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get overlay registrations to retry")
+	}
+
+	for _, registration := range registrations {
+		log := s.log.With().Str("txID", registration.ID).Logger()
+
+		if err = c.Tokens().VerifyAndSaveTokenTransfer(ctx, registration.transferRequest()); err != nil {
+			registration.markFailed(err)
+			log.Warn().Err(err).Int("attempts", registration.Attempts).Time("nextAttemptAt", registration.NextAttemptAt).
+				Msg("Failed to register token transaction in overlay, will retry")
+		} else {
+			registration.markRegistered()
+			log.Info().Int("attempts", registration.Attempts).Msg("Token transaction registered in overlay")
+
+			if registration.HoldBroadcast {
+				s.broadcastHeldTransaction(ctx, c, registration.ID)
+			}
+		}
+
+		if err = registration.Save(ctx); err != nil {
+			log.Error().Err(err).Msg("Failed to save overlay registration")
+		}
+	}
+
+	return nil
+}
+
+// broadcastHeldTransaction broadcasts the transaction held until its overlay registration
+// The failed broadcast is left to the transactions sync, as for the transactions broadcast when recorded.
+func (s *StablecoinTransferService) broadcastHeldTransaction(ctx context.Context, c ClientInterface, txID string) {
+	log := s.log.With().Str("txID", txID).Logger()
+
+	transaction, err := getTransactionByID(ctx, "", txID, c.DefaultModelOptions()...)
+	if err != nil || transaction == nil {
+		log.Error().Err(err).Msg("Cannot find the held token transaction")
+		return
+	}
+	if transaction.TxStatus != TxStatusHeld {
+		return
+	}
+
+	if err = broadcastTransaction(ctx, transaction); err != nil {
+		log.Warn().Err(err).Msg("Broadcasting the held token transaction failed")
+		transaction.TxStatus = TxStatusCreated
+	} else {
+		transaction.TxStatus = TxStatusBroadcasted
+	}
+
+	if err = transaction.Save(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to save the held token transaction")
+	}
+}
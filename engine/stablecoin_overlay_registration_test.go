@@ -0,0 +1,187 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenOverlayMock registers the token transfers, failing while the err is set
+type tokenOverlayMock struct {
+	tokens.TokenOverlayClient
+	err      error
+	requests []*tokens.TransferRequest
+}
+
+func (m *tokenOverlayMock) VerifyAndSaveTokenTransfer(_ context.Context, request *tokens.TransferRequest) error {
+	m.requests = append(m.requests, request)
+	return m.err
+}
+
+func newOverlayRegistrationTestClient(t *testing.T, policy config.OverlayBroadcastPolicy, overlay *tokenOverlayMock) (context.Context, ClientInterface) {
+	cfg := testAppConfig()
+	cfg.TokenOverlay.BroadcastPolicy = policy
+
+	ctx, client, deferMe := CreateTestSQLiteClient(t, false, false,
+		withTaskManagerMockup(),
+		WithTokenOverlayClient(overlay),
+		WithAppConfig(cfg),
+	)
+	t.Cleanup(deferMe)
+
+	return ctx, client
+}
+
+func TestOverlayRegistrationBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, overlayRegistrationRetryDelay, overlayRegistrationBackoff(1))
+	assert.Equal(t, 2*overlayRegistrationRetryDelay, overlayRegistrationBackoff(2))
+	assert.Equal(t, overlayRegistrationMaxRetryDelay, overlayRegistrationBackoff(100))
+}
+
+func TestStablecoinTransferService_RegisterTokenTransfer(t *testing.T) {
+	request := &tokens.TransferRequest{Hex: testTxHex, SenderID: "alice@example.com", ReceiverID: "bob@example.com", AssetID: testStablecoinID}
+
+	t.Run("broadcast the transaction registered in the overlay", func(t *testing.T) {
+		// given:
+		overlay := &tokenOverlayMock{}
+		ctx, client := newOverlayRegistrationTestClient(t, config.OverlayBroadcastHold, overlay)
+
+		// when:
+		broadcast := client.StablecoinTransferService().RegisterTokenTransfer(ctx, client, testXPubID, testTxID, request)
+
+		// then:
+		assert.True(t, broadcast)
+		registration, err := getOverlayRegistrationByID(ctx, testTxID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Nil(t, registration)
+	})
+
+	t.Run("broadcast anyway and retry the failed registration", func(t *testing.T) {
+		// given:
+		overlay := &tokenOverlayMock{err: errors.New("overlay is down")}
+		ctx, client := newOverlayRegistrationTestClient(t, config.OverlayBroadcastAnyway, overlay)
+
+		// when:
+		broadcast := client.StablecoinTransferService().RegisterTokenTransfer(ctx, client, testXPubID, testTxID, request)
+
+		// then:
+		assert.True(t, broadcast)
+		registration, err := getOverlayRegistrationByID(ctx, testTxID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		require.NotNil(t, registration)
+		assert.Equal(t, OverlayRegistrationPending, registration.Status)
+		assert.Equal(t, 1, registration.Attempts)
+		assert.Equal(t, "overlay is down", registration.LastError)
+		assert.False(t, registration.HoldBroadcast)
+		assert.Equal(t, request, registration.transferRequest())
+	})
+
+	t.Run("hold the broadcast of the transaction with failed registration", func(t *testing.T) {
+		// given:
+		overlay := &tokenOverlayMock{err: errors.New("overlay is down")}
+		ctx, client := newOverlayRegistrationTestClient(t, config.OverlayBroadcastHold, overlay)
+
+		// when:
+		broadcast := client.StablecoinTransferService().RegisterTokenTransfer(ctx, client, testXPubID, testTxID, request)
+
+		// then:
+		assert.False(t, broadcast)
+		registration, err := getOverlayRegistrationByID(ctx, testTxID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		require.NotNil(t, registration)
+		assert.True(t, registration.HoldBroadcast)
+	})
+
+	t.Run("broadcast the transaction when the failed registration cannot be persisted", func(t *testing.T) {
+		// given:
+		overlay := &tokenOverlayMock{err: errors.New("overlay is down")}
+		ctx, client := newOverlayRegistrationTestClient(t, config.OverlayBroadcastHold, overlay)
+		require.NoError(t, client.Datastore().DB().Migrator().DropTable(&OverlayRegistration{}))
+
+		// when:
+		broadcast := client.StablecoinTransferService().RegisterTokenTransfer(ctx, client, testXPubID, testTxID, request)
+
+		// then:
+		assert.True(t, broadcast)
+	})
+}
+
+func TestStablecoinTransferService_ProcessOverlayRegistrations(t *testing.T) {
+	request := &tokens.TransferRequest{Hex: testTxHex, SenderID: "alice@example.com", ReceiverID: "bob@example.com", AssetID: testStablecoinID}
+
+	// given the registration due for the retry
+	seed := func(t *testing.T, ctx context.Context, client ClientInterface, holdBroadcast bool) {
+		registration := newOverlayRegistration(testXPubID, testTxID, request, holdBroadcast, append(client.DefaultModelOptions(), New())...)
+		registration.markFailed(errors.New("overlay is down"))
+		registration.NextAttemptAt = time.Now().UTC().Add(-time.Second)
+		require.NoError(t, registration.Save(ctx))
+	}
+
+	t.Run("keep retrying while the overlay fails", func(t *testing.T) {
+		// given:
+		overlay := &tokenOverlayMock{err: errors.New("still down")}
+		ctx, client := newOverlayRegistrationTestClient(t, config.OverlayBroadcastAnyway, overlay)
+		seed(t, ctx, client, false)
+
+		// when:
+		err := client.StablecoinTransferService().ProcessOverlayRegistrations(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		registration, err := getOverlayRegistrationByID(ctx, testTxID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, OverlayRegistrationPending, registration.Status)
+		assert.Equal(t, 2, registration.Attempts)
+		assert.Equal(t, "still down", registration.LastError)
+		assert.True(t, registration.NextAttemptAt.After(time.Now().UTC()))
+	})
+
+	t.Run("mark registered when the overlay confirms", func(t *testing.T) {
+		// given:
+		overlay := &tokenOverlayMock{}
+		ctx, client := newOverlayRegistrationTestClient(t, config.OverlayBroadcastAnyway, overlay)
+		seed(t, ctx, client, false)
+
+		// when:
+		err := client.StablecoinTransferService().ProcessOverlayRegistrations(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		require.Len(t, overlay.requests, 1)
+		assert.Equal(t, testStablecoinID, overlay.requests[0].AssetID)
+
+		registration, err := getOverlayRegistrationByID(ctx, testTxID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, OverlayRegistrationRegistered, registration.Status)
+		assert.NotNil(t, registration.RegisteredAt)
+	})
+
+	t.Run("release the held transaction when the overlay confirms", func(t *testing.T) {
+		// given:
+		overlay := &tokenOverlayMock{}
+		ctx, client := newOverlayRegistrationTestClient(t, config.OverlayBroadcastHold, overlay)
+		seed(t, ctx, client, true)
+
+		transaction, err := txFromHex(testTxHex, append(client.DefaultModelOptions(), New())...)
+		require.NoError(t, err)
+		transaction.TxStatus = TxStatusHeld
+		require.NoError(t, transaction.Save(ctx))
+
+		// when:
+		err = client.StablecoinTransferService().ProcessOverlayRegistrations(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		transaction, err = getTransactionByID(ctx, "", testTxID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.NotEqual(t, TxStatusHeld, transaction.TxStatus)
+	})
+}
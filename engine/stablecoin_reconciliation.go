@@ -0,0 +1,201 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/datastore"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"gorm.io/gorm"
+)
+
+const reconciliationPageSize = 100
+
+// ReconcileTokenUtxos compares the unspent token utxos of the wallet with the overlay state and flags the divergences
+// Every unspent token utxo is checked against the overlay, then the overlay utxos of the holders are checked against the wallet.
+// The run is stopped when the overlay is unavailable, so its outage is not flagged as divergences.
+// The divergences which cannot be saved don't stop the run, their errors are returned together at the end.
+func (s *StablecoinBalanceService) ReconcileTokenUtxos(ctx context.Context, c ClientInterface) error {
+	var saveErrs []error
+	walletOutpoints := make(map[string]bool)
+	holders := make(map[string]map[string]bool) // xPubID -> stablecoin IDs
+	stablecoins := make(map[string]string)
+
+	conditions := map[string]interface{}{
+		typeField:         utils.ScriptTypePubKeyHashInscription,
+		spendingTxIDField: nil,
+	}
+	for page := 1; ; page++ {
+		utxos, err := getUtxosByConditions(ctx, conditions, &datastore.QueryParams{
+			Page:          page,
+			PageSize:      reconciliationPageSize,
+			OrderByField:  idField,
+			SortDirection: datastore.SortAsc,
+		}, c.DefaultModelOptions()...)
+		if err != nil {
+			return errors.Join(append(saveErrs, err)...)
+		}
+
+		for _, utxo := range utxos {
+			banknote, err := s.banknoteFromUtxo(ctx, c, utxo, stablecoins)
+			if err != nil {
+				s.log.Warn().Err(err).Str("utxoID", utxo.ID).Msg("Skipping token utxo which cannot be parsed")
+				continue
+			}
+
+			outpoint := tokens.Outpoint(utxo.TransactionID, utxo.OutputIndex)
+			walletOutpoints[outpoint] = true
+			if holders[utxo.XpubID] == nil {
+				holders[utxo.XpubID] = make(map[string]bool)
+			}
+			holders[utxo.XpubID][banknote.StablecoinID] = true
+
+			kind, details, err := s.compareWithOverlay(ctx, c, banknote, outpoint)
+			if err != nil {
+				return errors.Join(append(saveErrs, err)...)
+			}
+			if err = s.updateDivergence(ctx, c, utxo.XpubID, banknote.StablecoinID, outpoint, kind, details); err != nil {
+				saveErrs = append(saveErrs, err)
+			}
+		}
+
+		if len(utxos) < reconciliationPageSize {
+			break
+		}
+	}
+
+	return errors.Join(append(saveErrs, s.reconcileHolders(ctx, c, holders, walletOutpoints))...)
+}
+
+// compareWithOverlay returns the kind of the divergence of the wallet banknote, empty when the overlay state matches
+func (s *StablecoinBalanceService) compareWithOverlay(ctx context.Context, c ClientInterface, banknote *StablecoinBanknote, outpoint string,
+) (TokenDivergenceKind, string, error) {
+	state, err := c.Tokens().GetOutpointState(ctx, banknote.StablecoinID, outpoint)
+	if errors.Is(err, spverrors.ErrTokenOverlayNotFound) {
+		return TokenDivergenceUnknown, "token output is not known to the overlay", nil
+	} else if err != nil {
+		return "", "", err
+	}
+
+	switch {
+	case !state.Valid:
+		return TokenDivergenceInvalid, "token output is invalid in the overlay", nil
+	case state.Spent:
+		return TokenDivergenceSpent, fmt.Sprintf("token output is spent by %s in the overlay", state.SpendingTxID), nil
+	case state.Amount != banknote.Amount:
+		return TokenDivergenceAmount, fmt.Sprintf("overlay amount %d differs from wallet amount %d", state.Amount, banknote.Amount), nil
+	}
+	return "", "", nil
+}
+
+// reconcileHolders flags the overlay utxos of the holders which are missing in the wallet
+// The missing divergences which are not found again are resolved when the wallet has the utxo
+// or the overlay confirms it doesn't hold the utxo anymore.
+func (s *StablecoinBalanceService) reconcileHolders(ctx context.Context, c ClientInterface, holders map[string]map[string]bool,
+	walletOutpoints map[string]bool,
+) error {
+	var saveErrs []error
+	missing := make(map[string]bool)
+	for xPubID, holderStablecoins := range holders {
+		addresses, err := c.GetPaymailAddressesByXPubID(ctx, xPubID, nil, nil, nil)
+		if err != nil {
+			return errors.Join(append(saveErrs, err)...)
+		}
+
+		for _, address := range addresses {
+			holderID := fmt.Sprintf("%s@%s", address.Alias, address.Domain)
+			for stablecoinID := range holderStablecoins {
+				overlayUtxos, err := c.Tokens().GetHolderUtxos(ctx, stablecoinID, holderID)
+				if errors.Is(err, spverrors.ErrTokenOverlayNotFound) {
+					continue
+				} else if err != nil {
+					return errors.Join(append(saveErrs, err)...)
+				}
+
+				for _, overlayUtxo := range overlayUtxos {
+					if walletOutpoints[overlayUtxo.Outpoint] {
+						continue
+					}
+					missing[overlayUtxo.Outpoint] = true
+					err = s.updateDivergence(ctx, c, xPubID, stablecoinID, overlayUtxo.Outpoint, TokenDivergenceMissing,
+						fmt.Sprintf("overlay holds %d for %s which is not in the wallet", overlayUtxo.Amount, holderID))
+					if err != nil {
+						saveErrs = append(saveErrs, err)
+					}
+				}
+			}
+		}
+	}
+
+	var divergences []TokenDivergence
+	err := getModels(ctx, c.Datastore(), &divergences, map[string]interface{}{
+		kindField:       TokenDivergenceMissing,
+		resolvedAtField: nil,
+	}, nil, defaultDatabaseReadTimeout)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.Join(append(saveErrs, err)...)
+	}
+	for _, divergence := range divergences {
+		if missing[divergence.Outpoint] {
+			continue
+		}
+		if !walletOutpoints[divergence.Outpoint] {
+			held, err := s.heldByOverlay(ctx, c, divergence.StablecoinID, divergence.Outpoint)
+			if err != nil {
+				return errors.Join(append(saveErrs, err)...)
+			}
+			if held {
+				continue
+			}
+		}
+		if err = s.updateDivergence(ctx, c, divergence.XpubID, divergence.StablecoinID, divergence.Outpoint, "", ""); err != nil {
+			saveErrs = append(saveErrs, err)
+		}
+	}
+
+	return errors.Join(saveErrs...)
+}
+
+// heldByOverlay re-checks the outpoint of the missing divergence, it returns true while the overlay still holds the outpoint unspent
+func (s *StablecoinBalanceService) heldByOverlay(ctx context.Context, c ClientInterface, stablecoinID, outpoint string) (bool, error) {
+	state, err := c.Tokens().GetOutpointState(ctx, stablecoinID, outpoint)
+	if errors.Is(err, spverrors.ErrTokenOverlayNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return state.Valid && !state.Spent, nil
+}
+
+// updateDivergence flags the divergence of the outpoint or resolves it when the kind is empty
+func (s *StablecoinBalanceService) updateDivergence(ctx context.Context, c ClientInterface, xPubID, stablecoinID, outpoint string,
+	kind TokenDivergenceKind, details string,
+) error {
+	log := s.log.With().Str("xpubID", xPubID).Str("stablecoinID", stablecoinID).Str("outpoint", outpoint).Logger()
+
+	divergence, err := getTokenDivergence(ctx, outpoint, c.DefaultModelOptions()...)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get token divergence of %s", outpoint)
+	}
+
+	if kind == "" {
+		if divergence == nil || !divergence.isOpen() {
+			return nil
+		}
+		divergence.resolve()
+		log.Info().Str("kind", string(divergence.Kind)).Msg("Token divergence resolved")
+	} else {
+		if divergence == nil {
+			divergence = newTokenDivergence(xPubID, stablecoinID, outpoint, c.DefaultModelOptions(New())...)
+		}
+		if !divergence.flag(kind, details) {
+			return nil
+		}
+		log.Warn().Str("kind", string(kind)).Str("details", details).Msg("Token utxo diverges from the overlay state")
+	}
+
+	return spverrors.Wrapf(divergence.Save(ctx), "failed to save token divergence of %s", outpoint)
+}
@@ -0,0 +1,273 @@
+package engine
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStablecoinBalanceService_ReconcileTokenUtxos(t *testing.T) {
+	outpoint := tokens.Outpoint(testTxID, 0)
+	stateURL := "http://localhost:3091/api/v2/coin/" + testStablecoinID + "/outpoint/" + outpoint
+
+	// given the banknote of 100 of testStablecoinID
+	setup := func(t *testing.T) (context.Context, ClientInterface, *httpmock.MockTransport) {
+		mockTransport := httpmock.NewMockTransport()
+		httpClient := resty.New()
+		httpClient.SetTransport(mockTransport)
+
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, false, withTaskManagerMockup(), WithHTTPClient(httpClient))
+		t.Cleanup(deferMe)
+
+		transaction, err := txFromHex(testTxHex, append(client.DefaultModelOptions(), New(), WithMetadatas(map[string]interface{}{
+			TransactionConfigKey: tokenTransactionConfig{StablecoinID: testStablecoinID},
+		}))...)
+		require.NoError(t, err)
+		require.NoError(t, transaction.Save(ctx))
+
+		utxo := newUtxo(testXPubID, transaction.ID, testTokenLockingScript(t, testBanknoteSerial, 100), 0, 1, append(client.DefaultModelOptions(), New())...)
+		require.NoError(t, utxo.Save(ctx))
+
+		return ctx, client, mockTransport
+	}
+
+	overlayState := func(mockTransport *httpmock.MockTransport, state tokens.OutpointState) {
+		mockTransport.RegisterResponder(http.MethodGet, stateURL, httpmock.NewJsonResponderOrPanic(http.StatusOK, state))
+	}
+
+	t.Run("no divergence when the overlay state matches", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setup(t)
+		overlayState(mockTransport, tokens.OutpointState{Outpoint: outpoint, Amount: 100, Valid: true})
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err := getTokenDivergence(ctx, outpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Nil(t, divergence)
+	})
+
+	t.Run("flag the banknote spent in the overlay and resolve it when the states match again", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setup(t)
+		overlayState(mockTransport, tokens.OutpointState{Outpoint: outpoint, Amount: 100, Valid: true, Spent: true, SpendingTxID: testTxID2})
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err := getTokenDivergence(ctx, outpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		require.NotNil(t, divergence)
+		assert.Equal(t, TokenDivergenceSpent, divergence.Kind)
+		assert.Equal(t, testXPubID, divergence.XpubID)
+		assert.Equal(t, testStablecoinID, divergence.StablecoinID)
+		assert.False(t, divergence.ResolvedAt.Valid)
+
+		// when:
+		overlayState(mockTransport, tokens.OutpointState{Outpoint: outpoint, Amount: 100, Valid: true})
+		err = client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err = getTokenDivergence(ctx, outpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.True(t, divergence.ResolvedAt.Valid)
+	})
+
+	t.Run("flag the banknote with different amount in the overlay", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setup(t)
+		overlayState(mockTransport, tokens.OutpointState{Outpoint: outpoint, Amount: 50, Valid: true})
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err := getTokenDivergence(ctx, outpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, TokenDivergenceAmount, divergence.Kind)
+	})
+
+	t.Run("flag the banknote unknown to the overlay", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setup(t)
+		mockTransport.RegisterResponder(http.MethodGet, stateURL, httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err := getTokenDivergence(ctx, outpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, TokenDivergenceUnknown, divergence.Kind)
+	})
+
+	t.Run("stop without flagging when the overlay is unavailable", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setup(t)
+		mockTransport.RegisterResponder(http.MethodGet, stateURL, httpmock.NewStringResponder(http.StatusInternalServerError, ""))
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTokenOverlayUnavailable)
+		divergence, err := getTokenDivergence(ctx, outpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Nil(t, divergence)
+	})
+
+	missingOutpoint := tokens.Outpoint(testTxID2, 3)
+	missingStateURL := "http://localhost:3091/api/v2/coin/" + testStablecoinID + "/outpoint/" + missingOutpoint
+	holderUtxosURL := "http://localhost:3091/api/v2/coin/" + testStablecoinID + "/holder/" + testPaymail + "/utxos"
+
+	// given the paymail of the holder of the banknote and the overlay utxo missing in the wallet
+	setupHolder := func(t *testing.T) (context.Context, ClientInterface, *httpmock.MockTransport) {
+		ctx, client, mockTransport := setup(t)
+		overlayState(mockTransport, tokens.OutpointState{Outpoint: outpoint, Amount: 100, Valid: true})
+		_, err := client.NewXpub(ctx, testXPub, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		_, err = client.NewPaymailAddress(ctx, testXPub, testPaymail, testPublicName, testAvatar, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+
+		mockTransport.RegisterResponder(http.MethodGet, holderUtxosURL,
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, []*tokens.TokenUtxo{
+				{Outpoint: outpoint, AssetID: testStablecoinID, Amount: 100},
+				{Outpoint: missingOutpoint, AssetID: testStablecoinID, Amount: 20},
+			}),
+		)
+
+		return ctx, client, mockTransport
+	}
+
+	// given the missing divergence flagged by the previous run and the holder utxos without the missing utxo
+	setupFlaggedMissing := func(t *testing.T) (context.Context, ClientInterface, *httpmock.MockTransport) {
+		ctx, client, mockTransport := setupHolder(t)
+		require.NoError(t, client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client))
+
+		mockTransport.RegisterResponder(http.MethodGet, holderUtxosURL,
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, []*tokens.TokenUtxo{
+				{Outpoint: outpoint, AssetID: testStablecoinID, Amount: 100},
+			}),
+		)
+
+		return ctx, client, mockTransport
+	}
+
+	t.Run("flag the overlay utxo of the holder missing in the wallet", func(t *testing.T) {
+		// given:
+		ctx, client, _ := setupHolder(t)
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err := getTokenDivergence(ctx, missingOutpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		require.NotNil(t, divergence)
+		assert.Equal(t, TokenDivergenceMissing, divergence.Kind)
+		assert.Equal(t, testXPubID, divergence.XpubID)
+
+		// and then:
+		divergence, err = getTokenDivergence(ctx, outpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Nil(t, divergence)
+	})
+
+	t.Run("keep the missing divergence open while the overlay still holds the utxo", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setupFlaggedMissing(t)
+		mockTransport.RegisterResponder(http.MethodGet, missingStateURL, httpmock.NewJsonResponderOrPanic(http.StatusOK,
+			tokens.OutpointState{Outpoint: missingOutpoint, Amount: 20, Valid: true},
+		))
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err := getTokenDivergence(ctx, missingOutpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		require.NotNil(t, divergence)
+		assert.Equal(t, TokenDivergenceMissing, divergence.Kind)
+		assert.False(t, divergence.ResolvedAt.Valid)
+	})
+
+	t.Run("resolve the missing divergence when the overlay reports the utxo spent", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setupFlaggedMissing(t)
+		mockTransport.RegisterResponder(http.MethodGet, missingStateURL, httpmock.NewJsonResponderOrPanic(http.StatusOK,
+			tokens.OutpointState{Outpoint: missingOutpoint, Amount: 20, Valid: true, Spent: true, SpendingTxID: testTxID},
+		))
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err := getTokenDivergence(ctx, missingOutpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		require.NotNil(t, divergence)
+		assert.True(t, divergence.ResolvedAt.Valid)
+	})
+
+	t.Run("resolve the missing divergence when the overlay doesn't know the utxo", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setupFlaggedMissing(t)
+		mockTransport.RegisterResponder(http.MethodGet, missingStateURL, httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		divergence, err := getTokenDivergence(ctx, missingOutpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		require.NotNil(t, divergence)
+		assert.True(t, divergence.ResolvedAt.Valid)
+	})
+
+	t.Run("keep the missing divergence open when the overlay re-check fails", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setupFlaggedMissing(t)
+		mockTransport.RegisterResponder(http.MethodGet, missingStateURL, httpmock.NewStringResponder(http.StatusInternalServerError, ""))
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTokenOverlayUnavailable)
+		divergence, err := getTokenDivergence(ctx, missingOutpoint, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		require.NotNil(t, divergence)
+		assert.False(t, divergence.ResolvedAt.Valid)
+	})
+
+	t.Run("return the error when the divergence cannot be saved", func(t *testing.T) {
+		// given:
+		ctx, client, mockTransport := setup(t)
+		overlayState(mockTransport, tokens.OutpointState{Outpoint: outpoint, Amount: 100, Valid: true, Spent: true, SpendingTxID: testTxID2})
+		require.NoError(t, client.Datastore().DB().Migrator().DropTable(&TokenDivergence{}))
+
+		// when:
+		err := client.StablecoinBalanceService().ReconcileTokenUtxos(ctx, client)
+
+		// then:
+		require.Error(t, err)
+	})
+}
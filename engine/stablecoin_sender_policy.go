@@ -0,0 +1,216 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/go-resty/resty/v2"
+)
+
+// SenderPolicy decides if the sender is allowed to open the transfer intent.
+// Policies are checked by the default intent validator one by one, the first rejection stops the validation.
+type SenderPolicy interface {
+	// Name is used to identify the policy in the audit logs
+	Name() string
+	// Check returns an error if the intent should be rejected
+	Check(ctx context.Context, intent *Intent) error
+}
+
+// listPolicy rejects the senders from the deny list and, if the allow list is not empty, the senders not present on it
+type listPolicy struct {
+	allow map[string]struct{}
+	deny  map[string]struct{}
+}
+
+// NewListSenderPolicy creates a sender policy based on the allow and deny lists.
+// The list entries can be either the paymail addresses or the domains, the deny list takes precedence over the allow list.
+func NewListSenderPolicy(allowList, denyList []string) SenderPolicy {
+	return &listPolicy{
+		allow: toPolicyEntries(allowList),
+		deny:  toPolicyEntries(denyList),
+	}
+}
+
+// Name returns the name of the policy
+func (p *listPolicy) Name() string {
+	return "allow_deny_list"
+}
+
+// Check rejects the sender if it is denied or not allowed
+func (p *listPolicy) Check(_ context.Context, intent *Intent) error {
+	_, domain, address := paymail.SanitizePaymail(intent.SenderID)
+
+	if p.matches(p.deny, address, domain) {
+		return spverrors.ErrStablecoinSenderNotAllowed.Wrap(spverrors.Newf("sender %s is on the deny list", address))
+	}
+
+	if len(p.allow) > 0 && !p.matches(p.allow, address, domain) {
+		return spverrors.ErrStablecoinSenderNotAllowed.Wrap(spverrors.Newf("sender %s is not on the allow list", address))
+	}
+
+	return nil
+}
+
+func (p *listPolicy) matches(entries map[string]struct{}, address, domain string) bool {
+	if _, ok := entries[address]; ok {
+		return true
+	}
+	_, ok := entries[domain]
+	return ok
+}
+
+func toPolicyEntries(list []string) map[string]struct{} {
+	entries := make(map[string]struct{}, len(list))
+	for _, entry := range list {
+		entries[strings.ToLower(strings.TrimSpace(entry))] = struct{}{}
+	}
+	return entries
+}
+
+// dailyVolumePolicy limits the amount of the stablecoin transferred by a single sender or received by a single receiver within a day (UTC).
+// The accepted intent reserves its amount in the daily volumes of both parties, the reservation is released when the intent expires or is canceled.
+// The reservation is a conditional update of the volume, so the concurrent intents cannot exceed the limit together.
+type dailyVolumePolicy struct {
+	c             ClientInterface
+	senderLimit   uint64
+	receiverLimit uint64
+}
+
+// NewDailyVolumeSenderPolicy creates a sender policy limiting the daily volume of the stablecoin transfers, 0 means no limit.
+func NewDailyVolumeSenderPolicy(c ClientInterface, senderLimit, receiverLimit uint64) SenderPolicy {
+	return &dailyVolumePolicy{
+		c:             c,
+		senderLimit:   senderLimit,
+		receiverLimit: receiverLimit,
+	}
+}
+
+// Name returns the name of the policy
+func (p *dailyVolumePolicy) Name() string {
+	return "daily_volume_limit"
+}
+
+// Check reserves the amount of the intent in the daily volumes of the sender and the receiver,
+// the intent is rejected if it would exceed the daily limit of any of them
+func (p *dailyVolumePolicy) Check(ctx context.Context, intent *Intent) error {
+	day := time.Now().UTC().Format(dailyVolumeDayLayout)
+
+	if err := p.reserve(ctx, dailyVolumeSender, intent.SenderID, intent, day, p.senderLimit); err != nil {
+		return err
+	}
+
+	if err := p.reserve(ctx, dailyVolumeReceiver, intent.ReceiverID, intent, day, p.receiverLimit); err != nil {
+		// the intent is rejected, so the volume reserved for the sender is given back
+		if releaseErr := releaseDailyVolume(ctx, p.c.Datastore().DB(), dailyVolumeSender, intent.SenderID, intent.StablecoinID, day, intent.Amount); releaseErr != nil {
+			return releaseErr
+		}
+		return err
+	}
+
+	intent.volumeDay = day
+	return nil
+}
+
+func (p *dailyVolumePolicy) reserve(ctx context.Context, role dailyVolumeRole, paymailAddress string, intent *Intent, day string, limit uint64) error {
+	if limit > 0 && intent.Amount > limit {
+		return spverrors.ErrStablecoinDailyLimitExceeded.Wrap(spverrors.Newf("amount %d exceeds the daily limit %d of %s", intent.Amount, limit, paymailAddress))
+	}
+
+	reserved, err := reserveDailyVolume(ctx, p.c.Datastore().DB(), role, paymailAddress, intent.StablecoinID, day, intent.Amount, limit)
+	if err != nil {
+		return err
+	}
+
+	if !reserved {
+		return spverrors.ErrStablecoinDailyLimitExceeded.Wrap(spverrors.Newf("daily volume of %s with amount %d exceeds the limit %d", paymailAddress, intent.Amount, limit))
+	}
+
+	return nil
+}
+
+// callbackPolicyRequest is the body sent to the external policy service
+type callbackPolicyRequest struct {
+	SenderID     string `json:"senderId"`
+	ReceiverID   string `json:"receiverId"`
+	StablecoinID string `json:"stablecoinId"`
+	Amount       uint64 `json:"amount"`
+}
+
+// callbackPolicyResponse is the decision of the external policy service
+type callbackPolicyResponse struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+}
+
+// callbackPolicy asks the external service (e.g. KYC provider) about every transfer intent.
+// It fails closed - the intent is rejected when the service cannot be asked for the decision.
+type callbackPolicy struct {
+	url        string
+	httpClient *resty.Client
+}
+
+// NewCallbackSenderPolicy creates a sender policy which asks the external HTTP service for the decision.
+func NewCallbackSenderPolicy(url string, timeout time.Duration, httpClient *resty.Client) SenderPolicy {
+	return &callbackPolicy{
+		url:        url,
+		httpClient: httpClient.Clone().SetTimeout(timeout),
+	}
+}
+
+// Name returns the name of the policy
+func (p *callbackPolicy) Name() string {
+	return "http_callback"
+}
+
+// Check sends the intent to the external policy service and rejects it if the service does not allow it
+func (p *callbackPolicy) Check(ctx context.Context, intent *Intent) error {
+	var decision callbackPolicyResponse
+	resp, err := p.httpClient.R().
+		SetContext(ctx).
+		SetBody(&callbackPolicyRequest{
+			SenderID:     intent.SenderID,
+			ReceiverID:   intent.ReceiverID,
+			StablecoinID: intent.StablecoinID,
+			Amount:       intent.Amount,
+		}).
+		SetResult(&decision).
+		Post(p.url)
+	if err != nil {
+		return spverrors.ErrStablecoinSenderPolicyUnavailable.Wrap(err)
+	}
+	if resp.IsError() {
+		return spverrors.ErrStablecoinSenderPolicyUnavailable.Wrap(spverrors.Newf("policy service responded with status code %d", resp.StatusCode()))
+	}
+
+	if !decision.Allowed {
+		return spverrors.ErrStablecoinSenderNotAllowed.Wrap(spverrors.Newf("rejected by the policy service: %s", decision.Reason))
+	}
+
+	return nil
+}
+
+// newSenderPolicies creates the built-in sender policies enabled in the config
+func newSenderPolicies(cfg *config.SenderPolicyConfig, c ClientInterface, httpClient *resty.Client) []SenderPolicy {
+	policies := make([]SenderPolicy, 0)
+	if cfg == nil {
+		return policies
+	}
+
+	if len(cfg.AllowList) > 0 || len(cfg.DenyList) > 0 {
+		policies = append(policies, NewListSenderPolicy(cfg.AllowList, cfg.DenyList))
+	}
+
+	if cfg.DailySenderLimit > 0 || cfg.DailyReceiverLimit > 0 {
+		policies = append(policies, NewDailyVolumeSenderPolicy(c, cfg.DailySenderLimit, cfg.DailyReceiverLimit))
+	}
+
+	if cfg.Callback != nil && cfg.Callback.URL != "" {
+		policies = append(policies, NewCallbackSenderPolicy(cfg.Callback.URL, cfg.Callback.Timeout, httpClient))
+	}
+
+	return policies
+}
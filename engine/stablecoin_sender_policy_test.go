@@ -0,0 +1,259 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/go-resty/resty/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStablecoinID = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"
+
+func TestListSenderPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		allowList []string
+		denyList  []string
+		senderID  string
+		allowed   bool
+	}{
+		"allow everyone with empty lists": {
+			senderID: "alice@example.com",
+			allowed:  true,
+		},
+		"allow sender from the allow list": {
+			allowList: []string{"alice@example.com"},
+			senderID:  "Alice@Example.com",
+			allowed:   true,
+		},
+		"allow sender from the allowed domain": {
+			allowList: []string{"example.com"},
+			senderID:  "alice@example.com",
+			allowed:   true,
+		},
+		"reject sender not on the allow list": {
+			allowList: []string{"example.com"},
+			senderID:  "alice@other.com",
+			allowed:   false,
+		},
+		"reject sender from the deny list": {
+			denyList: []string{"mallory@example.com"},
+			senderID: "mallory@example.com",
+			allowed:  false,
+		},
+		"deny list takes precedence over allow list": {
+			allowList: []string{"example.com"},
+			denyList:  []string{"mallory@example.com"},
+			senderID:  "mallory@example.com",
+			allowed:   false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			policy := NewListSenderPolicy(test.allowList, test.denyList)
+
+			// when:
+			err := policy.Check(context.Background(), &Intent{SenderID: test.senderID})
+
+			// then:
+			if test.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, spverrors.ErrStablecoinSenderNotAllowed)
+			}
+		})
+	}
+}
+
+func TestCallbackSenderPolicy(t *testing.T) {
+	t.Parallel()
+
+	newPolicyServer := func(t *testing.T, status int, decision callbackPolicyResponse) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var request callbackPolicyRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, "alice@example.com", request.SenderID)
+			assert.Equal(t, uint64(100), request.Amount)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(decision)
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	intent := &Intent{SenderID: "alice@example.com", ReceiverID: "bob@example.com", StablecoinID: testStablecoinID, Amount: 100}
+
+	t.Run("allow when the service allows", func(t *testing.T) {
+		server := newPolicyServer(t, http.StatusOK, callbackPolicyResponse{Allowed: true})
+		policy := NewCallbackSenderPolicy(server.URL, time.Second, resty.New())
+
+		require.NoError(t, policy.Check(context.Background(), intent))
+	})
+
+	t.Run("reject when the service rejects", func(t *testing.T) {
+		server := newPolicyServer(t, http.StatusOK, callbackPolicyResponse{Allowed: false, Reason: "kyc not completed"})
+		policy := NewCallbackSenderPolicy(server.URL, time.Second, resty.New())
+
+		err := policy.Check(context.Background(), intent)
+
+		require.ErrorIs(t, err, spverrors.ErrStablecoinSenderNotAllowed)
+		assert.Contains(t, errors.Unwrap(err).Error(), "kyc not completed")
+	})
+
+	t.Run("fail closed when the service returns an error", func(t *testing.T) {
+		server := newPolicyServer(t, http.StatusInternalServerError, callbackPolicyResponse{Allowed: true})
+		policy := NewCallbackSenderPolicy(server.URL, time.Second, resty.New())
+
+		require.ErrorIs(t, policy.Check(context.Background(), intent), spverrors.ErrStablecoinSenderPolicyUnavailable)
+	})
+}
+
+func TestDailyVolumeSenderPolicy(t *testing.T) {
+	newIntent := func(senderID, receiverID string, amount uint64) *Intent {
+		return &Intent{SenderID: senderID, ReceiverID: receiverID, StablecoinID: testStablecoinID, Amount: amount}
+	}
+
+	t.Run("sender limit", func(t *testing.T) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		policy := NewDailyVolumeSenderPolicy(client, 1000, 0)
+
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 600)))
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "carol@example.com", 300)))
+
+		require.ErrorIs(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 101)), spverrors.ErrStablecoinDailyLimitExceeded)
+		require.ErrorIs(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 1001)), spverrors.ErrStablecoinDailyLimitExceeded)
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 100)))
+		require.NoError(t, policy.Check(ctx, newIntent("carol@example.com", "bob@example.com", 1000)))
+	})
+
+	t.Run("receiver limit", func(t *testing.T) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		policy := NewDailyVolumeSenderPolicy(client, 0, 1000)
+
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 500)))
+		require.NoError(t, policy.Check(ctx, newIntent("carol@example.com", "bob@example.com", 500)))
+
+		err := policy.Check(ctx, newIntent("dave@example.com", "bob@example.com", 1))
+
+		require.ErrorIs(t, err, spverrors.ErrStablecoinDailyLimitExceeded)
+	})
+
+	t.Run("give back sender volume when receiver limit is exceeded", func(t *testing.T) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		policy := NewDailyVolumeSenderPolicy(client, 1000, 500)
+
+		require.ErrorIs(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 600)), spverrors.ErrStablecoinDailyLimitExceeded)
+
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "carol@example.com", 500)))
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "dave@example.com", 500)))
+	})
+
+	t.Run("share the volume of the differently written paymail", func(t *testing.T) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		policy := NewDailyVolumeSenderPolicy(client, 1000, 0)
+
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 600)))
+
+		require.ErrorIs(t, policy.Check(ctx, newIntent(" Alice@Example.COM", "bob@example.com", 500)), spverrors.ErrStablecoinDailyLimitExceeded)
+	})
+
+	t.Run("release volume when the later policy rejects the sender", func(t *testing.T) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		logger := zerolog.Nop()
+		policy := NewDailyVolumeSenderPolicy(client, 1000, 0)
+		validator := NewDefaultIntentValidator(&logger, client, policy, NewListSenderPolicy(nil, []string{"alice@example.com"}))
+		service := NewStablecoinTransferService(validator, time.Minute, resty.New(), nil, "", &logger)
+
+		_, err := service.ValidateIntent(ctx, client, newIntent("alice@example.com", "bob@example.com", 1000))
+		require.ErrorIs(t, err, spverrors.ErrStablecoinSenderNotAllowed)
+
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 1000)))
+	})
+
+	t.Run("release volume of the expired intent", func(t *testing.T) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		policy := NewDailyVolumeSenderPolicy(client, 1000, 0)
+
+		intent := newIntent("alice@example.com", "bob@example.com", 1000)
+		require.NoError(t, policy.Check(ctx, intent))
+		sti, err := CreateStablecoinTransferIntent(intent, nil, nil, -time.Second, append(client.DefaultModelOptions(), New())...)
+		require.NoError(t, err)
+		require.NoError(t, sti.Save(ctx))
+		require.ErrorIs(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 1)), spverrors.ErrStablecoinDailyLimitExceeded)
+
+		require.NoError(t, client.StablecoinTransferService().ExpireTransferIntents(ctx, client))
+
+		require.NoError(t, policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 1000)))
+	})
+
+	t.Run("concurrent intents do not exceed the limit", func(t *testing.T) {
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		policy := NewDailyVolumeSenderPolicy(client, 1000, 0)
+
+		const attempts = 25
+		results := make(chan error, attempts)
+		var wg sync.WaitGroup
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results <- policy.Check(ctx, newIntent("alice@example.com", "bob@example.com", 100))
+			}()
+		}
+		wg.Wait()
+		close(results)
+
+		accepted := 0
+		for err := range results {
+			if err == nil {
+				accepted++
+				continue
+			}
+			require.ErrorIs(t, err, spverrors.ErrStablecoinDailyLimitExceeded)
+		}
+		assert.Equal(t, 10, accepted)
+	})
+}
+
+func TestDefaultValidator_ValidateSender(t *testing.T) {
+	t.Parallel()
+
+	logger := zerolog.Nop()
+
+	t.Run("allow without policies", func(t *testing.T) {
+		validator := NewDefaultIntentValidator(&logger, nil)
+
+		require.NoError(t, validator.ValidateSender(context.Background(), &Intent{SenderID: "alice@example.com"}))
+	})
+
+	t.Run("reject when any policy rejects", func(t *testing.T) {
+		validator := NewDefaultIntentValidator(&logger, nil,
+			NewListSenderPolicy([]string{"example.com"}, nil),
+			NewListSenderPolicy(nil, []string{"mallory@example.com"}),
+		)
+
+		require.NoError(t, validator.ValidateSender(context.Background(), &Intent{SenderID: "alice@example.com"}))
+		require.ErrorIs(t, validator.ValidateSender(context.Background(), &Intent{SenderID: "mallory@example.com"}), spverrors.ErrStablecoinSenderNotAllowed)
+	})
+}
@@ -0,0 +1,196 @@
+package engine
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	bsm "github.com/bsv-blockchain/go-sdk/compat/bsm"
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/bsv-blockchain/go-sdk/script"
+)
+
+// StablecoinSigner signs the transfer intents and the transfers sent to other paymail hosts.
+// The signature must be made with the private key of the sender's paymail PKI, so the receiver can verify it.
+// The engine does not hold the private keys of the paymails, so the signer has to be provided by the application,
+// e.g. NewPaymailKeysSigner built from the stablecoin signers config.
+type StablecoinSigner interface {
+	// Sign returns the compact Bitcoin message signature (base64) of the message
+	Sign(ctx context.Context, senderPaymail string, message []byte) (string, error)
+}
+
+// StablecoinSignerFunc is an adapter allowing to use an ordinary function as the StablecoinSigner
+type StablecoinSignerFunc func(ctx context.Context, senderPaymail string, message []byte) (string, error)
+
+// Sign calls f(ctx, senderPaymail, message)
+func (f StablecoinSignerFunc) Sign(ctx context.Context, senderPaymail string, message []byte) (string, error) {
+	return f(ctx, senderPaymail, message)
+}
+
+// paymailKeysSigner signs the messages with the PKI private keys of the configured sender paymails
+type paymailKeysSigner map[string]*ec.PrivateKey
+
+// NewPaymailKeysSigner creates the StablecoinSigner from the PKI private keys (WIF) of the sender paymails.
+// The requests of the paymails without a key are sent unsigned.
+func NewPaymailKeysSigner(keys map[string]string) (StablecoinSigner, error) {
+	signer := make(paymailKeysSigner, len(keys))
+	for address, wif := range keys {
+		key, err := ec.PrivateKeyFromWif(wif)
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "invalid private key of the stablecoin signer %s", address)
+		}
+		signer[strings.ToLower(strings.TrimSpace(address))] = key
+	}
+	return signer, nil
+}
+
+// Sign signs the message with the PKI private key of the sender paymail, it returns an empty signature if the key is not configured
+func (s paymailKeysSigner) Sign(_ context.Context, senderPaymail string, message []byte) (string, error) {
+	key, ok := s[strings.ToLower(strings.TrimSpace(senderPaymail))]
+	if !ok {
+		return "", nil
+	}
+	signature, err := bsm.SignMessageString(key, message)
+	return signature, spverrors.Wrapf(err, "failed to sign the message of %s", senderPaymail)
+}
+
+// SigningMessage returns the message signed by the sender of the intent
+// The metadata of the intent is not signed, because its JSON representation is not stable between the hosts.
+func (i *Intent) SigningMessage() []byte {
+	banknotes := make([]string, 0, len(i.Banknotes))
+	for _, banknote := range i.Banknotes {
+		banknotes = append(banknotes, fmt.Sprintf("%s:%d", banknote.Serial, banknote.Amount))
+	}
+
+	parts := []string{
+		i.SenderID,
+		i.ReceiverID,
+		i.Nonce,
+		i.StablecoinID,
+		fmt.Sprintf("%d", i.Amount),
+		strings.Join(banknotes, ","),
+	}
+	if i.Operation != "" {
+		// the regular transfer is signed without the operation, so the signatures of the older senders stay valid
+		parts = append(parts, string(i.Operation))
+	}
+
+	return []byte(strings.Join(parts, "|"))
+}
+
+// SigningMessage returns the message signed by the sender of the transfer
+func (t *Transfer) SigningMessage() []byte {
+	return []byte(strings.Join([]string{t.SenderID, t.RefID, t.TxHex}, "|"))
+}
+
+func (s *StablecoinTransferService) signIntent(ctx context.Context, intent *Intent) error {
+	if s.signer == nil {
+		return nil
+	}
+
+	signature, err := s.signer.Sign(ctx, intent.SenderID, intent.SigningMessage())
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to sign transfer intent")
+	}
+	intent.Signature = signature
+
+	return nil
+}
+
+func (s *StablecoinTransferService) signTransfer(ctx context.Context, transfer *Transfer) error {
+	if s.signer == nil || transfer.SenderID == "" {
+		return nil
+	}
+
+	signature, err := s.signer.Sign(ctx, transfer.SenderID, transfer.SigningMessage())
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to sign transfer")
+	}
+	transfer.Signature = signature
+
+	return nil
+}
+
+// VerifyIntentSignature verifies the signature of the transfer intent received from another paymail host.
+// Unsigned intents are rejected only when the sender validation is enabled in the paymail config,
+// but if the signature is present, it is always verified.
+func (s *StablecoinTransferService) VerifyIntentSignature(ctx context.Context, c ClientInterface, intent *Intent) error {
+	err := verifySenderSignature(ctx, c, intent.SenderID, intent.SigningMessage(), intent.Signature)
+	if err != nil {
+		s.log.Warn().Err(err).Str("senderID", intent.SenderID).Str("receiverID", intent.ReceiverID).Msg("Transfer intent signature verification failed")
+	}
+	return err
+}
+
+// VerifyTransferSignature verifies the signature of the transfer received from another paymail host.
+// The transfer must be signed by the sender of the referenced intent.
+// Unsigned transfers are rejected only when the sender validation is enabled in the paymail config,
+// but if the signature is present, it is always verified.
+func (s *StablecoinTransferService) VerifyTransferSignature(ctx context.Context, c ClientInterface, transfer *Transfer) error {
+	senderID := transfer.SenderID
+
+	sti, err := getStablecoinTransferIntentByID(ctx, transfer.RefID, c.DefaultModelOptions()...)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get transfer intent")
+	}
+	if sti != nil {
+		if senderID != "" && !strings.EqualFold(senderID, sti.SenderID) {
+			s.log.Warn().Str("refID", transfer.RefID).Str("senderID", senderID).Msg("Transfer is not sent by the sender of the intent")
+			return spverrors.ErrStablecoinSignatureInvalid.Wrap(spverrors.Newf("transfer sender %s is not the sender of the intent", senderID))
+		}
+		senderID = sti.SenderID
+	}
+
+	err = verifySenderSignature(ctx, c, senderID, transfer.SigningMessage(), transfer.Signature)
+	if err != nil {
+		s.log.Warn().Err(err).Str("refID", transfer.RefID).Str("senderID", senderID).Msg("Transfer signature verification failed")
+	}
+	return err
+}
+
+// verifySenderSignature checks the signature of the message against the PKI of the sender's paymail
+func verifySenderSignature(ctx context.Context, c ClientInterface, senderID string, message []byte, signature string) error {
+	if signature == "" {
+		if senderValidationEnabled(c) {
+			return spverrors.ErrStablecoinSignatureMissing
+		}
+		return nil
+	}
+
+	if senderID == "" {
+		return spverrors.ErrStablecoinSignatureInvalid.Wrap(spverrors.Newf("sender of the signed request is unknown"))
+	}
+
+	sender, err := c.PaymailService().GetSanitizedPaymail(senderID)
+	if err != nil {
+		return spverrors.ErrPaymailAddressIsInvalid.Wrap(err)
+	}
+
+	pki, err := c.PaymailService().GetPkiForPaymail(ctx, sender)
+	if err != nil {
+		return spverrors.ErrStablecoinSignatureInvalid.Wrap(spverrors.Wrapf(err, "cannot get PKI of the sender %s", sender.Address))
+	}
+
+	address, err := script.NewAddressFromPublicKeyString(pki.PubKey, true)
+	if err != nil {
+		return spverrors.ErrStablecoinSignatureInvalid.Wrap(spverrors.Wrapf(err, "invalid PKI of the sender %s", sender.Address))
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return spverrors.ErrStablecoinSignatureInvalid.Wrap(spverrors.Wrapf(err, "signature is not base64 encoded"))
+	}
+
+	if err = bsm.VerifyMessage(address.AddressString, decoded, message); err != nil {
+		return spverrors.ErrStablecoinSignatureInvalid.Wrap(err)
+	}
+
+	return nil
+}
+
+func senderValidationEnabled(c ClientInterface) bool {
+	cfg := c.GetPaymailConfig()
+	return cfg != nil && cfg.Configuration != nil && cfg.SenderValidationEnabled
+}
@@ -0,0 +1,204 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/go-paymail"
+	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	xtester "github.com/bitcoin-sv/spv-wallet/engine/tester/paymailmock"
+	bsm "github.com/bsv-blockchain/go-sdk/compat/bsm"
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testStablecoinSenderDomain = "sender.com"
+	testStablecoinSender       = "alice@" + testStablecoinSenderDomain
+)
+
+// senderPKIResponse responds on the PKI endpoint with the public key of the sender
+type senderPKIResponse struct {
+	pubKey string
+}
+
+func (r senderPKIResponse) Responder() httpmock.Responder {
+	return func(request *http.Request) (*http.Response, error) {
+		return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+			"bsvalias": paymail.DefaultBsvAliasVersion,
+			"handle":   testStablecoinSender,
+			"pubkey":   r.pubKey,
+		})
+	}
+}
+
+// intentRecorder stores the intent received by the mocked transfer intent endpoint
+type intentRecorder struct {
+	intent *Intent
+}
+
+func (r intentRecorder) Responder() httpmock.Responder {
+	return func(request *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(request.Body).Decode(r.intent); err != nil {
+			return httpmock.NewStringResponse(http.StatusBadRequest, "invalid json"), nil
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, ValidationResponse{Nonce: r.intent.Nonce})
+	}
+}
+
+func TestStablecoinSignatureVerification(t *testing.T) {
+	senderKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+
+	signer := StablecoinSignerFunc(func(_ context.Context, _ string, message []byte) (string, error) {
+		return bsm.SignMessageString(senderKey, message)
+	})
+
+	setup := func(t *testing.T, senderValidation bool) (context.Context, ClientInterface) {
+		paymailClient := xtester.MockClient(httpmock.NewMockTransport(), testStablecoinSenderDomain)
+		paymailClient.WillRespondWithBasicCapabilities()
+		paymailClient.WillRespondOnCapability(paymail.BRFCPki).With(senderPKIResponse{pubKey: senderKey.PubKey().ToDERHex()})
+
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, false, withTaskManagerMockup(),
+			WithPaymailClient(paymailClient),
+			WithPaymailSupport([]string{testStablecoinReceiverDomain}, "from@"+testStablecoinReceiverDomain, false, senderValidation),
+		)
+		t.Cleanup(deferMe)
+
+		return ctx, client
+	}
+
+	newIntent := func() *Intent {
+		return &Intent{
+			SenderID:     testStablecoinSender,
+			ReceiverID:   "bob@" + testStablecoinReceiverDomain,
+			Nonce:        "1234567890abcdef",
+			StablecoinID: testStablecoinID,
+			Amount:       100,
+			Banknotes:    []Banknote{{Serial: testStablecoinID, Amount: 100}},
+		}
+	}
+
+	signIntent := func(t *testing.T, intent *Intent, key *ec.PrivateKey) {
+		signature, err := bsm.SignMessageString(key, intent.SigningMessage())
+		require.NoError(t, err)
+		intent.Signature = signature
+	}
+
+	t.Run("accept intent signed with the sender's PKI key", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t, true)
+		intent := newIntent()
+		signIntent(t, intent, senderKey)
+
+		// when:
+		err := client.StablecoinTransferService().VerifyIntentSignature(ctx, client, intent)
+
+		// then:
+		require.NoError(t, err)
+	})
+
+	t.Run("reject intent signed with other key", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t, true)
+		intent := newIntent()
+		signIntent(t, intent, otherKey)
+
+		// when:
+		err := client.StablecoinTransferService().VerifyIntentSignature(ctx, client, intent)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinSignatureInvalid)
+	})
+
+	t.Run("reject tampered intent", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t, true)
+		intent := newIntent()
+		signIntent(t, intent, senderKey)
+		intent.Amount = 1000
+
+		// when:
+		err := client.StablecoinTransferService().VerifyIntentSignature(ctx, client, intent)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinSignatureInvalid)
+	})
+
+	t.Run("reject unsigned intent when sender validation is enabled", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t, true)
+
+		// when:
+		err := client.StablecoinTransferService().VerifyIntentSignature(ctx, client, newIntent())
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinSignatureMissing)
+	})
+
+	t.Run("accept unsigned intent when sender validation is disabled", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t, false)
+
+		// when:
+		err := client.StablecoinTransferService().VerifyIntentSignature(ctx, client, newIntent())
+
+		// then:
+		require.NoError(t, err)
+	})
+
+	t.Run("verify transfer signed by the sender of the intent", func(t *testing.T) {
+		// given:
+		ctx, client := setup(t, true)
+		sti, err := CreateStablecoinTransferIntent(newIntent(), nil, nil, time.Minute, append(client.DefaultModelOptions(), New())...)
+		require.NoError(t, err)
+		require.NoError(t, sti.Save(ctx))
+
+		transfer := &Transfer{RefID: sti.ID, TxHex: "0100000000000000000000", SenderID: testStablecoinSender}
+		transfer.Signature, err = signer.Sign(ctx, testStablecoinSender, transfer.SigningMessage())
+		require.NoError(t, err)
+
+		// when:
+		err = client.StablecoinTransferService().VerifyTransferSignature(ctx, client, transfer)
+
+		// then:
+		require.NoError(t, err)
+
+		// and when:
+		transfer.SenderID = "mallory@" + testStablecoinSenderDomain
+		err = client.StablecoinTransferService().VerifyTransferSignature(ctx, client, transfer)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinSignatureInvalid)
+	})
+}
+
+func TestStablecoinTransferService_SendSignedTransferIntent(t *testing.T) {
+	// given:
+	ctx, client, paymailClient := newStablecoinTransferTestClient(t, WithStablecoinSigner(StablecoinSignerFunc(
+		func(_ context.Context, senderPaymail string, message []byte) (string, error) {
+			return senderPaymail + ":" + string(message), nil
+		},
+	)))
+	paymailClient.WillRespondWithStablecoinCapabilities()
+
+	var received Intent
+	paymailClient.WillRespondOnCapability(paymailclient.BRFCStablecoinTransferIntent).With(intentRecorder{intent: &received})
+
+	intent := Intent{SenderID: testStablecoinSender, ReceiverID: "bob@" + testStablecoinReceiverDomain, Nonce: "nonce", StablecoinID: testStablecoinID, Amount: 1}
+
+	// when:
+	_, err := client.StablecoinTransferService().SendTransferIntent(ctx, client, intent)
+
+	// then:
+	require.NoError(t, err)
+	assert.Equal(t, testStablecoinSender+":"+string(intent.SigningMessage()), received.Signature)
+}
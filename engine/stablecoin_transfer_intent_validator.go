@@ -6,64 +6,106 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 
 	"github.com/4chain-AG/gateway-overlay/pkg/token_engine/bsv21"
 	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/rs/zerolog"
 )
 
 // IntentValidator defines the interface for validating intents and retrieving transaction outputs.
 type IntentValidator interface {
-	ValidateSender(ctx context.Context, senderID string) error
-	GetTxOutputs(_ context.Context, intent *Intent) (txOutputs []*TransactionOutput, feeOutputs []*TransactionOutput, err error)
+	ValidateSender(ctx context.Context, intent *Intent) error
+	GetTxOutputs(ctx context.Context, intent *Intent) (txOutputs []*TransactionOutput, feeOutputs []*TransactionOutput, rules *gateway.StablecoinRule, err error)
 }
 
 type defaultValidator struct {
-	log *zerolog.Logger
-	c   ClientInterface
+	log      *zerolog.Logger
+	c        ClientInterface
+	policies []SenderPolicy
 }
 
 // NewDefaultIntentValidator creates a new instance of the default intent validator.
-func NewDefaultIntentValidator(log *zerolog.Logger, c ClientInterface) IntentValidator {
+// The sender is validated against the given policies, without any policy every sender is allowed.
+func NewDefaultIntentValidator(log *zerolog.Logger, c ClientInterface, policies ...SenderPolicy) IntentValidator {
 	l := log.With().Str("component", "default-intent-validator").Logger()
 	return &defaultValidator{
-		log: &l,
-		c:   c,
+		log:      &l,
+		c:        c,
+		policies: policies,
 	}
 }
 
-// ValidateSender validates the sender ID. Currently, it does not implement any validation logic and always returns nil.
-func (d defaultValidator) ValidateSender(_ context.Context, senderID string) error {
-	d.log.Debug().Str("senderID", senderID).Msg("Validating sender ID")
-	return nil // No validation logic implemented, always returns nil
+// ValidateSender checks the intent against all sender policies, every rejection is logged as an audit event.
+func (d defaultValidator) ValidateSender(ctx context.Context, intent *Intent) error {
+	d.log.Debug().Str("senderID", intent.SenderID).Msg("Validating sender ID")
+
+	for _, policy := range d.policies {
+		if err := policy.Check(ctx, intent); err != nil {
+			d.log.Warn().
+				Err(err).
+				AnErr("reason", errors.Unwrap(err)).
+				Str("audit", "stablecoin_sender_rejected").
+				Str("policy", policy.Name()).
+				Str("senderID", intent.SenderID).
+				Str("receiverID", intent.ReceiverID).
+				Str("stablecoinID", intent.StablecoinID).
+				Uint64("amount", intent.Amount).
+				Msg("Transfer intent rejected by sender policy")
+			return err
+		}
+	}
+
+	return nil
 }
 
 // GetTxOutputs Creates transaction outputs for the intent, including fee outputs if applicable.
-func (d defaultValidator) GetTxOutputs(_ context.Context, intent *Intent) (txOutputs []*TransactionOutput, feeOutputs []*TransactionOutput, err error) {
+// It also returns the stablecoin rules used for the fee calculation (nil for fee-free intents).
+func (d defaultValidator) GetTxOutputs(ctx context.Context, intent *Intent) (txOutputs []*TransactionOutput, feeOutputs []*TransactionOutput, rules *gateway.StablecoinRule, err error) {
 	d.log.Debug().Str("senderID", intent.SenderID).Msg("Getting fee outputs for intent")
-	txOutputs, feeOutputs, err = d.handleStablecoinOutputs(intent)
+	txOutputs, feeOutputs, rules, err = d.handleStablecoinOutputs(ctx, intent)
 	if err != nil {
 		d.log.Error().Err(err).Str("senderID", intent.SenderID).Msg("Failed to handle stablecoin fee")
-		return nil, nil, fmt.Errorf("failed to handle stablecoin fee: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to handle stablecoin fee: %w", err)
 	}
 	return
 }
 
-func (d defaultValidator) handleStablecoinOutputs(intent *Intent) (txOutputs []*TransactionOutput, feeOutputs []*TransactionOutput, err error) {
+func (d defaultValidator) handleStablecoinOutputs(ctx context.Context, intent *Intent) (txOutputs []*TransactionOutput, feeOutputs []*TransactionOutput, rules *gateway.StablecoinRule, err error) {
 	feeAmount := uint64(0)
 	feeIssuer := ""
 
-	// Check if the intent has a metadata key that indicates fee-free transactions
-	if _, ok := intent.Metadata[TransactionFeeFreeKey]; !ok {
-		rules, err := d.c.GatewayClient().GetStablecoinRules(intent.StablecoinID)
+	if intent.Operation != "" {
+		// issue and redeem are always checked against the emitter and are never charged with the fee
+		rules, err = d.c.GatewayClient().GetStablecoinRules(ctx, intent.StablecoinID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if err = validateOperation(intent, rules); err != nil {
+			d.log.Warn().
+				Err(err).
+				Str("audit", "stablecoin_operation_rejected").
+				Str("operation", string(intent.Operation)).
+				Str("senderID", intent.SenderID).
+				Str("receiverID", intent.ReceiverID).
+				Str("emitterID", rules.EmitterID).
+				Str("stablecoinID", intent.StablecoinID).
+				Msg("Stablecoin operation rejected")
+			return nil, nil, nil, err
+		}
+	} else if _, ok := intent.Metadata[TransactionFeeFreeKey]; !ok {
+		// Check if the intent has a metadata key that indicates fee-free transactions
+		rules, err = d.c.GatewayClient().GetStablecoinRules(ctx, intent.StablecoinID)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		if intent.ReceiverID != rules.EmitterID {
 			feeIssuer, feeAmount = d.getApplicableFee(rules.Fees, intent.Amount)
 			if intent.Amount <= feeAmount {
-				return nil, nil, errors.New("fee will cover all of the transfer")
+				return nil, nil, nil, errors.New("fee will cover all of the transfer")
 			}
 		}
 	}
@@ -81,18 +123,36 @@ func (d defaultValidator) handleStablecoinOutputs(intent *Intent) (txOutputs []*
 	// This function will also return the remaining banknotes
 	feeOutputs, remainingBanknotes, err = d.createFeeOutputsFromBanknotes(remainingBanknotes, feeIssuer, feeAmount)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create fee outputs: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create fee outputs: %w", err)
 	}
 
 	// Now, create the outputs for the receiver from the remaining banknotes
 	txOutputs, err = d.createReceiverOutputs(remainingBanknotes, intent.ReceiverID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create receiver outputs: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create receiver outputs: %w", err)
 	}
 
 	return
 }
 
+// validateOperation checks that the issue is sent by the emitter and the redeem is sent to the emitter
+func validateOperation(intent *Intent, rules *gateway.StablecoinRule) error {
+	switch intent.Operation {
+	case StablecoinOperationIssue:
+		if !strings.EqualFold(intent.SenderID, rules.EmitterID) {
+			return spverrors.ErrStablecoinEmitterOnly.Wrap(spverrors.Newf("issue sent by %s", intent.SenderID))
+		}
+	case StablecoinOperationRedeem:
+		if !strings.EqualFold(intent.ReceiverID, rules.EmitterID) {
+			return spverrors.ErrStablecoinEmitterOnly.Wrap(spverrors.Newf("redeem sent to %s", intent.ReceiverID))
+		}
+	default:
+		return spverrors.ErrStablecoinOperationInvalid.Wrap(spverrors.Newf("unknown operation %s", intent.Operation))
+	}
+
+	return nil
+}
+
 // createFeeOutputsFromBanknotes consumes banknotes to create outputs for a specific recipient
 // and returns the outputs and any remaining banknotes.
 func (d defaultValidator) createFeeOutputsFromBanknotes(banknotes []Banknote, to string, requiredAmount uint64) ([]*TransactionOutput, []Banknote, error) {
@@ -0,0 +1,264 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/bsv-blockchain/go-sdk/script"
+	trx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/bsv-blockchain/go-sdk/transaction/template/p2pkh"
+	"github.com/pkg/errors"
+)
+
+// TransferOutputMismatchField is the part of the output which differs from the intent
+type TransferOutputMismatchField string
+
+const (
+	// TransferOutputMissing is when the transaction has no output at the expected index
+	TransferOutputMissing TransferOutputMismatchField = "missing"
+	// TransferOutputSatoshis is when the output satoshis differ from the intent
+	TransferOutputSatoshis TransferOutputMismatchField = "satoshis"
+	// TransferOutputScript is when the output does not carry the exact token script of the intent
+	TransferOutputScript TransferOutputMismatchField = "script"
+	// TransferOutputSerial is when the output transfers a different token than the intent
+	TransferOutputSerial TransferOutputMismatchField = "serial"
+	// TransferOutputAmount is when the output transfers a different amount of the token than the intent
+	TransferOutputAmount TransferOutputMismatchField = "amount"
+	// TransferOutputRecipient is when the output is not locked to the receiver of the intent
+	TransferOutputRecipient TransferOutputMismatchField = "recipient"
+)
+
+// TransferOutputMismatch describes a single difference between the intent output and the transaction output
+type TransferOutputMismatch struct {
+	Vout     int                         `json:"vout"`
+	Field    TransferOutputMismatchField `json:"field"`
+	Expected string                      `json:"expected"`
+	Actual   string                      `json:"actual"`
+}
+
+// String returns the human-readable description of the mismatch
+func (m TransferOutputMismatch) String() string {
+	return fmt.Sprintf("vout %d %s: expected %s, got %s", m.Vout, m.Field, m.Expected, m.Actual)
+}
+
+// TransferOutputsMismatchError is returned when the transfer transaction does not match the outputs of the intent
+// It is an extended error, so the mismatches are returned in the HTTP response as well.
+type TransferOutputsMismatchError struct {
+	Mismatches []TransferOutputMismatch `json:"mismatches"`
+}
+
+// Error returns the list of all mismatches
+func (e *TransferOutputsMismatchError) Error() string {
+	descriptions := make([]string, 0, len(e.Mismatches))
+	for _, mismatch := range e.Mismatches {
+		descriptions = append(descriptions, mismatch.String())
+	}
+	return fmt.Sprintf("%s: %s", spverrors.ErrTransferOutputsMismatch.Message, strings.Join(descriptions, "; "))
+}
+
+// GetCode returns the error code
+func (e *TransferOutputsMismatchError) GetCode() string {
+	return spverrors.ErrTransferOutputsMismatch.Code
+}
+
+// GetMessage returns the error message with the list of all mismatches
+func (e *TransferOutputsMismatchError) GetMessage() string {
+	return e.Error()
+}
+
+// GetStatusCode returns the HTTP status code
+func (e *TransferOutputsMismatchError) GetStatusCode() int {
+	return spverrors.ErrTransferOutputsMismatch.StatusCode
+}
+
+// StackTrace returns the error's stack trace, the mismatch error has none
+func (e *TransferOutputsMismatchError) StackTrace() errors.StackTrace {
+	return nil
+}
+
+// Is makes the error match spverrors.ErrTransferOutputsMismatch
+func (e *TransferOutputsMismatchError) Is(target error) bool {
+	return spverrors.ErrTransferOutputsMismatch.Is(target)
+}
+
+// transferOutputIndexes returns the vouts at which the transfer and the fee outputs of the intent are expected in the transaction
+// The outputs of the intent are stored in the order of the vouts (receiver outputs first, then fee outputs).
+func transferOutputIndexes(outputs TransactionOutputs) (transferIndexes, feeIndexes []int) {
+	transferIndexes = make([]int, 0, len(outputs))
+	feeIndexes = make([]int, 0)
+	for vout, out := range outputs {
+		if out.TokenFee {
+			feeIndexes = append(feeIndexes, vout)
+		} else {
+			transferIndexes = append(transferIndexes, vout)
+		}
+	}
+	return
+}
+
+// validateTransferOutputs checks that every intent output has exactly one matching output in the transaction.
+// The intent output at index i must be at vout i in the transaction, with the same satoshis and token script.
+// The transaction output script is the receiver's locking script followed by the token script of the intent,
+// for the receiver outputs the locking script must belong to the receiver of the intent,
+// for the fee outputs sent to the address it must be the P2PKH locking script of the address.
+func validateTransferOutputs(ctx context.Context, c ClientInterface, intent *StablecoinTransferIntent, tx *trx.Transaction) error {
+	receiverXpubID, err := getTransferReceiverXpubID(ctx, c, intent.ReceiverID)
+	if err != nil {
+		return err
+	}
+
+	mismatches := make([]TransferOutputMismatch, 0)
+	transferIndexes, feeIndexes := transferOutputIndexes(intent.Outputs)
+
+	for _, vout := range transferIndexes {
+		outMismatches, err := compareTransferOutput(ctx, c, vout, intent.Outputs[vout], tx, receiverXpubID, intent.ReceiverID)
+		if err != nil {
+			return err
+		}
+		mismatches = append(mismatches, outMismatches...)
+	}
+
+	for _, vout := range feeIndexes {
+		// fee recipients are usually hosted by other wallets, so only the fee sent to the address can be verified by its locking script
+		outMismatches, err := compareTransferOutput(ctx, c, vout, intent.Outputs[vout], tx, "", "")
+		if err != nil {
+			return err
+		}
+		mismatches = append(mismatches, outMismatches...)
+	}
+
+	if len(mismatches) > 0 {
+		return &TransferOutputsMismatchError{Mismatches: mismatches}
+	}
+
+	return nil
+}
+
+// compareTransferOutput compares the intent output with the transaction output at the given vout,
+// the recipient is checked against the receiverXpubID if provided, otherwise against the address of the fee output
+func compareTransferOutput(ctx context.Context, c ClientInterface, vout int, expected *TransactionOutput, tx *trx.Transaction, receiverXpubID, receiverID string) ([]TransferOutputMismatch, error) {
+	if vout >= len(tx.Outputs) {
+		return []TransferOutputMismatch{{
+			Vout:     vout,
+			Field:    TransferOutputMissing,
+			Expected: "output",
+			Actual:   fmt.Sprintf("%d outputs", len(tx.Outputs)),
+		}}, nil
+	}
+
+	mismatches := make([]TransferOutputMismatch, 0)
+	actual := tx.Outputs[vout]
+
+	if actual.Satoshis != expected.Satoshis {
+		mismatches = append(mismatches, TransferOutputMismatch{
+			Vout:     vout,
+			Field:    TransferOutputSatoshis,
+			Expected: fmt.Sprintf("%d", expected.Satoshis),
+			Actual:   fmt.Sprintf("%d", actual.Satoshis),
+		})
+	}
+
+	expectedScript, err := script.NewFromHex(expected.Script)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to parse the script of the intent output %d", vout)
+	}
+	expectedOperation, err := tokens.ParseTokenScript(expectedScript)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get token operation from the intent output %d", vout)
+	}
+
+	actualOperation, err := tokens.ParseTokenScript(actual.LockingScript)
+	if err != nil {
+		return append(mismatches, TransferOutputMismatch{
+			Vout:     vout,
+			Field:    TransferOutputScript,
+			Expected: expected.Script,
+			Actual:   actual.LockingScript.String(),
+		}), nil
+	}
+
+	if actualOperation.ID != expectedOperation.ID {
+		mismatches = append(mismatches, TransferOutputMismatch{
+			Vout:     vout,
+			Field:    TransferOutputSerial,
+			Expected: string(expectedOperation.ID),
+			Actual:   string(actualOperation.ID),
+		})
+	}
+	if actualOperation.Amount != expectedOperation.Amount {
+		mismatches = append(mismatches, TransferOutputMismatch{
+			Vout:     vout,
+			Field:    TransferOutputAmount,
+			Expected: fmt.Sprintf("%d", expectedOperation.Amount),
+			Actual:   fmt.Sprintf("%d", actualOperation.Amount),
+		})
+	}
+
+	actualScript := actual.LockingScript.String()
+	lockingScript, found := strings.CutSuffix(actualScript, expected.Script)
+	if !found {
+		return append(mismatches, TransferOutputMismatch{
+			Vout:     vout,
+			Field:    TransferOutputScript,
+			Expected: expected.Script,
+			Actual:   actualScript,
+		}), nil
+	}
+
+	if receiverXpubID == "" {
+		if mismatch := feeRecipientMismatch(vout, expected.To, lockingScript); mismatch != nil {
+			mismatches = append(mismatches, *mismatch)
+		}
+		return mismatches, nil
+	}
+
+	destination, err := getDestinationByLockingScript(ctx, lockingScript, c.DefaultModelOptions()...)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get destination of the output %d", vout)
+	}
+	if destination == nil || destination.XpubID != receiverXpubID {
+		mismatches = append(mismatches, TransferOutputMismatch{
+			Vout:     vout,
+			Field:    TransferOutputRecipient,
+			Expected: receiverID,
+			Actual:   lockingScript,
+		})
+	}
+
+	return mismatches, nil
+}
+
+// feeRecipientMismatch returns the mismatch if the fee output sent to the address is not locked to the address,
+// the fee output sent to the paymail is not checked
+func feeRecipientMismatch(vout int, to, lockingScript string) *TransferOutputMismatch {
+	address, err := script.NewAddressFromString(to)
+	if err != nil {
+		return nil
+	}
+
+	expected, err := p2pkh.Lock(address)
+	if err != nil || expected.String() == lockingScript {
+		return nil
+	}
+
+	return &TransferOutputMismatch{
+		Vout:     vout,
+		Field:    TransferOutputRecipient,
+		Expected: to,
+		Actual:   lockingScript,
+	}
+}
+
+func getTransferReceiverXpubID(ctx context.Context, c ClientInterface, receiverID string) (string, error) {
+	receiver, err := getPaymailAddress(ctx, receiverID, c.DefaultModelOptions()...)
+	if err != nil {
+		return "", spverrors.Wrapf(err, "failed to get receiver paymail %s", receiverID)
+	}
+	if receiver == nil {
+		return "", spverrors.ErrCouldNotFindPaymail
+	}
+	return receiver.XpubID, nil
+}
@@ -0,0 +1,208 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/4chain-AG/gateway-overlay/pkg/token_engine/bsv21"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"github.com/bsv-blockchain/go-sdk/script"
+	trx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTransferReceiver     = "bob@example.com"
+	testOtherLockingScript   = "76a914a5f271385e75f57bcd9092592dede812f8c466d588ac"
+	testFeeRecipientPaymail  = "fee@example.com"
+	testTransferSerial       = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"
+	testOtherTransferSerial  = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_1"
+	testTransferAmount       = uint64(100)
+	testTransferFeeAmount    = uint64(1)
+	testTransferOutputAmount = testTransferAmount - testTransferFeeAmount
+)
+
+func TestValidateTransferOutputs(t *testing.T) {
+	tokenScript := func(t *testing.T, serial string, amount uint64) string {
+		s, err := bsv21.NewBsv21Transfer(bsv21.TokenID(serial), amount)
+		require.NoError(t, err)
+		return s.String()
+	}
+
+	newIntent := func(t *testing.T) *StablecoinTransferIntent {
+		return &StablecoinTransferIntent{
+			ReceiverID: testTransferReceiver,
+			Outputs: TransactionOutputs{
+				{Satoshis: 1, Script: tokenScript(t, testTransferSerial, testTransferOutputAmount), To: testTransferReceiver, Token: true},
+				{Satoshis: 1, Script: tokenScript(t, testTransferSerial, testTransferFeeAmount), To: testFeeRecipientPaymail, Token: true, TokenFee: true},
+			},
+		}
+	}
+
+	newTx := func(outputs ...*trx.TransactionOutput) *trx.Transaction {
+		tx := trx.NewTransaction()
+		for _, out := range outputs {
+			tx.AddOutput(out)
+		}
+		return tx
+	}
+
+	txOutput := func(t *testing.T, lockingScript string, satoshis uint64, serial string, amount uint64) *trx.TransactionOutput {
+		s, err := script.NewFromHex(lockingScript + tokenScript(t, serial, amount))
+		require.NoError(t, err)
+		return &trx.TransactionOutput{LockingScript: s, Satoshis: satoshis}
+	}
+
+	prepareReceiver := func(t *testing.T, ctx context.Context, client ClientInterface) {
+		p := newPaymail(testTransferReceiver, 0, append(client.DefaultModelOptions(), New(), WithXPub(testXPub), WithEncryptionKey(testEncryption))...)
+		require.NoError(t, p.Save(ctx))
+
+		destination := newDestination(utils.Hash(testXPub), testLockingScript, append(client.DefaultModelOptions(), New())...)
+		require.NoError(t, destination.Save(ctx))
+	}
+
+	t.Run("accept transaction matching the intent", func(t *testing.T) {
+		// given:
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		prepareReceiver(t, ctx, client)
+
+		tx := newTx(
+			txOutput(t, testLockingScript, 1, testTransferSerial, testTransferOutputAmount),
+			txOutput(t, testOtherLockingScript, 1, testTransferSerial, testTransferFeeAmount),
+		)
+
+		// when:
+		err := validateTransferOutputs(ctx, client, newIntent(t), tx)
+
+		// then:
+		require.NoError(t, err)
+	})
+
+	t.Run("list all mismatches", func(t *testing.T) {
+		// given:
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		prepareReceiver(t, ctx, client)
+
+		tx := newTx(
+			txOutput(t, testOtherLockingScript, 2, testTransferSerial, testTransferAmount),
+		)
+
+		// when:
+		err := validateTransferOutputs(ctx, client, newIntent(t), tx)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTransferOutputsMismatch)
+
+		var mismatchErr *TransferOutputsMismatchError
+		require.True(t, errors.As(err, &mismatchErr))
+
+		fields := make([]TransferOutputMismatchField, 0, len(mismatchErr.Mismatches))
+		for _, mismatch := range mismatchErr.Mismatches {
+			fields = append(fields, mismatch.Field)
+		}
+		assert.Equal(t, []TransferOutputMismatchField{
+			TransferOutputSatoshis,
+			TransferOutputAmount,
+			TransferOutputScript,
+			TransferOutputMissing,
+		}, fields)
+	})
+
+	t.Run("reject outputs swapped between the receiver and the fee recipient", func(t *testing.T) {
+		// given:
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		prepareReceiver(t, ctx, client)
+
+		tx := newTx(
+			txOutput(t, testOtherLockingScript, 1, testTransferSerial, testTransferOutputAmount),
+			txOutput(t, testLockingScript, 1, testTransferSerial, testTransferFeeAmount),
+		)
+
+		// when:
+		err := validateTransferOutputs(ctx, client, newIntent(t), tx)
+
+		// then:
+		var mismatchErr *TransferOutputsMismatchError
+		require.True(t, errors.As(err, &mismatchErr))
+		require.Len(t, mismatchErr.Mismatches, 1)
+		assert.Equal(t, TransferOutputRecipient, mismatchErr.Mismatches[0].Field)
+		assert.Equal(t, 0, mismatchErr.Mismatches[0].Vout)
+	})
+
+	t.Run("reject fee redirected from the fee address", func(t *testing.T) {
+		// given:
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		prepareReceiver(t, ctx, client)
+
+		feeScript, err := script.NewFromHex(testOtherLockingScript)
+		require.NoError(t, err)
+		feeAddress, err := feeScript.Address()
+		require.NoError(t, err)
+
+		intent := newIntent(t)
+		intent.Outputs[1].To = feeAddress.AddressString
+
+		// when:
+		err = validateTransferOutputs(ctx, client, intent, newTx(
+			txOutput(t, testLockingScript, 1, testTransferSerial, testTransferOutputAmount),
+			txOutput(t, testOtherLockingScript, 1, testTransferSerial, testTransferFeeAmount),
+		))
+
+		// then:
+		require.NoError(t, err)
+
+		// when:
+		err = validateTransferOutputs(ctx, client, intent, newTx(
+			txOutput(t, testLockingScript, 1, testTransferSerial, testTransferOutputAmount),
+			txOutput(t, testLockingScript, 1, testTransferSerial, testTransferFeeAmount),
+		))
+
+		// then:
+		var mismatchErr *TransferOutputsMismatchError
+		require.True(t, errors.As(err, &mismatchErr))
+		require.Len(t, mismatchErr.Mismatches, 1)
+		assert.Equal(t, TransferOutputRecipient, mismatchErr.Mismatches[0].Field)
+		assert.Equal(t, 1, mismatchErr.Mismatches[0].Vout)
+		assert.Equal(t, feeAddress.AddressString, mismatchErr.Mismatches[0].Expected)
+	})
+
+	t.Run("reject different token", func(t *testing.T) {
+		// given:
+		ctx, client, deferMe := CreateTestSQLiteClient(t, false, true, withTaskManagerMockup())
+		defer deferMe()
+		prepareReceiver(t, ctx, client)
+
+		tx := newTx(
+			txOutput(t, testLockingScript, 1, testOtherTransferSerial, testTransferOutputAmount),
+			txOutput(t, testOtherLockingScript, 1, testTransferSerial, testTransferFeeAmount),
+		)
+
+		// when:
+		err := validateTransferOutputs(ctx, client, newIntent(t), tx)
+
+		// then:
+		var mismatchErr *TransferOutputsMismatchError
+		require.True(t, errors.As(err, &mismatchErr))
+		assert.Equal(t, TransferOutputSerial, mismatchErr.Mismatches[0].Field)
+	})
+}
+
+func TestTransferOutputIndexes(t *testing.T) {
+	t.Parallel()
+
+	transferIndexes, feeIndexes := transferOutputIndexes(TransactionOutputs{
+		{To: testTransferReceiver},
+		{To: testTransferReceiver},
+		{To: testFeeRecipientPaymail, TokenFee: true},
+	})
+
+	assert.Equal(t, []int{0, 1}, transferIndexes)
+	assert.Equal(t, []int{2}, feeIndexes)
+}
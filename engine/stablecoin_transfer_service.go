@@ -4,13 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"reflect"
+	"strings"
+	"time"
 
-	"github.com/4chain-AG/gateway-overlay/pkg/token_engine/bsv21"
-	"github.com/bitcoin-sv/go-paymail"
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
+	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/utils"
-	"github.com/bsv-blockchain/go-sdk/script"
 	trx "github.com/bsv-blockchain/go-sdk/transaction"
 	"github.com/go-resty/resty/v2"
 	"github.com/rs/zerolog"
@@ -25,6 +26,15 @@ type Intent struct {
 	Amount       uint64     `json:"amount" example:"1000000"`
 	Banknotes    []Banknote `json:"banknotes"`
 	Metadata     Metadata   `json:"metadata" swaggertype:"object,string" example:"key:value,key2:value2"`
+
+	// Operation is the issue or redeem performed with the emitter, empty for the regular transfer
+	Operation StablecoinOperationType `json:"operation,omitempty" example:"redeem"`
+
+	// Signature is the compact signature of the intent made with the sender's paymail PKI key (base64)
+	Signature string `json:"signature,omitempty" example:"H+zZ..."`
+
+	// volumeDay is the day of the volume reserved for the intent by the daily volume policy, empty if nothing has been reserved
+	volumeDay string
 }
 
 // ValidationResponse is the model for the response of a transfer intent validation
@@ -40,21 +50,38 @@ type Transfer struct {
 	RefID string `json:"refId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5"`
 	TxHex string `json:"txHex" example:"0100000001..."`
 
-	// SpecialOperation is used to indicate special operations like issue or redeem
-	SpecialOperation string `json:"_,omitempty" example:"issue/redeem"`
+	// SenderID is the paymail of the sender, it is required to verify the signature of the transfer without the intent
+	SenderID string `json:"senderId,omitempty" example:"alice@spv-wallet.com"`
+	// Signature is the compact signature of the transfer made with the sender's paymail PKI key (base64)
+	Signature string `json:"signature,omitempty" example:"H+zZ..."`
 }
 
 // StablecoinTransferService provides methods to validate and send transfer intents
 type StablecoinTransferService struct {
-	log       *zerolog.Logger
-	validator IntentValidator
+	log        *zerolog.Logger
+	validator  IntentValidator
+	intentTTL  time.Duration
+	httpClient *resty.Client
+	signer     StablecoinSigner
+
+	broadcastPolicy config.OverlayBroadcastPolicy
 }
 
 // NewStablecoinTransferService creates a new instance of TransferService with the provided validator and logger
-func NewStablecoinTransferService(validator IntentValidator, log *zerolog.Logger) *StablecoinTransferService {
+// intentTTL is the time for which the created transfer intents wait for the transfer,
+// httpClient is used to send the transfer intents and the transfers to the receivers' paymail hosts,
+// signer (optional) signs them on behalf of the sender,
+// broadcastPolicy tells whether the token transaction not yet registered in the overlay is broadcast
+func NewStablecoinTransferService(validator IntentValidator, intentTTL time.Duration, httpClient *resty.Client, signer StablecoinSigner,
+	broadcastPolicy config.OverlayBroadcastPolicy, log *zerolog.Logger,
+) *StablecoinTransferService {
 	return &StablecoinTransferService{
-		log:       log,
-		validator: validator,
+		log:             log,
+		validator:       validator,
+		intentTTL:       intentTTL,
+		httpClient:      httpClient,
+		signer:          signer,
+		broadcastPolicy: broadcastPolicy,
 	}
 }
 
@@ -62,28 +89,38 @@ func NewStablecoinTransferService(validator IntentValidator, log *zerolog.Logger
 func (s *StablecoinTransferService) ValidateIntent(ctx context.Context, c ClientInterface, intent *Intent) (*ValidationResponse, error) {
 	s.log.Debug().Str("senderID", intent.SenderID).Msg("Validating transfer intent")
 
-	if err := s.validator.ValidateSender(ctx, intent.SenderID); err != nil {
+	if err := s.validator.ValidateSender(ctx, intent); err != nil {
 		s.log.Error().Err(err).Str("senderID", intent.SenderID).Msg("Sender validation failed")
+		// the policy checked after the daily volume one could have rejected the sender, so the reserved volume is given back
+		s.releaseRejectedIntentDailyVolume(ctx, c, intent)
 		return nil, err
 	}
 
-	txOutputs, feeOutputs, err := s.validator.GetTxOutputs(ctx, intent)
+	resp, err := s.createIntent(ctx, c, intent)
 	if err != nil {
-		s.log.Error().Err(err).Str("senderID", intent.SenderID).Msg("Failed to get fee outputs")
+		// the intent has not been created, so the volume reserved by the sender policy is given back
+		s.releaseRejectedIntentDailyVolume(ctx, c, intent)
 		return nil, err
 	}
-	transferIndexes := make([]int, 0, len(txOutputs))
-	for vout, _ := range txOutputs {
-		transferIndexes = append(transferIndexes, vout)
-	}
-	feeIndexes := make([]int, 0, len(feeOutputs))
-	for vout, _ := range feeOutputs {
-		feeIndexes = append(feeIndexes, vout+len(txOutputs))
+
+	return resp, nil
+}
+
+func (s *StablecoinTransferService) releaseRejectedIntentDailyVolume(ctx context.Context, c ClientInterface, intent *Intent) {
+	if err := releaseIntentDailyVolume(ctx, c, intent.SenderID, intent.ReceiverID, intent.StablecoinID, intent.volumeDay, intent.Amount); err != nil {
+		s.log.Error().Err(err).Str("senderID", intent.SenderID).Msg("Failed to release daily volume of the rejected intent")
 	}
+}
 
+func (s *StablecoinTransferService) createIntent(ctx context.Context, c ClientInterface, intent *Intent) (*ValidationResponse, error) {
+	txOutputs, feeOutputs, rules, err := s.validator.GetTxOutputs(ctx, intent)
+	if err != nil {
+		s.log.Error().Err(err).Str("senderID", intent.SenderID).Msg("Failed to get fee outputs")
+		return nil, err
+	}
 	opts := []ModelOps{WithClient(c)}
 	outputs := append(txOutputs, feeOutputs...)
-	sti, err := CreateStablecoinTransferIntent(intent, outputs, c.DefaultModelOptions(append(opts, New())...)...)
+	sti, err := CreateStablecoinTransferIntent(intent, outputs, rules, s.intentTTL, c.DefaultModelOptions(append(opts, New())...)...)
 	if err != nil {
 		s.log.Error().Err(err).Str("senderID", intent.SenderID).Msg("Failed to create transfer intent")
 		return nil, fmt.Errorf("failed to create transfer intent: %w", err)
@@ -95,6 +132,9 @@ func (s *StablecoinTransferService) ValidateIntent(ctx context.Context, c Client
 		return nil, fmt.Errorf("failed to save transfer intent: %w", err)
 	}
 
+	// the same indexes are used to validate the transfer, so the transaction has to contain the outputs at these positions
+	transferIndexes, feeIndexes := transferOutputIndexes(sti.Outputs)
+
 	return &ValidationResponse{
 		Nonce:           sti.Nonce,
 		Outputs:         outputs,
@@ -105,41 +145,81 @@ func (s *StablecoinTransferService) ValidateIntent(ctx context.Context, c Client
 
 // ValidateTransfer validates the transfer by comparing the scripts in the transfer intent with the transaction outputs
 func (s *StablecoinTransferService) ValidateTransfer(c ClientInterface, transfer Transfer) error {
+	_, err := s.validateTransfer(context.Background(), c, transfer)
+	return err
+}
+
+func (s *StablecoinTransferService) validateTransfer(ctx context.Context, c ClientInterface, transfer Transfer) (*StablecoinTransferIntent, error) {
 	tx, err := trx.NewTransactionFromHex(transfer.TxHex)
 	if err != nil {
-		return spverrors.ErrInvalidHex
+		return nil, spverrors.ErrInvalidHex
 	}
 
-	sti, err := getStablecoinTransferIntentByID(context.Background(), transfer.RefID, c.DefaultModelOptions()...)
+	sti, err := getStablecoinTransferIntentByID(ctx, transfer.RefID, c.DefaultModelOptions()...)
 	if err != nil {
-		return fmt.Errorf("error getting transfer intent: %w", err)
+		return nil, fmt.Errorf("error getting transfer intent: %w", err)
+	}
+
+	// issue and redeem are transferred with the intent as well, so every transfer has to reference one
+	if sti == nil {
+		return nil, spverrors.ErrTransferIntentNotFound
+	}
+
+	if err = sti.checkUsable(); err != nil {
+		s.log.Warn().Err(err).Str("refID", transfer.RefID).Str("status", string(sti.Status)).Msg("Transfer intent cannot be used")
+		return nil, err
 	}
 
-	err = compareScripts(sti, tx, transfer)
+	err = validateTransferOutputs(ctx, c, sti, tx)
 	if err != nil {
 		s.log.Error().Err(err).Str("refID", transfer.RefID).Msg("Transfer validation failed")
-		return fmt.Errorf("transfer validation failed: %w", err)
+		return nil, fmt.Errorf("transfer validation failed: %w", err)
 	}
 
-	return nil
+	return sti, nil
 }
 
-// SendTransferIntent sends the transfer intent to the receiver's paymail server for validation
-func (s *StablecoinTransferService) SendTransferIntent(intent Intent) (*ValidationResponse, error) {
-	_, domain, _ := paymail.SanitizePaymail(intent.ReceiverID)
-	path := "bsvalias/transfer-intent"
-	url := fmt.Sprintf("https://%s/%s", domain, path)
+// GetTransferReceiver returns the receiver of the transfer intent referenced by the transfer
+func (s *StablecoinTransferService) GetTransferReceiver(ctx context.Context, c ClientInterface, transfer *Transfer) (string, error) {
+	sti, err := getStablecoinTransferIntentByID(ctx, transfer.RefID, c.DefaultModelOptions()...)
+	if err != nil {
+		return "", spverrors.Wrapf(err, "failed to get transfer intent")
+	}
+	if sti == nil {
+		return "", spverrors.ErrTransferIntentNotFound
+	}
+
+	return sti.ReceiverID, nil
+}
+
+// SendTransferIntent sends the transfer intent to the receiver's paymail host for validation
+// The endpoint is resolved from the capabilities of the receiver's paymail domain.
+func (s *StablecoinTransferService) SendTransferIntent(ctx context.Context, c ClientInterface, intent Intent) (*ValidationResponse, error) {
+	url, err := resolveStablecoinCapabilityURL(ctx, c, intent.ReceiverID, paymailclient.BRFCStablecoinTransferIntent)
+	if err != nil {
+		s.log.Error().Err(err).Str("receiverID", intent.ReceiverID).Msg("Failed to resolve transfer intent endpoint")
+		return nil, err
+	}
+
+	if err = s.signIntent(ctx, &intent); err != nil {
+		s.log.Error().Err(err).Str("senderID", intent.SenderID).Msg("Failed to sign transfer intent")
+		return nil, err
+	}
 
-	httpClient := resty.New()
-	resp, err := httpClient.R().
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
 		SetBody(intent).
 		Post(url)
-
 	if err != nil {
 		s.log.Error().Err(err).Str("receiverID", intent.ReceiverID).Msg("Failed to send transfer intent")
 		return nil, fmt.Errorf("failed to send transfer intent: %w", err)
 	}
 
+	if resp.IsError() {
+		s.log.Error().Str("receiverID", intent.ReceiverID).Int("statusCode", resp.StatusCode()).Msg("Receiver rejected transfer intent")
+		return nil, spverrors.ErrStablecoinReceiverRejected.Wrap(spverrors.Newf("receiver responded with status code %d: %s", resp.StatusCode(), resp.String()))
+	}
+
 	var vr ValidationResponse
 	if err = json.Unmarshal(resp.Body(), &vr); err != nil {
 		s.log.Error().Err(err).Str("receiverID", intent.ReceiverID).Msg("Failed to unmarshal validation response")
@@ -149,33 +229,48 @@ func (s *StablecoinTransferService) SendTransferIntent(intent Intent) (*Validati
 	return &vr, nil
 }
 
-// SendTransfer sends the transfer to the receiver's paymail server for validation
-func (s *StablecoinTransferService) SendTransfer(receiverDomain string, transfer Transfer) error {
-	path := "bsvalias/transfer"
-	url := fmt.Sprintf("https://%s/%s", receiverDomain, path)
+// SendTransfer sends the transfer to the receiver's paymail host
+// The endpoint is resolved from the capabilities of the receiver's paymail domain.
+func (s *StablecoinTransferService) SendTransfer(ctx context.Context, c ClientInterface, receiverPaymail string, transfer Transfer) error {
+	url, err := resolveStablecoinCapabilityURL(ctx, c, receiverPaymail, paymailclient.BRFCStablecoinTransfer)
+	if err != nil {
+		s.log.Error().Err(err).Str("receiver", receiverPaymail).Msg("Failed to resolve transfer endpoint")
+		return err
+	}
+
+	if err = s.signTransfer(ctx, &transfer); err != nil {
+		s.log.Error().Err(err).Str("senderID", transfer.SenderID).Msg("Failed to sign transfer")
+		return err
+	}
 
-	httpClient := resty.New()
-	resp, err := httpClient.R().
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
 		SetBody(transfer).
 		Post(url)
-
 	if err != nil {
-		s.log.Error().Err(err).Str("receiverDomain", receiverDomain).Msg("Failed to send transfer")
+		s.log.Error().Err(err).Str("receiver", receiverPaymail).Msg("Failed to send transfer")
 		return fmt.Errorf("failed to send transfer: %w", err)
 	}
 
-	if resp.StatusCode() != 200 {
-		s.log.Error().Str("receiverDomain", receiverDomain).Int("statusCode", resp.StatusCode()).
-			Msg("Failed to send transfer, received non-200 status code")
-		return fmt.Errorf("failed to send transfer, received status code: %d", resp.StatusCode())
+	if resp.IsError() {
+		s.log.Error().Str("receiver", receiverPaymail).Int("statusCode", resp.StatusCode()).Msg("Receiver rejected transfer")
+		return spverrors.ErrStablecoinReceiverRejected.Wrap(spverrors.Newf("receiver responded with status code %d: %s", resp.StatusCode(), resp.String()))
 	}
 
 	return nil
 }
 
 // IncomingTransfer processes an incoming transfer by validating it, creating a transaction from the hex, and recording it
+// The referenced transfer intent is consumed by the transfer, so it cannot be replayed with the same RefID.
 func (s *StablecoinTransferService) IncomingTransfer(ctx context.Context, c ClientInterface, transfer Transfer) (*Transaction, error) {
-	err := s.ValidateTransfer(c, transfer)
+	unlock, err := newWriteLock(ctx, fmt.Sprintf(lockKeyProcessTransferIntent, transfer.RefID), c.Cachestore())
+	defer unlock()
+	if err != nil {
+		s.log.Warn().Err(err).Str("refID", transfer.RefID).Msg("Transfer for the intent is already being processed")
+		return nil, spverrors.ErrTransferIntentInProgress.Wrap(err)
+	}
+
+	sti, err := s.validateTransfer(ctx, c, transfer)
 	if err != nil {
 		s.log.Error().Err(err).Str("refID", transfer.RefID).Msg("Transfer validation failed")
 		return nil, spverrors.Wrapf(err, "transfer validation failed")
@@ -192,74 +287,226 @@ func (s *StablecoinTransferService) IncomingTransfer(ctx context.Context, c Clie
 		return nil, spverrors.Wrapf(err, "failed to get incoming transaction record strategy")
 	}
 
-	transaction, err := recordTransaction(ctx, c, rts, WithMetadatas(map[string]interface{}{"transfer": transfer}))
+	metadata := map[string]interface{}{
+		"transfer": transfer,
+		// the stablecoin of the received banknotes is known only from the intent
+		TransactionConfigKey: tokenTransactionConfig{StablecoinID: sti.StablecoinID},
+	}
+
+	transaction, err := recordTransaction(ctx, c, rts, WithMetadatas(metadata))
+	if err != nil {
+		return nil, err
+	}
+
+	// the gateway notification is enqueued in the same DB transaction which consumes the intent
+	sti.gatewayNotification, err = s.newGatewayNotification(ctx, c, gateway.TransferIncoming, transfer.RefID, sdkTx, sti.StablecoinID, sti.Outputs)
 	if err != nil {
+		s.log.Error().Err(err).Str("refID", transfer.RefID).Str("txID", transaction.ID).Msg("Failed to prepare gateway notification")
 		return nil, err
 	}
 
+	sti.markConsumed(transaction.ID)
+	if err = sti.Save(ctx); err != nil {
+		s.log.Error().Err(err).Str("refID", transfer.RefID).Str("txID", transaction.ID).Msg("Failed to mark transfer intent as consumed")
+		return nil, spverrors.Wrapf(err, "failed to mark transfer intent as consumed")
+	}
+
+	if sti.Operation != "" {
+		c.StablecoinOperationService().recordReceivedOperation(ctx, c, sti)
+	}
+
 	return transaction, nil
 }
 
-// NotifyGatewayAboutTransfer is a placeholder method for notifying the gateway about the transfer
-func (s *StablecoinTransferService) NotifyGatewayAboutTransfer() {
-	// This method is a placeholder for notifying the gateway about the transfer.
-	// The implementation will depend on the specific requirements and architecture of the system.
-	s.log.Info().Msg("NotifyGatewayAboutTransfer method called, but not implemented yet.")
+// CancelTransferIntent cancels the pending transfer intent addressed to the paymail of the given xPub, so it cannot be used by the transfer anymore
+func (s *StablecoinTransferService) CancelTransferIntent(ctx context.Context, c ClientInterface, xPubID, refID string) error {
+	unlock, err := newWriteLock(ctx, fmt.Sprintf(lockKeyProcessTransferIntent, refID), c.Cachestore())
+	defer unlock()
+	if err != nil {
+		return spverrors.ErrTransferIntentInProgress.Wrap(err)
+	}
+
+	sti, err := getStablecoinTransferIntentByID(ctx, refID, c.DefaultModelOptions()...)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get transfer intent")
+	}
+	if sti == nil {
+		return spverrors.ErrTransferIntentNotFound
+	}
+
+	receiver, err := getPaymailAddress(ctx, sti.ReceiverID, c.DefaultModelOptions()...)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get receiver paymail of transfer intent")
+	}
+	// the intents of other users are reported as not found, so their existence is not revealed
+	if receiver == nil || receiver.XpubID != xPubID {
+		return spverrors.ErrTransferIntentNotFound
+	}
+
+	if err = sti.checkUsable(); err != nil {
+		return err
+	}
+
+	sti.markCanceled()
+	if err = sti.Save(ctx); err != nil {
+		return spverrors.Wrapf(err, "failed to save canceled transfer intent")
+	}
+
+	if err = sti.releaseDailyVolume(ctx); err != nil {
+		return err
+	}
+
+	s.log.Info().Str("refID", refID).Msg("Transfer intent canceled")
+	return nil
 }
 
-// compareScripts compares the scripts in the transfer intent with the transaction outputs
-// it is required for tx to contain all outputs from the intent
-func compareScripts(intent *StablecoinTransferIntent, tx *trx.Transaction, transfer Transfer) error {
-	// intent can be nil if the transaction is issue or redeem operation
-	if intent == nil {
-		if transfer.SpecialOperation != "" {
-			return nil
-		}
-		return fmt.Errorf("transfer intent is nil, cannot compare scripts")
+// ExpireTransferIntents marks the pending transfer intents which have passed their expiration time as expired
+func (s *StablecoinTransferService) ExpireTransferIntents(ctx context.Context, c ClientInterface) error {
+	intents, err := getExpiredStablecoinTransferIntents(ctx, transferIntentsExpireBatchSize, c.DefaultModelOptions()...)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get expired transfer intents")
 	}
 
-	for _, out := range intent.Outputs {
-		intentScript, err := script.NewFromHex(out.Script)
+	expired := 0
+	for _, sti := range intents {
+		// one failed intent doesn't stop the batch, it is picked up again by the next run
+		ok, err := s.expireTransferIntent(ctx, c, sti.ID)
 		if err != nil {
-			return fmt.Errorf("failed to create script from hex: %w", err)
+			s.log.Error().Err(err).Str("refID", sti.ID).Msg("Failed to expire transfer intent")
+			continue
 		}
-
-		intentOperation, err := getTokenOperationFromScript(intentScript)
-		if err != nil {
-			return fmt.Errorf("failed to get token operation from script: %w", err)
+		if ok {
+			expired++
 		}
+	}
 
-		found := false
-		for _, txOut := range tx.Outputs {
-			txOperation, err := getTokenOperationFromScript(txOut.LockingScript)
-			if err != nil {
-				continue
-			}
+	if expired > 0 {
+		s.log.Info().Int("count", expired).Msg("Expired stale transfer intents")
+	}
 
-			if !reflect.DeepEqual(intentOperation, txOperation) {
-				continue
-			}
+	return nil
+}
+
+// expireTransferIntent marks the intent as expired and releases its daily volume, it returns false if the intent is not pending anymore
+// The intent is expired under the same lock as the transfer, so the transfer which is being processed is not overridden.
+func (s *StablecoinTransferService) expireTransferIntent(ctx context.Context, c ClientInterface, refID string) (bool, error) {
+	unlock, err := newWriteLock(ctx, fmt.Sprintf(lockKeyProcessTransferIntent, refID), c.Cachestore())
+	defer unlock()
+	if err != nil {
+		s.log.Debug().Err(err).Str("refID", refID).Msg("Transfer intent is being processed, skipping expiration")
+		return false, nil
+	}
+
+	// the status is read again under the lock, the intent could have been consumed or canceled after the batch was fetched
+	sti, err := getStablecoinTransferIntentByID(ctx, refID, c.DefaultModelOptions()...)
+	if err != nil {
+		return false, spverrors.Wrapf(err, "failed to get transfer intent")
+	}
+	if sti == nil || sti.Status != TransferIntentStatusPending {
+		return false, nil
+	}
+
+	sti.markExpired()
+	if err = sti.Save(ctx); err != nil {
+		return false, spverrors.Wrapf(err, "failed to save expired transfer intent")
+	}
 
-			found = true
+	if err = sti.releaseDailyVolume(ctx); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// NotifyGatewayAboutTransfer stores the notification about the accepted transfer in the outbox.
+// The notification is delivered to the gateway by the cron job, so the gateway outage never loses the settlement record.
+func (s *StablecoinTransferService) NotifyGatewayAboutTransfer(ctx context.Context, c ClientInterface, direction gateway.TransferDirection, refID string, tx *trx.Transaction, stablecoinID string, outputs TransactionOutputs) error {
+	notification, err := s.newGatewayNotification(ctx, c, direction, refID, tx, stablecoinID, outputs)
+	if err != nil || notification == nil {
+		return err
+	}
+
+	if err = notification.Save(ctx); err != nil {
+		s.log.Error().Err(err).Str("refID", refID).Str("txID", notification.TxID).Msg("Failed to enqueue gateway notification")
+		return spverrors.ErrGatewayNotificationEnqueue.Wrap(err)
+	}
+
+	s.log.Info().Str("refID", refID).Str("txID", notification.TxID).Str("direction", string(direction)).Msg("Gateway notification enqueued")
+	return nil
+}
+
+// newGatewayNotification prepares the outbox record about the accepted transfer, it returns nil if the notification is already enqueued
+func (s *StablecoinTransferService) newGatewayNotification(ctx context.Context, c ClientInterface, direction gateway.TransferDirection, refID string, tx *trx.Transaction, stablecoinID string, outputs TransactionOutputs) (*GatewayNotification, error) {
+	payload := newGatewayNotificationPayload(tx, outputs)
+	if stablecoinID == "" && len(payload.Banknotes) > 0 {
+		stablecoinID = payload.Banknotes[0].Serial
+	}
+
+	notification := newGatewayNotification(direction, refID, tx.TxID().String(), stablecoinID, payload, c.DefaultModelOptions(New())...)
+
+	existing, err := getGatewayNotificationByID(ctx, notification.ID, c.DefaultModelOptions()...)
+	if err != nil {
+		return nil, spverrors.ErrGatewayNotificationEnqueue.Wrap(err)
+	}
+	if existing != nil {
+		s.log.Debug().Str("refID", refID).Str("txID", notification.TxID).Msg("Gateway notification already enqueued")
+		return nil, nil
+	}
+
+	return notification, nil
+}
+
+// ProcessGatewayNotifications sends the pending notifications from the outbox to the gateway
+// Failed deliveries are retried with exponential backoff, and dead-lettered after reaching the max number of attempts.
+func (s *StablecoinTransferService) ProcessGatewayNotifications(ctx context.Context, c ClientInterface) error {
+	notifications, err := getGatewayNotificationsToSend(ctx, gatewayNotificationBatchSize, c.DefaultModelOptions()...)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get gateway notifications to send")
+	}
+
+	for _, notification := range notifications {
+		log := s.log.With().Str("refID", notification.RefID).Str("txID", notification.TxID).Logger()
+
+		if err = c.GatewayClient().NotifyTransfer(ctx, notification.toTransferNotification()); err != nil {
+			notification.markFailed(err)
+			if notification.Status == GatewayNotificationDead {
+				log.Error().Err(err).Int("attempts", notification.Attempts).Msg("Gateway notification moved to dead letter")
+			} else {
+				log.Warn().Err(err).Int("attempts", notification.Attempts).Time("nextAttemptAt", notification.NextAttemptAt).
+					Msg("Failed to send gateway notification, will retry")
+			}
+		} else {
+			notification.markSent()
+			log.Info().Msg("Gateway notified about the transfer")
 		}
-		if !found {
-			return fmt.Errorf("output %s not found in transaction outputs", out.Script)
+
+		if err = notification.Save(ctx); err != nil {
+			log.Error().Err(err).Msg("Failed to save gateway notification")
 		}
 	}
 
 	return nil
 }
 
-func getTokenOperationFromScript(s *script.Script) (*bsv21.TokenOperation, error) {
-	inscription, err := bsv21.FindInscription(s)
-	if err != nil || inscription == nil {
-		return nil, fmt.Errorf("failed to find inscription in script: %w", err)
+// resolveStablecoinCapabilityURL returns the URL of the stablecoin capability of the receiver's paymail host
+func resolveStablecoinCapabilityURL(ctx context.Context, c ClientInterface, receiverPaymail, brfcID string) (string, error) {
+	address, err := c.PaymailService().GetSanitizedPaymail(receiverPaymail)
+	if err != nil {
+		return "", spverrors.ErrPaymailAddressIsInvalid.Wrap(err)
 	}
 
-	inscriptionData, err := bsv21.NewFromInscription("id", 1, inscription)
+	capabilities, err := c.PaymailService().GetCapabilities(ctx, address.Domain)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create inscription data from inscription: %w", err)
+		return "", spverrors.ErrGetCapabilities.Wrap(err)
 	}
 
-	return inscriptionData, nil
+	url := capabilities.GetString(brfcID, "")
+	if url == "" {
+		return "", spverrors.ErrCapabilitiesStablecoinTransferUnsupported.Wrap(spverrors.Newf("capability %s is not supported by %s", brfcID, address.Domain))
+	}
+
+	url = strings.ReplaceAll(url, "{alias}", address.Alias)
+	url = strings.ReplaceAll(url, "{domain.tld}", address.Domain)
+
+	return url, nil
 }
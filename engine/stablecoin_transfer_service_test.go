@@ -0,0 +1,423 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/gateway"
+	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	xtester "github.com/bitcoin-sv/spv-wallet/engine/tester/paymailmock"
+	trx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStablecoinReceiverDomain = "receiver.com"
+
+// newStablecoinTransferTestClient creates the client which sends the paymail and the stablecoin requests to the mocked receiver's host
+func newStablecoinTransferTestClient(t *testing.T, opts ...ClientOps) (context.Context, ClientInterface, *xtester.PaymailClientMock) {
+	mockTransport := httpmock.NewMockTransport()
+	paymailClient := xtester.MockClient(mockTransport, testStablecoinReceiverDomain)
+
+	httpClient := resty.New()
+	httpClient.SetTransport(mockTransport)
+
+	ctx, client, deferMe := CreateTestSQLiteClient(t, false, false, append([]ClientOps{withTaskManagerMockup(), WithPaymailClient(paymailClient), WithHTTPClient(httpClient)}, opts...)...)
+	t.Cleanup(deferMe)
+
+	return ctx, client, paymailClient
+}
+
+func TestStablecoinTransferService_SendTransferIntent(t *testing.T) {
+	intent := Intent{
+		SenderID:     "alice@example.com",
+		ReceiverID:   "bob@" + testStablecoinReceiverDomain,
+		Nonce:        "1234567890abcdef",
+		StablecoinID: testStablecoinID,
+		Amount:       100,
+	}
+
+	t.Run("send intent to the endpoint from the capabilities", func(t *testing.T) {
+		// given:
+		ctx, client, paymailClient := newStablecoinTransferTestClient(t)
+		paymailClient.WillRespondWithStablecoinCapabilities()
+
+		// when:
+		resp, err := client.StablecoinTransferService().SendTransferIntent(ctx, client, intent)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, intent.Nonce, resp.Nonce)
+	})
+
+	t.Run("fail when the receiver does not support stablecoin transfers", func(t *testing.T) {
+		// given:
+		ctx, client, paymailClient := newStablecoinTransferTestClient(t)
+		paymailClient.WillRespondWithP2PCapabilities()
+
+		// when:
+		resp, err := client.StablecoinTransferService().SendTransferIntent(ctx, client, intent)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrCapabilitiesStablecoinTransferUnsupported)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("fail when the receiver rejects the intent", func(t *testing.T) {
+		// given:
+		ctx, client, paymailClient := newStablecoinTransferTestClient(t)
+		paymailClient.WillRespondWithStablecoinCapabilities()
+		paymailClient.WillRespondOnCapability(paymailclient.BRFCStablecoinTransferIntent).WithInternalServerError()
+
+		// when:
+		resp, err := client.StablecoinTransferService().SendTransferIntent(ctx, client, intent)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinReceiverRejected)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestStablecoinTransferService_SendTransfer(t *testing.T) {
+	receiver := "bob@" + testStablecoinReceiverDomain
+	transfer := Transfer{RefID: "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5", TxHex: "0100000000000000000000"}
+
+	t.Run("send transfer to the endpoint from the capabilities", func(t *testing.T) {
+		// given:
+		ctx, client, paymailClient := newStablecoinTransferTestClient(t)
+		paymailClient.WillRespondWithStablecoinCapabilities()
+
+		// when:
+		err := client.StablecoinTransferService().SendTransfer(ctx, client, receiver, transfer)
+
+		// then:
+		require.NoError(t, err)
+	})
+
+	t.Run("fail when the receiver rejects the transfer", func(t *testing.T) {
+		// given:
+		ctx, client, paymailClient := newStablecoinTransferTestClient(t)
+		paymailClient.WillRespondWithStablecoinCapabilities()
+		paymailClient.WillRespondOnCapability(paymailclient.BRFCStablecoinTransfer).WithNotFound()
+
+		// when:
+		err := client.StablecoinTransferService().SendTransfer(ctx, client, receiver, transfer)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrStablecoinReceiverRejected)
+	})
+
+	t.Run("fail for invalid receiver paymail", func(t *testing.T) {
+		// given:
+		ctx, client, _ := newStablecoinTransferTestClient(t)
+
+		// when:
+		err := client.StablecoinTransferService().SendTransfer(ctx, client, "invalid-paymail", transfer)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrPaymailAddressIsInvalid)
+	})
+}
+
+// newGatewayNotificationsTestClient creates the client which sends the transfer notifications to the gateway responding with the given status
+func newGatewayNotificationsTestClient(t *testing.T, status int) (context.Context, ClientInterface, *httpmock.MockTransport) {
+	mockTransport := httpmock.NewMockTransport()
+	mockTransport.RegisterResponder(http.MethodPost, "http://localhost:8090/coins/bsv21/transfers",
+		httpmock.NewStringResponder(status, "{}"),
+	)
+
+	httpClient := resty.New()
+	httpClient.SetTransport(mockTransport)
+
+	ctx, client, deferMe := CreateTestSQLiteClient(t, false, false, withTaskManagerMockup(), WithHTTPClient(httpClient))
+	t.Cleanup(deferMe)
+
+	return ctx, client, mockTransport
+}
+
+// enqueueTestGatewayNotification stores the notification about the transfer of the empty transaction with the given refID
+func enqueueTestGatewayNotification(ctx context.Context, t *testing.T, client ClientInterface, refID string) *GatewayNotification {
+	tx := trx.NewTransaction()
+	err := client.StablecoinTransferService().NotifyGatewayAboutTransfer(ctx, client, gateway.TransferOutgoing, refID, tx, testStablecoinID, nil)
+	require.NoError(t, err)
+
+	notification, err := getGatewayNotificationByID(ctx, newGatewayNotification(gateway.TransferOutgoing, refID, tx.TxID().String(), "", GatewayNotificationPayload{}).ID, client.DefaultModelOptions()...)
+	require.NoError(t, err)
+	require.NotNil(t, notification)
+
+	return notification
+}
+
+func TestStablecoinTransferService_NotifyGatewayAboutTransfer(t *testing.T) {
+	t.Run("enqueue the notification only once", func(t *testing.T) {
+		// given:
+		ctx, client, _ := newGatewayNotificationsTestClient(t, http.StatusOK)
+		notification := enqueueTestGatewayNotification(ctx, t, client, "ref")
+		notification.markSent()
+		require.NoError(t, notification.Save(ctx))
+
+		// when:
+		err := client.StablecoinTransferService().NotifyGatewayAboutTransfer(ctx, client, gateway.TransferOutgoing, "ref", trx.NewTransaction(), testStablecoinID, nil)
+
+		// then:
+		require.NoError(t, err)
+
+		// and:
+		enqueued, err := getGatewayNotificationByID(ctx, notification.ID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, GatewayNotificationSent, enqueued.Status)
+	})
+}
+
+func TestStablecoinTransferService_ProcessGatewayNotifications(t *testing.T) {
+	t.Run("send the pending notification", func(t *testing.T) {
+		// given:
+		ctx, client, transport := newGatewayNotificationsTestClient(t, http.StatusOK)
+		notification := enqueueTestGatewayNotification(ctx, t, client, "ref")
+
+		// when:
+		err := client.StablecoinTransferService().ProcessGatewayNotifications(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, 1, transport.GetTotalCallCount())
+
+		// and:
+		sent, err := getGatewayNotificationByID(ctx, notification.ID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, GatewayNotificationSent, sent.Status)
+		assert.Zero(t, sent.Attempts)
+
+		// when:
+		err = client.StablecoinTransferService().ProcessGatewayNotifications(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, 1, transport.GetTotalCallCount(), "sent notification should not be sent again")
+	})
+
+	t.Run("retry the failed notification after the backoff", func(t *testing.T) {
+		// given:
+		ctx, client, transport := newGatewayNotificationsTestClient(t, http.StatusServiceUnavailable)
+		notification := enqueueTestGatewayNotification(ctx, t, client, "ref")
+
+		// when:
+		err := client.StablecoinTransferService().ProcessGatewayNotifications(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, 1, transport.GetTotalCallCount())
+
+		// and:
+		failed, err := getGatewayNotificationByID(ctx, notification.ID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, GatewayNotificationPending, failed.Status)
+		assert.Equal(t, 1, failed.Attempts)
+		assert.Contains(t, failed.LastError, "503")
+		assert.WithinDuration(t, time.Now().UTC().Add(gatewayNotificationRetryDelay), failed.NextAttemptAt, 5*time.Second)
+
+		// when:
+		err = client.StablecoinTransferService().ProcessGatewayNotifications(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, 1, transport.GetTotalCallCount(), "notification should not be retried before the backoff")
+
+		// given:
+		failed.NextAttemptAt = time.Now().UTC().Add(-time.Second)
+		require.NoError(t, failed.Save(ctx))
+
+		// when:
+		err = client.StablecoinTransferService().ProcessGatewayNotifications(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, 2, transport.GetTotalCallCount())
+
+		// and:
+		retried, err := getGatewayNotificationByID(ctx, notification.ID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, 2, retried.Attempts)
+		assert.WithinDuration(t, time.Now().UTC().Add(2*gatewayNotificationRetryDelay), retried.NextAttemptAt, 5*time.Second)
+	})
+
+	t.Run("dead letter the notification after the last failed attempt", func(t *testing.T) {
+		// given:
+		ctx, client, transport := newGatewayNotificationsTestClient(t, http.StatusInternalServerError)
+		notification := enqueueTestGatewayNotification(ctx, t, client, "ref")
+		notification.Attempts = gatewayNotificationMaxAttempts - 1
+		require.NoError(t, notification.Save(ctx))
+
+		// when:
+		err := client.StablecoinTransferService().ProcessGatewayNotifications(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, 1, transport.GetTotalCallCount())
+
+		// and:
+		dead, err := getGatewayNotificationByID(ctx, notification.ID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, GatewayNotificationDead, dead.Status)
+		assert.Equal(t, gatewayNotificationMaxAttempts, dead.Attempts)
+	})
+}
+
+func TestStablecoinTransferService_CancelTransferIntent(t *testing.T) {
+	// givenIntent stores the pending transfer intent addressed to the testPaymail of the testXPub
+	givenIntent := func(t *testing.T) (context.Context, ClientInterface, *StablecoinTransferIntent) {
+		ctx, client, _ := newStablecoinTransferTestClient(t)
+
+		_, err := client.NewXpub(ctx, testXPub, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		_, err = client.NewPaymailAddress(ctx, testXPub, testPaymail, testPublicName, testAvatar, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+
+		sti, err := CreateStablecoinTransferIntent(&Intent{
+			SenderID:     "alice@" + testStablecoinReceiverDomain,
+			ReceiverID:   testPaymail,
+			Nonce:        "nonce",
+			StablecoinID: testStablecoinID,
+			Amount:       100,
+		}, nil, nil, time.Minute, client.DefaultModelOptions(New())...)
+		require.NoError(t, err)
+		require.NoError(t, sti.Save(ctx))
+
+		return ctx, client, sti
+	}
+
+	t.Run("cancel the pending intent of the receiver", func(t *testing.T) {
+		// given:
+		ctx, client, sti := givenIntent(t)
+
+		// when:
+		err := client.StablecoinTransferService().CancelTransferIntent(ctx, client, testXPubID, sti.ID)
+
+		// then:
+		require.NoError(t, err)
+
+		// and:
+		canceled, err := getStablecoinTransferIntentByID(ctx, sti.ID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		assert.Equal(t, TransferIntentStatusCanceled, canceled.Status)
+
+		// when:
+		err = client.StablecoinTransferService().CancelTransferIntent(ctx, client, testXPubID, sti.ID)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTransferIntentCanceled)
+	})
+
+	t.Run("do not cancel the intent of other user", func(t *testing.T) {
+		// given:
+		ctx, client, sti := givenIntent(t)
+
+		// when:
+		err := client.StablecoinTransferService().CancelTransferIntent(ctx, client, "other-xpub-id", sti.ID)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTransferIntentNotFound)
+	})
+
+	t.Run("do not cancel the consumed intent", func(t *testing.T) {
+		// given:
+		ctx, client, sti := givenIntent(t)
+		sti.markConsumed("txID")
+		require.NoError(t, sti.Save(ctx))
+
+		// when:
+		err := client.StablecoinTransferService().CancelTransferIntent(ctx, client, testXPubID, sti.ID)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTransferIntentAlreadyConsumed)
+	})
+
+	t.Run("fail for unknown intent", func(t *testing.T) {
+		// given:
+		ctx, client, _ := newStablecoinTransferTestClient(t)
+
+		// when:
+		err := client.StablecoinTransferService().CancelTransferIntent(ctx, client, testXPubID, "unknown")
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTransferIntentNotFound)
+	})
+}
+
+func TestStablecoinTransferService_ExpireTransferIntents(t *testing.T) {
+	givenExpiredIntent := func(t *testing.T, ctx context.Context, client ClientInterface, nonce string) *StablecoinTransferIntent {
+		sti, err := CreateStablecoinTransferIntent(&Intent{
+			SenderID:     "alice@" + testStablecoinReceiverDomain,
+			ReceiverID:   testPaymail,
+			Nonce:        nonce,
+			StablecoinID: testStablecoinID,
+			Amount:       100,
+		}, nil, nil, -time.Second, client.DefaultModelOptions(New())...)
+		require.NoError(t, err)
+		require.NoError(t, sti.Save(ctx))
+		return sti
+	}
+
+	getStatus := func(t *testing.T, ctx context.Context, client ClientInterface, refID string) TransferIntentStatus {
+		sti, err := getStablecoinTransferIntentByID(ctx, refID, client.DefaultModelOptions()...)
+		require.NoError(t, err)
+		return sti.Status
+	}
+
+	t.Run("expire the pending intents", func(t *testing.T) {
+		// given:
+		ctx, client, _ := newStablecoinTransferTestClient(t)
+		first := givenExpiredIntent(t, ctx, client, "first")
+		second := givenExpiredIntent(t, ctx, client, "second")
+
+		// when:
+		err := client.StablecoinTransferService().ExpireTransferIntents(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, TransferIntentStatusExpired, getStatus(t, ctx, client, first.ID))
+		assert.Equal(t, TransferIntentStatusExpired, getStatus(t, ctx, client, second.ID))
+	})
+
+	t.Run("skip the intent which is being processed and expire the rest", func(t *testing.T) {
+		// given:
+		ctx, client, _ := newStablecoinTransferTestClient(t)
+		processed := givenExpiredIntent(t, ctx, client, "processed")
+		stale := givenExpiredIntent(t, ctx, client, "stale")
+
+		// and:
+		unlock, err := newWriteLock(ctx, fmt.Sprintf(lockKeyProcessTransferIntent, processed.ID), client.Cachestore())
+		require.NoError(t, err)
+		defer unlock()
+
+		// when:
+		err = client.StablecoinTransferService().ExpireTransferIntents(ctx, client)
+
+		// then:
+		require.NoError(t, err)
+		assert.Equal(t, TransferIntentStatusPending, getStatus(t, ctx, client, processed.ID))
+		assert.Equal(t, TransferIntentStatusExpired, getStatus(t, ctx, client, stale.ID))
+	})
+
+	t.Run("do not expire the intent consumed after the batch was fetched", func(t *testing.T) {
+		// given:
+		ctx, client, _ := newStablecoinTransferTestClient(t)
+		sti := givenExpiredIntent(t, ctx, client, "consumed")
+		sti.markConsumed("txID")
+		require.NoError(t, sti.Save(ctx))
+
+		// when:
+		expired, err := client.StablecoinTransferService().expireTransferIntent(ctx, client, sti.ID)
+
+		// then:
+		require.NoError(t, err)
+		assert.False(t, expired)
+		assert.Equal(t, TransferIntentStatusConsumed, getStatus(t, ctx, client, sti.ID))
+	})
+}
@@ -20,6 +20,9 @@ type BlockHeadersServiceFixture interface {
 	// WillRespondForMerkleRootsVerify returns a MerkleRootsConfirmations response for get merkleroot/verify endpoint with
 	// provided httpCode
 	WillRespondForMerkleRootsVerify(httpCode int, response *chainmodels.MerkleRootsConfirmations)
+
+	// WillRespondForChainTip returns a chain tip response with provided height for get chain tip endpoint
+	WillRespondForChainTip(height int64)
 }
 
 func (f *engineFixture) BHS() BlockHeadersServiceFixture {
@@ -48,6 +51,14 @@ func (f *engineFixture) WillRespondForMerkleRootsVerify(httpCode int, response *
 	f.externalTransport.RegisterResponder("POST", "http://localhost:8080/api/v1/chain/merkleroot/verify", responder)
 }
 
+func (f *engineFixture) WillRespondForChainTip(height int64) {
+	responder := func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewJsonResponse(http.StatusOK, &chainmodels.ChainTip{Height: height})
+	}
+
+	f.externalTransport.RegisterResponder("GET", "http://localhost:8080/api/v1/chain/tip/longest", responder)
+}
+
 func (f *engineFixture) mockBHSGetMerkleRoots() {
 	responder := func(req *http.Request) (*http.Response, error) {
 		if req.Header.Get("Authorization") != "Bearer "+f.config.BHS.AuthToken {
@@ -48,6 +48,9 @@ type EngineFixture interface {
 	// ARC creates a new test fixture for ARC
 	ARC() ARCFixture
 
+	// SourceTxs creates a new test fixture for the source transactions which are not stored in the database
+	SourceTxs() SourceTxsFixture
+
 	// Faucet creates a new test fixture for Faucet
 	Faucet(user fixtures.User) FaucetFixture
 
@@ -76,6 +79,7 @@ type engineFixture struct {
 	paymailClient                *paymailmock.PaymailClientMock
 	txFixture                    txtestability.TransactionsFixtures
 	externalTransportWithSniffer *tester.HTTPSniffer
+	sourceTxs                    *sourceTxsMock
 }
 
 func Given(t testing.TB) EngineFixture {
@@ -87,6 +91,7 @@ func Given(t testing.TB) EngineFixture {
 		paymailClient:                paymailmock.MockClient(externalTransport, fixtures.PaymailDomainExternal),
 		txFixture:                    txtestability.Given(t),
 		externalTransportWithSniffer: tester.NewHTTPSniffer(externalTransport),
+		sourceTxs:                    newSourceTxsMock(),
 	}
 
 	return f
@@ -205,6 +210,7 @@ func (f *engineFixture) initialiseFixtures() {
 func (f *engineFixture) addMockedExternalDependenciesOptions(options []engine.ClientOps) []engine.ClientOps {
 	options = append(options, engine.WithHTTPClient(f.httpClientWithMockedTransport()))
 	options = append(options, engine.WithPaymailClient(f.paymailClient))
+	options = append(options, engine.WithSourceTxGetter(f.sourceTxs))
 	return options
 }
 
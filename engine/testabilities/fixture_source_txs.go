@@ -0,0 +1,48 @@
+package testabilities
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
+)
+
+// SourceTxsFixture is a test fixture for the source transactions which are not stored in the database.
+type SourceTxsFixture interface {
+	// WillProvide makes the provided transactions available as source transactions of the recorded raw transactions.
+	WillProvide(txs ...*trx.Transaction)
+}
+
+type sourceTxsMock struct {
+	mu  sync.Mutex
+	txs map[string]*trx.Transaction
+}
+
+func newSourceTxsMock() *sourceTxsMock {
+	return &sourceTxsMock{txs: make(map[string]*trx.Transaction)}
+}
+
+func (f *engineFixture) SourceTxs() SourceTxsFixture {
+	return f.sourceTxs
+}
+
+func (m *sourceTxsMock) WillProvide(txs ...*trx.Transaction) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, tx := range txs {
+		m.txs[tx.TxID().String()] = tx
+	}
+}
+
+func (m *sourceTxsMock) GetTransactions(_ context.Context, ids iter.Seq[string]) ([]*trx.Transaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var txs []*trx.Transaction
+	for id := range ids {
+		if tx, ok := m.txs[id]; ok {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
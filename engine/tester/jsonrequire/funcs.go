@@ -19,6 +19,7 @@ var funcsMap = template.FuncMap{
 	"anything":           anything,
 	"matchTxByFormat":    matchTxByFormat,
 	"matchDestination":   matchDestination,
+	"matchUUID":          matchUUID,
 }
 
 func anything() string {
@@ -72,6 +73,10 @@ func matchAddress() string {
 	return regexPlaceholder(`^1[a-km-zA-HJ-NP-Z1-9]{24,33}$`)
 }
 
+func matchUUID() string {
+	return regexPlaceholder(`^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$`)
+}
+
 func matchNumber() string {
 	return regexPlaceholder(`^\\d+$`)
 }
@@ -101,6 +101,10 @@ func TestJSONTemplate(t *testing.T) {
 			template: `{ "id": "{{ matchID64 }}" }`,
 			actual:   `{ "id": "d425432e0d10a46af1ec6d00f380e9581ebf7907f3486572b3cd561a4c326e14" }`,
 		},
+		"match UUID": {
+			template: `{ "id": "{{ matchUUID }}" }`,
+			actual:   `{ "id": "5f0d6f1e-5c1d-4b1a-9c3e-2f7a8b9c0d1e" }`,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
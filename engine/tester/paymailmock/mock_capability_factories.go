@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/bitcoin-sv/go-paymail"
+	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
 	"github.com/jarcoal/httpmock"
 )
@@ -90,3 +91,23 @@ func capabilityBEEFTransaction() *CapabilityMock {
 		endpoint: endpoint(http.MethodPost, RecordBEEFResponse().Responder()),
 	}
 }
+
+func capabilityStablecoinTransferIntent() *CapabilityMock {
+	return &CapabilityMock{
+		name: paymailclient.BRFCStablecoinTransferIntent,
+		value: func(dn paymailDomainName) any {
+			return dn.StablecoinTransferIntent()
+		},
+		endpoint: endpoint(http.MethodPost, StablecoinTransferIntentResponse().Responder()),
+	}
+}
+
+func capabilityStablecoinTransfer() *CapabilityMock {
+	return &CapabilityMock{
+		name: paymailclient.BRFCStablecoinTransfer,
+		value: func(dn paymailDomainName) any {
+			return dn.StablecoinTransfer()
+		},
+		endpoint: endpoint(http.MethodPost, httpmock.NewStringResponder(http.StatusOK, "{}")),
+	}
+}
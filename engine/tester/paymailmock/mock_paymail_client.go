@@ -141,6 +141,17 @@ func (c *PaymailClientMock) WillRespondWithP2PWithBEEFCapabilities() {
 	}
 }
 
+// WillRespondWithStablecoinCapabilities is configuring a client to respond with basic, P2P and stablecoin transfer capabilities for all mocked domains.
+func (c *PaymailClientMock) WillRespondWithStablecoinCapabilities() {
+	c.reset()
+	c.useBasicCapabilities()
+	c.useP2PCapabilities()
+	c.useStablecoinCapabilities()
+	for _, domain := range c.domains {
+		c.exposeCapabilities(domain)
+	}
+}
+
 // WillRespondWithNotFoundOnCapabilities is configuring a client to respond with not found on capabilities for all mocked domains.
 func (c *PaymailClientMock) WillRespondWithNotFoundOnCapabilities() {
 	c.reset()
@@ -187,6 +198,16 @@ func (c *PaymailClientMock) GetMockedBEEFTransactionURL(domain string) string {
 	return c.findDomain(domain).BEEFTransaction()
 }
 
+// GetMockedStablecoinTransferIntentURL is returning the mocked stablecoin transfer intent URL for a given domain if it is mocked.
+func (c *PaymailClientMock) GetMockedStablecoinTransferIntentURL(domain string) string {
+	return c.findDomain(domain).StablecoinTransferIntent()
+}
+
+// GetMockedStablecoinTransferURL is returning the mocked stablecoin transfer URL for a given domain if it is mocked.
+func (c *PaymailClientMock) GetMockedStablecoinTransferURL(domain string) string {
+	return c.findDomain(domain).StablecoinTransfer()
+}
+
 func (c *PaymailClientMock) findDomain(domain string) paymailDomainName {
 	for _, dn := range c.domains {
 		if string(dn) == domain {
@@ -222,6 +243,13 @@ func (c *PaymailClientMock) useBEEFCapabilities() {
 	)
 }
 
+func (c *PaymailClientMock) useStablecoinCapabilities() {
+	c.capabilities = append(c.capabilities,
+		capabilityStablecoinTransferIntent(),
+		capabilityStablecoinTransfer(),
+	)
+}
+
 func (c *PaymailClientMock) exposeCapabilities(domain paymailDomainName) {
 	capabilities := make(obj)
 	for _, capability := range c.capabilities {
@@ -41,6 +41,16 @@ func (domain paymailDomainName) P2PPaymentDestination() string {
 	return domain.template("p2p-payment-destination")
 }
 
+// StablecoinTransferIntent returns the stablecoin transfer intent URL for the paymail domain
+func (domain paymailDomainName) StablecoinTransferIntent() string {
+	return domain.template("transfer-intent")
+}
+
+// StablecoinTransfer returns the stablecoin transfer URL for the paymail domain
+func (domain paymailDomainName) StablecoinTransfer() string {
+	return domain.template("transfer")
+}
+
 func (domain paymailDomainName) template(path string) string {
 	return domain.ServerURL() + "/" + path + "/{alias}@{domain.tld}"
 }
@@ -85,3 +85,54 @@ func P2PDestinationsForSats(satoshis bsv.Satoshis, moreSatoshis ...bsv.Satoshis)
 		CustomDistribution: append([]bsv.Satoshis{satoshis}, moreSatoshis...),
 	}
 }
+
+// MockedStablecoinTransferIntent is a mocked response for the stablecoin transfer intent endpoint
+type MockedStablecoinTransferIntent struct {
+	Outputs []MockedP2PDestinationOutput
+}
+
+// MockedStablecoinTransferIntentResponse is a model for the mocked stablecoin transfer intent response
+type MockedStablecoinTransferIntentResponse struct {
+	Nonce           string                       `json:"nonce"`
+	Outputs         []MockedP2PDestinationOutput `json:"outputs"`
+	TransferIndexes []int                        `json:"transferIndexes"`
+	FeeIndexes      []int                        `json:"feeIndexes"`
+}
+
+// Responder returns a httpmock responder for the mocked stablecoin transfer intent response
+// The nonce of the intent is echoed back and all the outputs are reported as the transfer outputs.
+func (m *MockedStablecoinTransferIntent) Responder() httpmock.Responder {
+	return func(request *http.Request) (*http.Response, error) {
+		var payload struct {
+			Nonce string `json:"nonce"`
+		}
+		err := json.NewDecoder(request.Body).Decode(&payload)
+		if err != nil {
+			return httpmock.NewStringResponse(http.StatusBadRequest, "invalid json"), nil
+		}
+
+		outputs := m.Outputs
+		if outputs == nil {
+			outputs = []MockedP2PDestinationOutput{}
+		}
+
+		r, err := httpmock.NewJsonResponse(http.StatusOK, MockedStablecoinTransferIntentResponse{
+			Nonce:   payload.Nonce,
+			Outputs: outputs,
+			TransferIndexes: lo.Map(outputs, func(_ MockedP2PDestinationOutput, i int) int {
+				return i
+			}),
+			FeeIndexes: []int{},
+		})
+		if err != nil {
+			panic(spverrors.Wrapf(err, "cannot create mocked responder for stablecoin transfer intent response"))
+		}
+
+		return r, nil
+	}
+}
+
+// StablecoinTransferIntentResponse returns a new mocked response for the stablecoin transfer intent endpoint
+func StablecoinTransferIntentResponse() *MockedStablecoinTransferIntent {
+	return &MockedStablecoinTransferIntent{}
+}
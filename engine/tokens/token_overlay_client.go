@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strings"
 
 	api "github.com/4chain-AG/gateway-overlay/pkg/open_api"
 	"github.com/go-resty/resty/v2"
@@ -38,12 +39,23 @@ type TransferRequest struct {
 // TokenOverlayClient represents an interface of token overlay client
 type TokenOverlayClient interface {
 	VerifyAndSaveTokenTransfer(ctx context.Context, txHex *TransferRequest) error
+
+	// GetOutpointState returns the validity and the spend state of the token output known to the overlay
+	GetOutpointState(ctx context.Context, assetID, outpoint string) (*OutpointState, error)
+	// GetOutpointHistory returns the transfers which led to the token output, starting from the oldest one
+	GetOutpointHistory(ctx context.Context, assetID, outpoint string) ([]*OutpointEvent, error)
+	// GetHolderUtxos returns the unspent token outputs of the holder
+	GetHolderUtxos(ctx context.Context, assetID, holderID string) ([]*TokenUtxo, error)
+	// GetAssetMetadata returns the metadata of the token asset
+	GetAssetMetadata(ctx context.Context, assetID string) (*AssetMetadata, error)
 }
 
 type tokenOverlayClient struct {
 	log        zerolog.Logger
 	api        *api.Client
 	apiVersion APIVersion
+	httpClient *resty.Client
+	overlayURL string
 }
 
 // NewTokenOverlayClient returns a new token overlay client
@@ -57,6 +69,8 @@ func NewTokenOverlayClient(logger *zerolog.Logger, overlayURL string, httpClient
 		log:        logger.With().Str("tokens", "token-overlay-client").Logger(),
 		api:        apiClient,
 		apiVersion: apiVersion,
+		httpClient: httpClient,
+		overlayURL: strings.TrimSuffix(overlayURL, "/"),
 	}, nil
 }
 
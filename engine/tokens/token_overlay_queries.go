@@ -0,0 +1,138 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+)
+
+// OutpointState represents the state of the token output known to the overlay
+type OutpointState struct {
+	Outpoint     string `json:"outpoint"`
+	AssetID      string `json:"asset_id"`
+	Amount       uint64 `json:"amount"`
+	OwnerID      string `json:"owner_id"`
+	Valid        bool   `json:"valid"`
+	Spent        bool   `json:"spent"`
+	SpendingTxID string `json:"spending_txid,omitempty"`
+}
+
+// OutpointEvent represents a single transfer in the history of the token output
+type OutpointEvent struct {
+	TxID       string    `json:"txid"`
+	Operation  string    `json:"operation"`
+	SenderID   string    `json:"sender_id,omitempty"`
+	ReceiverID string    `json:"receiver_id,omitempty"`
+	Amount     uint64    `json:"amount"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// TokenUtxo represents the unspent token output of the holder
+type TokenUtxo struct {
+	Outpoint string `json:"outpoint"`
+	AssetID  string `json:"asset_id"`
+	Amount   uint64 `json:"amount"`
+}
+
+// AssetMetadata represents the metadata of the token asset
+type AssetMetadata struct {
+	AssetID     string `json:"asset_id"`
+	Symbol      string `json:"symbol"`
+	Decimals    int    `json:"decimals"`
+	Icon        string `json:"icon,omitempty"`
+	IssuerID    string `json:"issuer_id,omitempty"`
+	TotalSupply uint64 `json:"total_supply"`
+}
+
+// Outpoint returns the outpoint in the form used by the overlay
+func Outpoint(txID string, vout uint32) string {
+	return fmt.Sprintf("%s_%d", txID, vout)
+}
+
+func (c *tokenOverlayClient) GetOutpointState(ctx context.Context, assetID, outpoint string) (*OutpointState, error) {
+	var state OutpointState
+	path := c.path(
+		"/api/v1/bsv21/outpoint/"+url.PathEscape(outpoint),
+		"/api/v2/coin/"+url.PathEscape(assetID)+"/outpoint/"+url.PathEscape(outpoint),
+	)
+	if err := c.get(ctx, path, nil, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (c *tokenOverlayClient) GetOutpointHistory(ctx context.Context, assetID, outpoint string) ([]*OutpointEvent, error) {
+	history := make([]*OutpointEvent, 0)
+	path := c.path(
+		"/api/v1/bsv21/outpoint/"+url.PathEscape(outpoint)+"/history",
+		"/api/v2/coin/"+url.PathEscape(assetID)+"/outpoint/"+url.PathEscape(outpoint)+"/history",
+	)
+	if err := c.get(ctx, path, nil, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (c *tokenOverlayClient) GetHolderUtxos(ctx context.Context, assetID, holderID string) ([]*TokenUtxo, error) {
+	utxos := make([]*TokenUtxo, 0)
+
+	var query map[string]string
+	if c.apiVersion == APIV1 && assetID != "" {
+		// v1 API has no asset in the path, so the utxos are filtered by the query
+		query = map[string]string{"tokenId": assetID}
+	}
+	path := c.path(
+		"/api/v1/bsv21/holder/"+url.PathEscape(holderID)+"/utxos",
+		"/api/v2/coin/"+url.PathEscape(assetID)+"/holder/"+url.PathEscape(holderID)+"/utxos",
+	)
+	if err := c.get(ctx, path, query, &utxos); err != nil {
+		return nil, err
+	}
+	return utxos, nil
+}
+
+func (c *tokenOverlayClient) GetAssetMetadata(ctx context.Context, assetID string) (*AssetMetadata, error) {
+	var metadata AssetMetadata
+	path := c.path(
+		"/api/v1/bsv21/token/"+url.PathEscape(assetID),
+		"/api/v2/coin/"+url.PathEscape(assetID),
+	)
+	if err := c.get(ctx, path, nil, &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func (c *tokenOverlayClient) path(v1Path, v2Path string) string {
+	if c.apiVersion == APIV1 {
+		return c.overlayURL + v1Path
+	}
+	return c.overlayURL + v2Path
+}
+
+// get fetches the resource from the overlay, a missing resource is reported as ErrTokenOverlayNotFound
+func (c *tokenOverlayClient) get(ctx context.Context, path string, query map[string]string, result any) error {
+	resp, err := c.httpClient.R().
+		SetContext(ctx).
+		SetQueryParams(query).
+		SetResult(result).
+		Get(path)
+	if err != nil {
+		c.log.Err(err).Ctx(ctx).Str("path", path).Msg("Failed to query token overlay")
+		return spverrors.ErrTokenOverlayUnavailable.Wrap(err)
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return spverrors.ErrTokenOverlayNotFound
+	}
+	if resp.IsError() {
+		c.log.Error().Ctx(ctx).Str("path", path).Int("statusCode", resp.StatusCode()).Msg("Token overlay responded with an error")
+		return spverrors.ErrTokenOverlayUnavailable.Wrap(fmt.Errorf("token overlay responded with status code %d: %s", resp.StatusCode(), resp.String()))
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package tokens_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	assetID  = "0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"
+	outpoint = "1b52eac9d1eb0adf3ce6fa6e4e0ee4cbb7e8a4f2c7e3ca4d6d2e0e7ef6a2c1d0_1"
+	holderID = "holder@example.com"
+)
+
+// newOverlayMock starts the overlay which responds with the given body to the requests of the given path
+func newOverlayMock(t *testing.T, path string, status int, body any) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func newClient(t *testing.T, url string, version tokens.APIVersion) tokens.TokenOverlayClient {
+	logger := tester.Logger(t)
+	client, err := tokens.NewTokenOverlayClient(&logger, url, resty.New(), version)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestTokenOverlayClientGetOutpointState(t *testing.T) {
+	state := tokens.OutpointState{Outpoint: outpoint, AssetID: assetID, Amount: 100, OwnerID: holderID, Valid: true}
+
+	tests := map[string]struct {
+		version tokens.APIVersion
+		path    string
+	}{
+		"v1": {version: tokens.APIV1, path: "/api/v1/bsv21/outpoint/" + outpoint},
+		"v2": {version: tokens.APIV2, path: "/api/v2/coin/" + assetID + "/outpoint/" + outpoint},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			client := newClient(t, newOverlayMock(t, test.path, http.StatusOK, state), test.version)
+
+			// when:
+			result, err := client.GetOutpointState(context.Background(), assetID, outpoint)
+
+			// then:
+			require.NoError(t, err)
+			assert.Equal(t, &state, result)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		// given:
+		client := newClient(t, newOverlayMock(t, "/other", http.StatusOK, state), tokens.APIV2)
+
+		// when:
+		result, err := client.GetOutpointState(context.Background(), assetID, outpoint)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTokenOverlayNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("overlay error", func(t *testing.T) {
+		// given:
+		client := newClient(t, newOverlayMock(t, "/api/v2/coin/"+assetID+"/outpoint/"+outpoint, http.StatusInternalServerError, nil), tokens.APIV2)
+
+		// when:
+		result, err := client.GetOutpointState(context.Background(), assetID, outpoint)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTokenOverlayUnavailable)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTokenOverlayClientGetOutpointHistory(t *testing.T) {
+	history := []*tokens.OutpointEvent{
+		{TxID: "aa", Operation: "mint", ReceiverID: "emitter@example.com", Amount: 1000},
+		{TxID: "bb", Operation: "transfer", SenderID: "emitter@example.com", ReceiverID: holderID, Amount: 100},
+	}
+
+	tests := map[string]struct {
+		version tokens.APIVersion
+		path    string
+	}{
+		"v1": {version: tokens.APIV1, path: "/api/v1/bsv21/outpoint/" + outpoint + "/history"},
+		"v2": {version: tokens.APIV2, path: "/api/v2/coin/" + assetID + "/outpoint/" + outpoint + "/history"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			client := newClient(t, newOverlayMock(t, test.path, http.StatusOK, history), test.version)
+
+			// when:
+			result, err := client.GetOutpointHistory(context.Background(), assetID, outpoint)
+
+			// then:
+			require.NoError(t, err)
+			require.Len(t, result, 2)
+			assert.Equal(t, "mint", result[0].Operation)
+			assert.Equal(t, holderID, result[1].ReceiverID)
+		})
+	}
+
+	t.Run("overlay error", func(t *testing.T) {
+		// given:
+		client := newClient(t, newOverlayMock(t, "/api/v2/coin/"+assetID+"/outpoint/"+outpoint+"/history", http.StatusInternalServerError, nil), tokens.APIV2)
+
+		// when:
+		result, err := client.GetOutpointHistory(context.Background(), assetID, outpoint)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTokenOverlayUnavailable)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTokenOverlayClientGetHolderUtxos(t *testing.T) {
+	utxos := []*tokens.TokenUtxo{{Outpoint: outpoint, AssetID: assetID, Amount: 100}}
+
+	tests := map[string]struct {
+		version tokens.APIVersion
+		path    string
+	}{
+		"v1": {version: tokens.APIV1, path: "/api/v1/bsv21/holder/" + holderID + "/utxos"},
+		"v2": {version: tokens.APIV2, path: "/api/v2/coin/" + assetID + "/holder/" + holderID + "/utxos"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			client := newClient(t, newOverlayMock(t, test.path, http.StatusOK, utxos), test.version)
+
+			// when:
+			result, err := client.GetHolderUtxos(context.Background(), assetID, holderID)
+
+			// then:
+			require.NoError(t, err)
+			assert.Equal(t, utxos, result)
+		})
+	}
+}
+
+func TestTokenOverlayClientGetAssetMetadata(t *testing.T) {
+	metadata := tokens.AssetMetadata{AssetID: assetID, Symbol: "USDC", Decimals: 2, IssuerID: "emitter@example.com", TotalSupply: 1000000}
+
+	tests := map[string]struct {
+		version tokens.APIVersion
+		path    string
+	}{
+		"v1": {version: tokens.APIV1, path: "/api/v1/bsv21/token/" + assetID},
+		"v2": {version: tokens.APIV2, path: "/api/v2/coin/" + assetID},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			client := newClient(t, newOverlayMock(t, test.path, http.StatusOK, metadata), test.version)
+
+			// when:
+			result, err := client.GetAssetMetadata(context.Background(), assetID)
+
+			// then:
+			require.NoError(t, err)
+			assert.Equal(t, &metadata, result)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		// given:
+		client := newClient(t, newOverlayMock(t, "/other", http.StatusOK, metadata), tokens.APIV2)
+
+		// when:
+		result, err := client.GetAssetMetadata(context.Background(), assetID)
+
+		// then:
+		require.ErrorIs(t, err, spverrors.ErrTokenOverlayNotFound)
+		assert.Nil(t, result)
+	})
+}
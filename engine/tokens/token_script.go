@@ -0,0 +1,23 @@
+package tokens
+
+import (
+	"fmt"
+
+	"github.com/4chain-AG/gateway-overlay/pkg/token_engine/bsv21"
+	"github.com/bsv-blockchain/go-sdk/script"
+)
+
+// ParseTokenScript returns the BSV-21 token operation inscribed in the given locking script
+func ParseTokenScript(s *script.Script) (*bsv21.TokenOperation, error) {
+	inscription, err := bsv21.FindInscription(s)
+	if err != nil || inscription == nil {
+		return nil, fmt.Errorf("failed to find inscription in script: %w", err)
+	}
+
+	inscriptionData, err := bsv21.NewFromInscription("id", 1, inscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inscription data from inscription: %w", err)
+	}
+
+	return inscriptionData, nil
+}
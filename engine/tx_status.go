@@ -10,6 +10,7 @@ const (
 	TxStatusMined       TxStatus = "MINED"
 	TxStatusReverted    TxStatus = "REVERTED"
 	TxStatusProblematic TxStatus = "PROBLEMATIC"
+	TxStatusHeld        TxStatus = "HELD" // token transaction waiting for the overlay registration before the broadcast
 )
 
 // String returns the string representation of the TxStatus
@@ -0,0 +1,39 @@
+package banknotes
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/banknotes/banknotesmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+)
+
+// Service is the domain service for the stablecoin banknotes.
+type Service struct {
+	repo Repo
+}
+
+// NewService creates a new instance of the banknotes service.
+func NewService(repo Repo) *Service {
+	return &Service{repo: repo}
+}
+
+// PaginatedForUser returns the unspent banknotes of a user, stablecoinID (optional) limits them to the given stablecoin.
+func (s *Service) PaginatedForUser(ctx context.Context, userID string, stablecoinID string, page filter.Page) (*models.PagedResult[banknotesmodels.Banknote], error) {
+	result, err := s.repo.PaginatedForUser(ctx, userID, stablecoinID, page)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get banknotes for user %s", userID)
+	}
+	return result, nil
+}
+
+// BalancesForUser returns the balances of the stablecoins held by a user, sorted by stablecoin ID.
+// stablecoinID (optional) limits the balances to the given stablecoin.
+func (s *Service) BalancesForUser(ctx context.Context, userID string, stablecoinID string) ([]*banknotesmodels.Balance, error) {
+	balances, err := s.repo.BalancesForUser(ctx, userID, stablecoinID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get stablecoin balances for user %s", userID)
+	}
+	return balances, nil
+}
@@ -0,0 +1,24 @@
+package banknotesmodels
+
+import "time"
+
+// Banknote is a domain model for the unspent BSV-21 token output (stablecoin banknote) of the user.
+type Banknote struct {
+	TxID string
+	Vout uint32
+
+	UserID string
+
+	Serial       string
+	StablecoinID string
+	Amount       uint64
+
+	CreatedAt time.Time
+}
+
+// Balance is the sum of the unspent banknotes of the stablecoin held by the user.
+type Balance struct {
+	StablecoinID string
+	Amount       uint64
+	Banknotes    int
+}
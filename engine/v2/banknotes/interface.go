@@ -0,0 +1,15 @@
+package banknotes
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/banknotes/banknotesmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+)
+
+// Repo is the interface that wraps the basic operations with the banknotes.
+type Repo interface {
+	PaginatedForUser(ctx context.Context, userID string, stablecoinID string, page filter.Page) (*models.PagedResult[banknotesmodels.Banknote], error)
+	BalancesForUser(ctx context.Context, userID string, stablecoinID string) ([]*banknotesmodels.Balance, error)
+}
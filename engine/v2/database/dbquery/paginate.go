@@ -18,7 +18,8 @@ func PaginatedQuery[T any](ctx context.Context, page filter.Page, db *gorm.DB, s
 	var modelType T
 	var totalElements int64
 	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		query := tx.Model(&modelType).Scopes(scopes...)
+		// a new session, so the paging of the content query doesn't leak into the count query
+		query := tx.Model(&modelType).Scopes(scopes...).Session(&gorm.Session{})
 
 		if err := query.
 			Scopes(Paginate(page)).
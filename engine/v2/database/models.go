@@ -11,6 +11,7 @@ func Models() []any {
 		Paymail{},
 		Address{},
 		UserUTXO{},
+		UserBanknote{},
 		Operation{},
 	}
 }
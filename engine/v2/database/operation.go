@@ -5,12 +5,13 @@ import "time"
 // Operation represents a user's operation on a transaction.
 type Operation struct {
 	TxID   string `gorm:"primaryKey"`
-	UserID string `gorm:"primaryKey"`
+	UserID string `gorm:"primaryKey;index:idx_operations_user_created_at,priority:1;index:idx_operations_user_counterparty,priority:1;index:idx_operations_user_type,priority:1"`
 
-	CreatedAt time.Time
+	CreatedAt time.Time `gorm:"index:idx_operations_user_created_at,priority:2"`
 
-	Counterparty string
-	Type         string
+	// Counterparty is indexed in lowercase, because the operations are filtered by the counterparty case-insensitively
+	Counterparty string `gorm:"index:idx_operations_user_counterparty,priority:2,expression:lower(counterparty)"`
+	Type         string `gorm:"index:idx_operations_user_type,priority:2"`
 	Value        int64
 
 	User        *User               `gorm:"foreignKey:UserID"`
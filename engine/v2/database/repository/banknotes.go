@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/banknotes/banknotesmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/dbquery"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// Banknotes is a repository for the stablecoin banknotes.
+type Banknotes struct {
+	db *gorm.DB
+}
+
+// NewBanknotesRepo creates a new repository for the stablecoin banknotes.
+func NewBanknotesRepo(db *gorm.DB) *Banknotes {
+	return &Banknotes{db: db}
+}
+
+// PaginatedForUser returns the unspent banknotes of a user, stablecoinID (optional) limits them to the given stablecoin.
+func (b *Banknotes) PaginatedForUser(ctx context.Context, userID string, stablecoinID string, page filter.Page) (*models.PagedResult[banknotesmodels.Banknote], error) {
+	rows, err := dbquery.PaginatedQuery[database.UserBanknote](
+		ctx,
+		page,
+		b.db,
+		unspentBanknotes(userID, stablecoinID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PagedResult[banknotesmodels.Banknote]{
+		PageDescription: rows.PageDescription,
+		Content: lo.Map(rows.Content, func(row *database.UserBanknote, _ int) *banknotesmodels.Banknote {
+			return &banknotesmodels.Banknote{
+				TxID:         row.TxID,
+				Vout:         row.Vout,
+				UserID:       row.UserID,
+				Serial:       row.Serial,
+				StablecoinID: row.StablecoinID,
+				Amount:       row.Amount,
+				CreatedAt:    row.CreatedAt,
+			}
+		}),
+	}, nil
+}
+
+// BalancesForUser returns the balances of the stablecoins held by a user, sorted by stablecoin ID.
+// stablecoinID (optional) limits the balances to the given stablecoin.
+func (b *Banknotes) BalancesForUser(ctx context.Context, userID string, stablecoinID string) ([]*banknotesmodels.Balance, error) {
+	var balances []*banknotesmodels.Balance
+	err := b.db.
+		WithContext(ctx).
+		Model(&database.UserBanknote{}).
+		Select("stablecoin_id, SUM(amount) AS amount, COUNT(*) AS banknotes").
+		Scopes(unspentBanknotes(userID, stablecoinID)).
+		Group("stablecoin_id").
+		Order("stablecoin_id").
+		Scan(&balances).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// unspentBanknotes is a scope function that filters the banknotes of a user whose outputs are still in the user's UTXOs.
+// stablecoinID (optional) limits the banknotes to the given stablecoin.
+func unspentBanknotes(userID string, stablecoinID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		utxos := db.Session(&gorm.Session{NewDB: true}).
+			Model(&database.UserUTXO{}).
+			Select("tx_id", "vout").
+			Where("user_id = ?", userID)
+
+		db = db.Where("user_id = ? AND (tx_id, vout) IN (?)", userID, utxos)
+		if stablecoinID != "" {
+			db = db.Where("stablecoin_id = ?", stablecoinID)
+		}
+		return db
+	}
+}
@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"iter"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/dbquery"
@@ -26,13 +29,14 @@ func NewOperationsRepo(db *gorm.DB) *Operations {
 	return &Operations{db: db}
 }
 
-// PaginatedForUser returns operations for a user based on userID and the provided paging options.
-func (o *Operations) PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[operationsmodels.Operation], error) {
+// PaginatedForUser returns operations for a user based on userID, the provided filter conditions and paging options.
+func (o *Operations) PaginatedForUser(ctx context.Context, userID string, page filter.Page, conditions *filter.OperationFilter) (*models.PagedResult[operationsmodels.Operation], error) {
 	rows, err := dbquery.PaginatedQuery[database.Operation](
 		ctx,
 		page,
 		o.db,
 		dbquery.UserID(userID),
+		o.withConditions(conditions),
 		dbquery.Preload("Transaction"),
 	)
 	if err != nil {
@@ -41,21 +45,177 @@ func (o *Operations) PaginatedForUser(ctx context.Context, userID string, page f
 	return &models.PagedResult[operationsmodels.Operation]{
 		PageDescription: rows.PageDescription,
 		Content: lo.Map(rows.Content, func(operation *database.Operation, _ int) *operationsmodels.Operation {
-			return &operationsmodels.Operation{
-				TxID:         operation.TxID,
-				UserID:       operation.UserID,
-				CreatedAt:    operation.CreatedAt,
-				Counterparty: operation.Counterparty,
-				Type:         operation.Type,
-				Value:        operation.Value,
-				TxStatus:     operation.Transaction.TxStatus,
-				BlockHeight:  operation.Transaction.BlockHeight,
-				BlockHash:    operation.Transaction.BlockHash,
+			return mapToOperation(operation)
+		}),
+	}, nil
+}
+
+// FindForUser returns the operation of a user on the given transaction,
+// together with the user's inputs, outputs and data outputs of that transaction.
+// It returns nil if the operation doesn't exist.
+func (o *Operations) FindForUser(ctx context.Context, userID string, txID string) (*operationsmodels.OperationDetails, error) {
+	var row database.Operation
+	err := o.db.
+		WithContext(ctx).
+		Where("tx_id = ? AND user_id = ?", txID, userID).
+		Preload("Transaction").
+		Preload("Transaction.Inputs", "user_id = ?", userID).
+		Preload("Transaction.Outputs", "user_id = ?", userID).
+		Preload("Transaction.Data", "user_id = ?", userID).
+		First(&row).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &operationsmodels.OperationDetails{
+		Operation: *mapToOperation(&row),
+		Inputs:    lo.Map(row.Transaction.Inputs, mapToOperationOutput),
+		Outputs:   lo.Map(row.Transaction.Outputs, mapToOperationOutput),
+		Data: lo.Map(row.Transaction.Data, func(data *database.Data, _ int) *operationsmodels.DataOutput {
+			return &operationsmodels.DataOutput{
+				TxID: data.TxID,
+				Vout: data.Vout,
+				Blob: data.Blob,
 			}
 		}),
+		BeefHex: row.Transaction.BeefHex,
+		RawHex:  row.Transaction.RawHex,
 	}, nil
 }
 
+// HistoryBatch returns the next batch of operations of a user (or of all users when userID is empty)
+// created in the given time range, ordered by creation time and placed after the cursor.
+// The operations are returned with the hex of their transactions and the user's spent outputs, so the fee can be calculated.
+func (o *Operations) HistoryBatch(ctx context.Context, userID string, createdRange *filter.TimeRange, after *operationsmodels.HistoryCursor, limit int) ([]*operationsmodels.OperationDetails, error) {
+	query := o.db.
+		WithContext(ctx).
+		Scopes(o.withConditions(&filter.OperationFilter{CreatedRange: createdRange})).
+		Preload("Transaction").
+		Preload("Transaction.Inputs").
+		Order("created_at, tx_id, user_id").
+		Limit(limit)
+
+	if userID != "" {
+		query = query.Scopes(dbquery.UserID(userID))
+	}
+	if after != nil {
+		query = query.Where("(created_at, tx_id, user_id) > (?, ?, ?)", after.CreatedAt, after.TxID, after.UserID)
+	}
+
+	var rows []*database.Operation
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *database.Operation, _ int) *operationsmodels.OperationDetails {
+		userInputs := lo.Filter(row.Transaction.Inputs, func(input *database.TrackedOutput, _ int) bool {
+			return input.UserID == row.UserID
+		})
+		return &operationsmodels.OperationDetails{
+			Operation: *mapToOperation(row),
+			Inputs:    lo.Map(userInputs, mapToOperationOutput),
+			BeefHex:   row.Transaction.BeefHex,
+			RawHex:    row.Transaction.RawHex,
+		}
+	}), nil
+}
+
+// BalancesBefore returns the sum of values of the operations created before the given time, per user.
+// It returns the balance of a single user, or of all users when userID is empty.
+func (o *Operations) BalancesBefore(ctx context.Context, userID string, before time.Time) (map[string]int64, error) {
+	query := o.db.
+		WithContext(ctx).
+		Model(&database.Operation{}).
+		Select("user_id, COALESCE(SUM(value), 0) AS balance").
+		Where("created_at < ?", before).
+		Group("user_id")
+
+	if userID != "" {
+		query = query.Scopes(dbquery.UserID(userID))
+	}
+
+	var rows []struct {
+		UserID  string
+		Balance int64
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		balances[row.UserID] = row.Balance
+	}
+	return balances, nil
+}
+
+func mapToOperation(operation *database.Operation) *operationsmodels.Operation {
+	return &operationsmodels.Operation{
+		TxID:          operation.TxID,
+		UserID:        operation.UserID,
+		CreatedAt:     operation.CreatedAt,
+		Counterparty:  operation.Counterparty,
+		Type:          operation.Type,
+		Value:         operation.Value,
+		TxStatus:      operation.Transaction.TxStatus,
+		BlockHeight:   operation.Transaction.BlockHeight,
+		BlockHash:     operation.Transaction.BlockHash,
+		Confirmations: operation.Transaction.Confirmations,
+	}
+}
+
+func mapToOperationOutput(output *database.TrackedOutput, _ int) *operationsmodels.TrackedOutput {
+	return &operationsmodels.TrackedOutput{
+		TxID:     output.TxID,
+		Vout:     output.Vout,
+		Satoshis: output.Satoshis,
+	}
+}
+
+func (o *Operations) withConditions(conditions *filter.OperationFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if conditions == nil {
+			return db
+		}
+
+		if conditions.Type != nil {
+			db = db.Where("type = ?", *conditions.Type)
+		}
+		if conditions.Counterparty != nil {
+			// paymails are case-insensitive, so the counterparty is matched exactly but regardless of the case
+			db = db.Where("lower(counterparty) = ?", strings.ToLower(*conditions.Counterparty))
+		}
+		if conditions.MinValue != nil {
+			db = db.Where("value >= ?", *conditions.MinValue)
+		}
+		if conditions.MaxValue != nil {
+			db = db.Where("value <= ?", *conditions.MaxValue)
+		}
+		if createdRange := conditions.CreatedRange; createdRange != nil {
+			if from := lo.FromPtr(createdRange.From); !from.IsZero() {
+				db = db.Where("created_at >= ?", from)
+			}
+			if to := lo.FromPtr(createdRange.To); !to.IsZero() {
+				db = db.Where("created_at <= ?", to)
+			}
+		}
+		if conditions.TxStatus != nil {
+			transactionsWithStatus := o.db.
+				Model(&database.TrackedTransaction{}).
+				Select("id").
+				Where("tx_status = ?", *conditions.TxStatus)
+
+			db = db.Where("tx_id IN (?)", transactionsWithStatus)
+		}
+
+		return db
+	}
+}
+
 // SaveAll saves operations to the database.
 func (o *Operations) SaveAll(ctx context.Context, operations iter.Seq[*txmodels.NewOperation]) error {
 	rows := mapOperations(operations)
@@ -110,6 +270,10 @@ func mapTransaction(operation *txmodels.NewOperation) *database.TrackedTransacti
 		RawHex:   lo.If(rawHex != "", &rawHex).Else(nil),
 	}
 
+	if operation.Transaction.ReservationID != "" {
+		tx.ReleaseReservation(operation.Transaction.ReservationUserID, operation.Transaction.ReservationID)
+	}
+
 	for _, input := range operation.Transaction.TransactionInputSources() {
 		tx.SourceTxInputs = append(tx.SourceTxInputs, database.TxInput{
 			TxID:       input.TxID,
@@ -140,6 +304,16 @@ func mapTransaction(operation *txmodels.NewOperation) *database.TrackedTransacti
 				output.UTXO.EstimatedInputSize,
 				output.UTXO.CustomInstructions,
 			)
+			if output.Banknote != nil {
+				tx.CreateBanknote(&database.UserBanknote{
+					TxID:         operation.Transaction.ID,
+					Vout:         output.Vout,
+					UserID:       output.UserID,
+					StablecoinID: output.Banknote.StablecoinID,
+					Serial:       output.Banknote.Serial,
+					Amount:       output.Banknote.Amount,
+				})
+			}
 		} else if output.Data != nil {
 			tx.CreateDataOutput(&database.Data{
 				TxID:   operation.Transaction.ID,
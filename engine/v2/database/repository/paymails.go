@@ -38,12 +38,18 @@ func (p *Paymails) Create(ctx context.Context, newPaymail *paymailsmodels.NewPay
 	return p.newPaymailModel(row), nil
 }
 
-// Find returns a paymail by alias and domain.
+// Find returns a paymail by alias and domain. Paymails of deactivated users are not returned.
 func (p *Paymails) Find(ctx context.Context, alias, domain string) (*paymailsmodels.Paymail, error) {
+	activeUsers := p.db.
+		Model(&database.User{}).
+		Select("id").
+		Where("deactivated_at IS NULL")
+
 	var row database.Paymail
 	if err := p.db.
 		WithContext(ctx).
 		Where("alias = ? AND domain = ?", alias, domain).
+		Where("user_id IN (?)", activeUsers).
 		First(&row).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -84,6 +90,52 @@ func (p *Paymails) GetDefault(ctx context.Context, userID string) (*paymailsmode
 	return p.newPaymailModel(row), nil
 }
 
+// Update updates the public name and avatar of the user's paymail.
+// It returns nil if the paymail doesn't exist or belongs to another user.
+func (p *Paymails) Update(ctx context.Context, userID string, paymailID uint, update *paymailsmodels.PaymailUpdate) (*paymailsmodels.Paymail, error) {
+	var row database.Paymail
+	if err := p.db.
+		WithContext(ctx).
+		Where("id = ? AND user_id = ?", paymailID, userID).
+		First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if update.PublicName != nil {
+		row.PublicName = *update.PublicName
+	}
+	if update.Avatar != nil {
+		row.Avatar = *update.Avatar
+	}
+
+	if err := p.db.
+		WithContext(ctx).
+		Model(&row).
+		Select("public_name", "avatar").
+		Updates(&row).Error; err != nil {
+		return nil, err
+	}
+
+	return p.newPaymailModel(row), nil
+}
+
+// Delete removes the user's paymail. It returns false if the paymail doesn't exist or belongs to another user.
+// The paymail is soft-deleted, so its alias cannot be taken over by another user.
+func (p *Paymails) Delete(ctx context.Context, userID string, paymailID uint) (bool, error) {
+	result := p.db.
+		WithContext(ctx).
+		Where("id = ? AND user_id = ?", paymailID, userID).
+		Delete(&database.Paymail{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 func (p *Paymails) newPaymailModel(row database.Paymail) *paymailsmodels.Paymail {
 	return &paymailsmodels.Paymail{
 		ID:        row.ID,
@@ -9,6 +9,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/beef"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -26,9 +27,10 @@ func NewTransactions(db *gorm.DB) *Transactions {
 // UpdateTransaction updates the tracked transaction with the given transaction data and makes cleanup of the input sources.
 func (t *Transactions) UpdateTransaction(ctx context.Context, trackedTx *txmodels.TrackedTransaction) error {
 	toUpdate := map[string]any{
-		"block_hash":   trackedTx.BlockHash,
-		"block_height": trackedTx.BlockHeight,
-		"tx_status":    trackedTx.TxStatus,
+		"block_hash":    trackedTx.BlockHash,
+		"block_height":  trackedTx.BlockHeight,
+		"confirmations": trackedTx.Confirmations,
+		"tx_status":     trackedTx.TxStatus,
 	}
 	if trackedTx.BeefHex != nil {
 		toUpdate["beef_hex"] = trackedTx.BeefHex
@@ -50,9 +52,24 @@ func (t *Transactions) UpdateTransaction(ctx context.Context, trackedTx *txmodel
 			return err
 		}
 
-		return tx.
+		err = tx.
 			Where("tx_id = ?", trackedTx.ID).
 			Delete(&database.TxInput{}).Error
+		if err != nil {
+			return err
+		}
+
+		sourceTXIDs := trackedTx.SourceTXIDs()
+		if trackedTx.RawHex == nil || len(sourceTXIDs) == 0 {
+			return nil
+		}
+
+		return tx.Create(lo.Map(sourceTXIDs, func(sourceTXID string, _ int) database.TxInput {
+			return database.TxInput{
+				TxID:       trackedTx.ID,
+				SourceTxID: sourceTXID,
+			}
+		})).Error
 	})
 
 	if err != nil {
@@ -72,19 +89,38 @@ func (t *Transactions) GetTransaction(ctx context.Context, txID string) (transac
 		return nil, spverrors.Wrapf(err, "failed to query transaction hex for %s", txID)
 	}
 
-	return &txmodels.TrackedTransaction{
-		ID:       record.ID,
-		TxStatus: txmodels.TxStatus(record.TxStatus),
+	return mapToTrackedTransaction(&record), nil
+}
 
-		CreatedAt: record.CreatedAt,
-		UpdatedAt: record.UpdatedAt,
+// FindMinedTransactionsBelowDepth retrieves the mined transactions which haven't reached the given number of confirmations yet.
+func (t *Transactions) FindMinedTransactionsBelowDepth(ctx context.Context, confirmationDepth uint32) ([]*txmodels.TrackedTransaction, error) {
+	var records []*database.TrackedTransaction
+	err := t.db.
+		WithContext(ctx).
+		Where("tx_status = ?", txmodels.TxStatusMined).
+		Where("confirmations < ?", confirmationDepth).
+		Order("block_height ASC").
+		Find(&records).Error
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to query mined transactions below confirmation depth %d", confirmationDepth)
+	}
 
-		BlockHeight: record.BlockHeight,
-		BlockHash:   record.BlockHash,
+	return lo.Map(records, func(record *database.TrackedTransaction, _ int) *txmodels.TrackedTransaction {
+		return mapToTrackedTransaction(record)
+	}), nil
+}
 
-		BeefHex: record.BeefHex,
-		RawHex:  record.RawHex,
-	}, nil
+// UpdateConfirmations sets the number of confirmations for all the mined transactions from the block with the given hash.
+func (t *Transactions) UpdateConfirmations(ctx context.Context, blockHash string, confirmations uint32) error {
+	err := t.db.
+		WithContext(ctx).
+		Model(&database.TrackedTransaction{}).
+		Where("block_hash = ? AND tx_status = ?", blockHash, txmodels.TxStatusMined).
+		Update("confirmations", confirmations).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to update confirmations of transactions from block %s", blockHash)
+	}
+	return nil
 }
 
 // HasTransactionInputSources checks if all the provided input source transaction IDs exist in the database.
@@ -188,3 +224,20 @@ func (v visitedTransactions) isNotVisited(txID string) bool {
 }
 
 func (v visitedTransactions) recordVisited(txID string) { v[txID] = struct{}{} }
+
+func mapToTrackedTransaction(record *database.TrackedTransaction) *txmodels.TrackedTransaction {
+	return &txmodels.TrackedTransaction{
+		ID:       record.ID,
+		TxStatus: txmodels.TxStatus(record.TxStatus),
+
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+
+		BlockHeight:   record.BlockHeight,
+		BlockHash:     record.BlockHash,
+		Confirmations: record.Confirmations,
+
+		BeefHex: record.BeefHex,
+		RawHex:  record.RawHex,
+	}
+}
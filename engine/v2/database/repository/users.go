@@ -2,12 +2,20 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/bitcoin-sv/go-paymail"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/dbquery"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/paymails/paymailsmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/users/usersmodels"
+	"github.com/bitcoin-sv/spv-wallet/lox"
+	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
 	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 	"github.com/samber/lo"
 	"gorm.io/gorm"
@@ -34,14 +42,14 @@ func (u *Users) Exists(ctx context.Context, userID string) (bool, error) {
 	return count > 0, nil
 }
 
-// GetIDByPubKey returns a user by its public key. If the user does not exist, it returns error.
+// GetIDByPubKey returns an active user by its public key. If the user does not exist or is deactivated, it returns error.
 func (u *Users) GetIDByPubKey(ctx context.Context, pubKey string) (string, error) {
 	var user struct {
 		ID string
 	}
 	err := u.db.WithContext(ctx).
 		Model(&database.User{}).
-		Where("pub_key = ?", pubKey).
+		Where("pub_key = ? AND deactivated_at IS NULL", pubKey).
 		First(&user).Error
 	if err != nil {
 		return "", spverrors.Wrapf(err, "failed to get user by public key")
@@ -64,6 +72,38 @@ func (u *Users) Get(ctx context.Context, userID string) (*usersmodels.User, erro
 	return mapToDomainUser(&user), nil
 }
 
+// Search returns a page of users (with their paymails) matching the conditions.
+func (u *Users) Search(ctx context.Context, page filter.Page, conditions *filter.UserFilter) (*models.PagedResult[usersmodels.User], error) {
+	rows, err := dbquery.PaginatedQuery[database.User](
+		ctx,
+		page,
+		u.db,
+		u.withConditions(conditions),
+		withPaymailsScope,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.PagedResult[usersmodels.User]{
+		PageDescription: rows.PageDescription,
+		Content:         lo.Map(rows.Content, lox.MappingFn(mapToDomainUser)),
+	}, nil
+}
+
+// SetDeactivatedAt sets (or clears with nil) the time of the user's deactivation.
+func (u *Users) SetDeactivatedAt(ctx context.Context, userID string, deactivatedAt *time.Time) error {
+	err := u.db.WithContext(ctx).
+		Model(&database.User{}).
+		Where("id = ?", userID).
+		Update("deactivated_at", deactivatedAt).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to set user's deactivation time")
+	}
+
+	return nil
+}
+
 // Create saves new user to the database.
 func (u *Users) Create(ctx context.Context, newUser *usersmodels.NewUser) (*usersmodels.User, error) {
 	query := u.db.WithContext(ctx)
@@ -105,12 +145,151 @@ func (u *Users) GetBalance(ctx context.Context, userID string, bucket bucket.Nam
 	return balance, nil
 }
 
+// GetConfirmedBalance returns the balance of a user in a given bucket,
+// counting only the UTXOs of the mined transactions with at least the given number of confirmations.
+func (u *Users) GetConfirmedBalance(ctx context.Context, userID string, bucket bucket.Name, confirmations uint32) (bsv.Satoshis, error) {
+	confirmedTransactions := u.db.
+		Model(&database.TrackedTransaction{}).
+		Select("id").
+		Where("tx_status = ? AND confirmations >= ?", txmodels.TxStatusMined, confirmations)
+
+	var balance bsv.Satoshis
+	err := u.db.
+		WithContext(ctx).
+		Model(&database.UserUTXO{}).
+		Where("user_id = ? AND bucket = ?", userID, bucket).
+		Where("tx_id IN (?)", confirmedTransactions).
+		Select("COALESCE(SUM(satoshis), 0)").
+		Row().
+		Scan(&balance)
+
+	if err != nil {
+		return 0, spverrors.Wrapf(err, "failed to get confirmed balance")
+	}
+
+	return balance, nil
+}
+
+// GetBalanceAt returns the balance of a user made of the operations created at or before the given time.
+func (u *Users) GetBalanceAt(ctx context.Context, userID string, at time.Time) (usersmodels.Balance, error) {
+	operations := u.db.
+		WithContext(ctx).
+		Model(&database.Operation{}).
+		Where("user_id = ? AND created_at <= ?", userID, at)
+
+	total, err := sumOfValues(operations.Session(&gorm.Session{}).Where("tx_id NOT IN (?)", u.transactionsWithStatus(txmodels.TxStatusReverted)))
+	if err != nil {
+		return usersmodels.Balance{}, spverrors.Wrapf(err, "failed to get balance at %s", at)
+	}
+
+	confirmed, err := sumOfValues(operations.Session(&gorm.Session{}).Where("tx_id IN (?)", u.transactionsWithStatus(txmodels.TxStatusMined)))
+	if err != nil {
+		return usersmodels.Balance{}, spverrors.Wrapf(err, "failed to get confirmed balance at %s", at)
+	}
+
+	return usersmodels.Balance{
+		Confirmed:   confirmed,
+		Unconfirmed: total - confirmed,
+	}, nil
+}
+
+// GetBalanceAtBlockHeight returns the balance of a user made of the operations on transactions mined at or below the given block height.
+func (u *Users) GetBalanceAtBlockHeight(ctx context.Context, userID string, blockHeight int64) (usersmodels.Balance, error) {
+	minedTransactions := u.transactionsWithStatus(txmodels.TxStatusMined).
+		Where("block_height <= ?", blockHeight)
+
+	confirmed, err := sumOfValues(u.db.
+		WithContext(ctx).
+		Model(&database.Operation{}).
+		Where("user_id = ?", userID).
+		Where("tx_id IN (?)", minedTransactions))
+	if err != nil {
+		return usersmodels.Balance{}, spverrors.Wrapf(err, "failed to get balance at block height %d", blockHeight)
+	}
+
+	return usersmodels.Balance{Confirmed: confirmed}, nil
+}
+
+// GetDailyBalanceChanges returns the changes of the user's balance made by the operations created in the time range [from, to),
+// summed per day (UTC) and ordered by the day. Operations on reverted transactions are skipped.
+func (u *Users) GetDailyBalanceChanges(ctx context.Context, userID string, from, to time.Time) ([]*usersmodels.BalanceChange, error) {
+	var rows []struct {
+		Day         string
+		Confirmed   int64
+		Unconfirmed int64
+	}
+	err := u.db.
+		WithContext(ctx).
+		Model(&database.Operation{}).
+		Select(
+			u.utcDayOf("created_at")+" AS day, "+
+				"COALESCE(SUM(CASE WHEN tx_id IN (?) THEN value ELSE 0 END), 0) AS confirmed, "+
+				"COALESCE(SUM(CASE WHEN tx_id IN (?) THEN 0 ELSE value END), 0) AS unconfirmed",
+			u.transactionsWithStatus(txmodels.TxStatusMined),
+			u.transactionsWithStatus(txmodels.TxStatusMined),
+		).
+		Where("user_id = ? AND created_at >= ? AND created_at < ?", userID, from, to).
+		Where("tx_id NOT IN (?)", u.transactionsWithStatus(txmodels.TxStatusReverted)).
+		Group("day").
+		Order("day").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get daily balance changes")
+	}
+
+	changes := make([]*usersmodels.BalanceChange, 0, len(rows))
+	for _, row := range rows {
+		date, err := time.Parse(time.DateOnly, row.Day)
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to parse day of balance change %s", row.Day)
+		}
+		changes = append(changes, &usersmodels.BalanceChange{
+			Date: date,
+			Balance: usersmodels.Balance{
+				Confirmed:   row.Confirmed,
+				Unconfirmed: row.Unconfirmed,
+			},
+		})
+	}
+	return changes, nil
+}
+
+// utcDayOf returns the SQL expression formatting the day (UTC) of the timestamp column as YYYY-MM-DD
+func (u *Users) utcDayOf(column string) string {
+	if u.db.Dialector.Name() == "postgres" {
+		return fmt.Sprintf("to_char(%s AT TIME ZONE 'UTC', 'YYYY-MM-DD')", column)
+	}
+	return fmt.Sprintf("strftime('%%Y-%%m-%%d', %s)", column)
+}
+
+func (u *Users) transactionsWithStatus(status txmodels.TxStatus) *gorm.DB {
+	return u.db.
+		Model(&database.TrackedTransaction{}).
+		Select("id").
+		Where("tx_status = ?", status)
+}
+
+func sumOfValues(operations *gorm.DB) (int64, error) {
+	var sum int64
+	err := operations.
+		Select("COALESCE(SUM(value), 0)").
+		Row().
+		Scan(&sum)
+	if err != nil {
+		return 0, err //nolint:wrapcheck // wrapped by the caller
+	}
+	return sum, nil
+}
+
 func mapToDomainUser(user *database.User) *usersmodels.User {
 	return &usersmodels.User{
 		ID:        user.ID,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 		PublicKey: user.PubKey,
+
+		DeactivatedAt: user.DeactivatedAt,
+
 		Paymails: lo.Map(user.Paymails, func(p *database.Paymail, _ int) *paymailsmodels.Paymail {
 			return &paymailsmodels.Paymail{
 				ID:        p.ID,
@@ -129,6 +308,44 @@ func mapToDomainUser(user *database.User) *usersmodels.User {
 	}
 }
 
+func (u *Users) withConditions(conditions *filter.UserFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if conditions == nil {
+			return db
+		}
+
+		if conditions.PublicKey != nil {
+			db = db.Where("pub_key = ?", *conditions.PublicKey)
+		}
+		if conditions.Paymail != nil {
+			alias, domain, _ := paymail.SanitizePaymail(*conditions.Paymail)
+			usersWithPaymail := u.db.
+				Model(&database.Paymail{}).
+				Select("user_id").
+				Where("alias = ? AND domain = ?", alias, domain)
+
+			db = db.Where("id IN (?)", usersWithPaymail)
+		}
+		if createdRange := conditions.CreatedRange; createdRange != nil {
+			if from := lo.FromPtr(createdRange.From); !from.IsZero() {
+				db = db.Where("created_at >= ?", from)
+			}
+			if to := lo.FromPtr(createdRange.To); !to.IsZero() {
+				db = db.Where("created_at <= ?", to)
+			}
+		}
+		if conditions.Active != nil {
+			if *conditions.Active {
+				db = db.Where("deactivated_at IS NULL")
+			} else {
+				db = db.Where("deactivated_at IS NOT NULL")
+			}
+		}
+
+		return db
+	}
+}
+
 func withPaymailsScope(db *gorm.DB) *gorm.DB {
 	return db.Preload("Paymails", func(db *gorm.DB) *gorm.DB {
 		// NOTE: To preserve deterministic order necessary to get default paymail as the first one
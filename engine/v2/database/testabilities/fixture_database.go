@@ -2,6 +2,7 @@ package testabilities
 
 import (
 	"testing"
+	"time"
 
 	testengine "github.com/bitcoin-sv/spv-wallet/engine/testabilities"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
@@ -27,6 +28,8 @@ type UserUtxoFixture interface {
 	P2PKH() UserUtxoFixture
 	// WithSatoshis sets the satoshis value of the UTXO.
 	WithSatoshis(satoshis bsv.Satoshis) UserUtxoFixture
+	// ReservedUntil marks the UTXO as reserved for the transaction outline with given reservation ID until given time.
+	ReservedUntil(reservationID string, until time.Time) UserUtxoFixture
 
 	Storable[database.UserUTXO]
 }
@@ -23,6 +23,8 @@ type userUtxoFixture struct {
 	vout               uint32
 	satoshis           bsv.Satoshis
 	estimatedInputSize uint64
+	reservationID      *string
+	reservedUntil      *time.Time
 }
 
 func newUtxoFixture(t testing.TB, db *gorm.DB, index uint32) *userUtxoFixture {
@@ -62,6 +64,12 @@ func (f *userUtxoFixture) WithSatoshis(satoshis bsv.Satoshis) UserUtxoFixture {
 	return f
 }
 
+func (f *userUtxoFixture) ReservedUntil(reservationID string, until time.Time) UserUtxoFixture {
+	f.reservationID = &reservationID
+	f.reservedUntil = &until
+	return f
+}
+
 func (f *userUtxoFixture) Stored() *database.UserUTXO {
 	utxo := &database.UserUTXO{
 		UserID:             f.userID,
@@ -72,6 +80,8 @@ func (f *userUtxoFixture) Stored() *database.UserUTXO {
 		Bucket:             string(bucket.BSV),
 		CreatedAt:          FirstCreatedAt.Add(time.Duration(f.index) * time.Second),
 		TouchedAt:          FirstCreatedAt.Add(time.Duration(24) * time.Hour),
+		ReservationID:      f.reservationID,
+		ReservedUntil:      f.reservedUntil,
 	}
 
 	f.db.Create(utxo)
@@ -22,7 +22,7 @@ type TxInput struct {
 // TrackedTransaction represents a transaction in the database.
 type TrackedTransaction struct {
 	ID       string `gorm:"type:char(64);primaryKey"`
-	TxStatus string
+	TxStatus string `gorm:"index"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -30,12 +30,20 @@ type TrackedTransaction struct {
 	BlockHeight *int64
 	BlockHash   *string
 
+	Confirmations uint32 `gorm:"default:0"`
+
 	Data []*Data `gorm:"foreignKey:TxID"`
 
 	Inputs  []*TrackedOutput `gorm:"foreignKey:SpendingTX"`
 	Outputs []*TrackedOutput `gorm:"foreignKey:TxID"`
 
-	newUTXOs []*UserUTXO `gorm:"-"`
+	newUTXOs     []*UserUTXO     `gorm:"-"`
+	newBanknotes []*UserBanknote `gorm:"-"`
+
+	// reservationID is the reservation of UTXOs made for the outline of this transaction, released when the transaction is created.
+	reservationID string `gorm:"-"`
+	// reservationUserID is the owner of the reserved UTXOs, so only the user's reservation is released.
+	reservationUserID string `gorm:"-"`
 
 	BeefHex        *string   `gorm:"column:beef_hex"`
 	RawHex         *string   `gorm:"column:raw_hex"`
@@ -68,13 +76,26 @@ func (t *TrackedTransaction) CreateUTXO(
 	t.newUTXOs = append(t.newUTXOs, NewUTXO(output, bucket, estimatedInputSize, customInstructions))
 }
 
+// CreateBanknote prepares a new banknote of the BSV-21 token output and adds it to the transaction.
+// The banknote is considered spent once its UTXO is removed by the spending transaction.
+func (t *TrackedTransaction) CreateBanknote(banknote *UserBanknote) {
+	t.newBanknotes = append(t.newBanknotes, banknote)
+}
+
 // CreateDataOutput prepares a new Data output and adds it to the transaction.
 func (t *TrackedTransaction) CreateDataOutput(data *Data) {
 	t.Data = append(t.Data, data)
 }
 
+// ReleaseReservation marks the user's reservation of UTXOs to be released after creating the transaction,
+// so the reserved UTXOs which are not spent by this transaction can be selected again.
+func (t *TrackedTransaction) ReleaseReservation(userID, reservationID string) {
+	t.reservationUserID = userID
+	t.reservationID = reservationID
+}
+
 // AfterCreate is a hook that is called after creating the transaction.
-// It is responsible for adding new (User's) UTXOs and removing spent UTXOs.
+// It is responsible for adding new (User's) UTXOs and banknotes, removing spent UTXOs and releasing the reservation of UTXOs.
 func (t *TrackedTransaction) AfterCreate(tx *gorm.DB) error {
 	// Add new UTXOs
 	if len(t.newUTXOs) > 0 {
@@ -84,6 +105,13 @@ func (t *TrackedTransaction) AfterCreate(tx *gorm.DB) error {
 		}
 	}
 
+	if len(t.newBanknotes) > 0 {
+		err := tx.Model(&UserBanknote{}).Create(t.newBanknotes).Error
+		if err != nil {
+			return spverrors.Wrapf(err, "failed to save user banknotes")
+		}
+	}
+
 	spentOutpoints := slices.AppendSeq(
 		make([][]any, 0, len(t.Inputs)),
 		func(yield func(sqlPair []any) bool) {
@@ -102,5 +130,16 @@ func (t *TrackedTransaction) AfterCreate(tx *gorm.DB) error {
 		}
 	}
 
+	if t.reservationID != "" {
+		err := tx.Model(&UserUTXO{}).
+			Where("user_id = ?", t.reservationUserID).
+			Where("reservation_id = ?", t.reservationID).
+			Updates(map[string]any{"reservation_id": nil, "reserved_until": nil}).
+			Error
+		if err != nil {
+			return spverrors.Wrapf(err, "failed to release reserved utxos")
+		}
+	}
+
 	return nil
 }
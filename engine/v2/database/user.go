@@ -17,6 +17,10 @@ type User struct {
 
 	PubKey string `gorm:"index;unique;not null"`
 
+	// DeactivatedAt is set when the user is deactivated by admin; deactivated users cannot authenticate
+	// and their paymails are not resolved.
+	DeactivatedAt *time.Time `gorm:"index"`
+
 	Paymails  []*Paymail `gorm:"foreignKey:UserID"`
 	Addresses []*Address `gorm:"foreignKey:UserID"`
 }
@@ -0,0 +1,19 @@
+package database
+
+import "time"
+
+// UserBanknote holds the BSV-21 token details of the user's token output (stablecoin banknote).
+// The banknote is unspent as long as its output is in the user's UTXOs.
+type UserBanknote struct {
+	TxID string `gorm:"primaryKey"`
+	Vout uint32 `gorm:"primaryKey"`
+
+	UserID       string `gorm:"index:idx_user_banknotes_stablecoin,priority:1"`
+	StablecoinID string `gorm:"index:idx_user_banknotes_stablecoin,priority:2"`
+
+	// Serial is the token ID of the banknote.
+	Serial string
+	Amount uint64
+
+	CreatedAt time.Time
+}
@@ -29,6 +29,10 @@ type UserUTXO struct {
 	TouchedAt time.Time `gorm:"uniqueIndex:idx_window,sort:asc,priority:2"`
 	// CustomInstructions is the list of instructions for unlocking given UTXO (it should be understood by client).
 	CustomInstructions datatypes.JSONSlice[bsv.CustomInstruction]
+	// ReservationID identifies the transaction outline the UTXO is reserved for, nil when the UTXO is not reserved.
+	ReservationID *string `gorm:"index"`
+	// ReservedUntil is the time when the reservation expires, so the UTXO can be selected for other transaction outlines again.
+	ReservedUntil *time.Time
 }
 
 // NewUTXO creates a new UserUTXO from the given TrackedOutput and additional data.
@@ -0,0 +1,63 @@
+package operations
+
+import (
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations/operationsmodels"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/samber/lo"
+)
+
+// transactionFee calculates the fee paid for the transaction of the operation.
+// Satoshis of the inputs are taken from the source transactions (BEEF) or from the given spent outputs.
+// It returns nil if the satoshis of any input are unknown (e.g. raw hex transaction spending outputs of other users).
+func transactionFee(details *operationsmodels.OperationDetails, spentOutputs []*operationsmodels.TrackedOutput) (*bsv.Satoshis, error) {
+	tx, err := parseTransaction(details)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+
+	spentSatoshis := lo.SliceToMap(spentOutputs, func(output *operationsmodels.TrackedOutput) (bsv.Outpoint, bsv.Satoshis) {
+		return output.Outpoint(), output.Satoshis
+	})
+
+	var inputsTotal bsv.Satoshis
+	for _, input := range tx.Inputs {
+		if sourceSatoshis := input.SourceTxSatoshis(); sourceSatoshis != nil {
+			inputsTotal += bsv.Satoshis(*sourceSatoshis)
+			continue
+		}
+
+		satoshis, ok := spentSatoshis[bsv.Outpoint{TxID: input.SourceTXID.String(), Vout: input.SourceTxOutIndex}]
+		if !ok {
+			return nil, nil
+		}
+		inputsTotal += satoshis
+	}
+
+	outputsTotal := bsv.Satoshis(tx.TotalOutputSatoshis())
+	if inputsTotal < outputsTotal {
+		return nil, nil
+	}
+
+	return lo.ToPtr(inputsTotal - outputsTotal), nil
+}
+
+func parseTransaction(details *operationsmodels.OperationDetails) (*trx.Transaction, error) {
+	switch {
+	case details.BeefHex != nil:
+		tx, err := trx.NewTransactionFromBEEFHex(*details.BeefHex)
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to parse BEEF hex")
+		}
+		return tx, nil
+	case details.RawHex != nil:
+		tx, err := trx.NewTransactionFromHex(*details.RawHex)
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to parse raw hex")
+		}
+		return tx, nil
+	default:
+		return nil, nil
+	}
+}
@@ -2,6 +2,7 @@ package operations
 
 import (
 	"context"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations/operationsmodels"
 	"github.com/bitcoin-sv/spv-wallet/models"
@@ -10,5 +11,8 @@ import (
 
 // Repo is an interface for operations repository.
 type Repo interface {
-	PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[operationsmodels.Operation], error)
+	PaginatedForUser(ctx context.Context, userID string, page filter.Page, conditions *filter.OperationFilter) (*models.PagedResult[operationsmodels.Operation], error)
+	FindForUser(ctx context.Context, userID string, txID string) (*operationsmodels.OperationDetails, error)
+	HistoryBatch(ctx context.Context, userID string, createdRange *filter.TimeRange, after *operationsmodels.HistoryCursor, limit int) ([]*operationsmodels.OperationDetails, error)
+	BalancesBefore(ctx context.Context, userID string, before time.Time) (map[string]int64, error)
 }
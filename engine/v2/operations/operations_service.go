@@ -2,11 +2,18 @@ package operations
 
 import (
 	"context"
+	"iter"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/operations/operationsmodels"
 	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/bitcoin-sv/spv-wallet/models/filter"
+	"github.com/samber/lo"
+)
+
+const (
+	historyBatchSize      = 500
+	outgoingOperationType = "outgoing"
 )
 
 // Service is a service for operations.
@@ -19,12 +26,92 @@ func NewService(repo Repo) *Service {
 	return &Service{repo: repo}
 }
 
-// PaginatedForUser returns operations for a user based on userID and the provided paging options.
-func (s *Service) PaginatedForUser(ctx context.Context, userID string, page filter.Page) (*models.PagedResult[operationsmodels.Operation], error) {
-	entities, err := s.repo.PaginatedForUser(ctx, userID, page)
+// PaginatedForUser returns operations for a user based on userID, the provided filter conditions and paging options.
+func (s *Service) PaginatedForUser(ctx context.Context, userID string, page filter.Page, conditions *filter.OperationFilter) (*models.PagedResult[operationsmodels.Operation], error) {
+	entities, err := s.repo.PaginatedForUser(ctx, userID, page, conditions)
 	if err != nil {
 		return nil, spverrors.Wrapf(err, "failed to get operations for user")
 	}
 
 	return entities, nil
 }
+
+// FindForUser returns the operation of a user on the given transaction with the breakdown of the transaction.
+// It returns nil if the operation is not found.
+func (s *Service) FindForUser(ctx context.Context, userID string, txID string) (*operationsmodels.OperationDetails, error) {
+	details, err := s.repo.FindForUser(ctx, userID, txID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to find operation %s for user", txID)
+	}
+	if details == nil {
+		return nil, nil
+	}
+
+	details.Fee, err = transactionFee(details, details.Inputs)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to calculate fee of transaction %s", txID)
+	}
+
+	return details, nil
+}
+
+// History iterates over the operations of a user (or of all users when userID is empty) created in the given time range,
+// in chronological order, together with the running balance of each user and the fee of the outgoing transactions.
+// The operations are fetched in batches, so the whole history is never loaded into memory.
+func (s *Service) History(ctx context.Context, userID string, createdRange *filter.TimeRange) iter.Seq2[*operationsmodels.HistoryEntry, error] {
+	return func(yield func(*operationsmodels.HistoryEntry, error) bool) {
+		balances := map[string]int64{}
+		if createdRange != nil && !lo.FromPtr(createdRange.From).IsZero() {
+			opening, err := s.repo.BalancesBefore(ctx, userID, *createdRange.From)
+			if err != nil {
+				yield(nil, spverrors.Wrapf(err, "failed to get balances before the history"))
+				return
+			}
+			balances = opening
+		}
+
+		var cursor *operationsmodels.HistoryCursor
+		for {
+			batch, err := s.repo.HistoryBatch(ctx, userID, createdRange, cursor, historyBatchSize)
+			if err != nil {
+				yield(nil, spverrors.Wrapf(err, "failed to get operations history"))
+				return
+			}
+
+			for _, details := range batch {
+				entry, err := historyEntry(details, balances)
+				if !yield(entry, err) || err != nil {
+					return
+				}
+			}
+
+			if len(batch) < historyBatchSize {
+				return
+			}
+			last := batch[len(batch)-1]
+			cursor = &operationsmodels.HistoryCursor{
+				CreatedAt: last.CreatedAt,
+				TxID:      last.TxID,
+				UserID:    last.UserID,
+			}
+		}
+	}
+}
+
+func historyEntry(details *operationsmodels.OperationDetails, balances map[string]int64) (*operationsmodels.HistoryEntry, error) {
+	balances[details.UserID] += details.Value
+	entry := &operationsmodels.HistoryEntry{
+		Operation: details.Operation,
+		Balance:   balances[details.UserID],
+	}
+
+	if details.Type == outgoingOperationType {
+		fee, err := transactionFee(details, details.Inputs)
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to calculate fee of transaction %s", details.TxID)
+		}
+		entry.Fee = fee
+	}
+
+	return entry, nil
+}
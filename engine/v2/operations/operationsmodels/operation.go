@@ -1,6 +1,10 @@
 package operationsmodels
 
-import "time"
+import (
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
 
 // Operation represents a user's operation on with underlying transaction.
 type Operation struct {
@@ -15,6 +19,66 @@ type Operation struct {
 
 	TxStatus string
 
-	BlockHeight *int64
-	BlockHash   *string
+	BlockHeight   *int64
+	BlockHash     *string
+	Confirmations uint32
+}
+
+// OperationDetails represents a user's operation with the breakdown of its underlying transaction.
+type OperationDetails struct {
+	Operation
+
+	// Inputs are the user's outputs spent by the transaction.
+	Inputs []*TrackedOutput
+	// Outputs are the outputs of the transaction received by the user.
+	Outputs []*TrackedOutput
+	// Data are the data outputs of the transaction which belong to the user.
+	Data []*DataOutput
+
+	// Fee is the fee paid for the transaction; nil if it can't be calculated.
+	Fee *bsv.Satoshis
+
+	BeefHex *string
+	RawHex  *string
+}
+
+// TrackedOutput represents a user's output of a transaction.
+type TrackedOutput struct {
+	TxID     string
+	Vout     uint32
+	Satoshis bsv.Satoshis
+}
+
+// Outpoint returns bsv.Outpoint object which identifies the output.
+func (o *TrackedOutput) Outpoint() bsv.Outpoint {
+	return bsv.Outpoint{TxID: o.TxID, Vout: o.Vout}
+}
+
+// DataOutput represents a user's data output of a transaction.
+type DataOutput struct {
+	TxID string
+	Vout uint32
+	Blob []byte
+}
+
+// ID returns the identifier (outpoint string) of the data output.
+func (d *DataOutput) ID() string {
+	return bsv.Outpoint{TxID: d.TxID, Vout: d.Vout}.String()
+}
+
+// HistoryEntry is an operation in the history of a user with the running balance of that user after the operation.
+type HistoryEntry struct {
+	Operation
+
+	// Fee is the fee paid by the user for an outgoing transaction; nil for other operations or if it can't be calculated.
+	Fee *bsv.Satoshis
+	// Balance is the sum of values of all the user's operations up to (and including) this one.
+	Balance int64
+}
+
+// HistoryCursor points at the last operation of the previously fetched batch of the history.
+type HistoryCursor struct {
+	CreatedAt time.Time
+	TxID      string
+	UserID    string
 }
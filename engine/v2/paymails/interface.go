@@ -14,6 +14,10 @@ type PaymailRepo interface {
 	FindForUser(ctx context.Context, alias, domain, userID string) (*paymailsmodels.Paymail, error)
 	// GetDefault returns a default paymail for user.
 	GetDefault(ctx context.Context, userID string) (*paymailsmodels.Paymail, error)
+	// Update updates the user's paymail; it returns nil if the paymail is not found for the user.
+	Update(ctx context.Context, userID string, paymailID uint, update *paymailsmodels.PaymailUpdate) (*paymailsmodels.Paymail, error)
+	// Delete removes the user's paymail; it returns false if the paymail is not found for the user.
+	Delete(ctx context.Context, userID string, paymailID uint) (bool, error)
 }
 
 // UsersService is a user domain service
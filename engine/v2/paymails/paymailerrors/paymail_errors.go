@@ -8,5 +8,8 @@ var ErrNoDefaultPaymailAddress = models.SPVError{Message: "no default paymail ad
 // ErrInvalidPaymailAddress is when the paymail address is invalid.
 var ErrInvalidPaymailAddress = models.SPVError{Message: "invalid paymail address", StatusCode: 400, Code: "error-invalid-paymail-address"}
 
+// ErrPaymailNotFound is when the paymail cannot be found for the user.
+var ErrPaymailNotFound = models.SPVError{Message: "paymail not found", StatusCode: 404, Code: "error-user-paymail-not-found"}
+
 // ErrInvalidAvatarURL is when url provided for paymail is not empty and is invalid URL format
 var ErrInvalidAvatarURL = models.SPVError{Message: "invalid avatar url", StatusCode: 500, Code: "error-invalid-avatar-url"}
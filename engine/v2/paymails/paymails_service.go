@@ -54,6 +54,34 @@ func (s *Service) Create(ctx context.Context, newPaymail *paymailsmodels.NewPaym
 	return createdPaymail, nil
 }
 
+// Update updates the public name and/or avatar of the user's paymail
+func (s *Service) Update(ctx context.Context, userID string, paymailID uint, update *paymailsmodels.PaymailUpdate) (*paymailsmodels.Paymail, error) {
+	if err := update.ValidateAvatar(); err != nil {
+		return nil, spverrors.Wrapf(err, "invalid avatar url during paymail update")
+	}
+
+	updatedPaymail, err := s.paymailsRepo.Update(ctx, userID, paymailID, update)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to update paymail")
+	}
+	if updatedPaymail == nil {
+		return nil, paymailerrors.ErrPaymailNotFound
+	}
+	return updatedPaymail, nil
+}
+
+// Delete removes the user's paymail
+func (s *Service) Delete(ctx context.Context, userID string, paymailID uint) error {
+	deleted, err := s.paymailsRepo.Delete(ctx, userID, paymailID)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to delete paymail")
+	}
+	if !deleted {
+		return paymailerrors.ErrPaymailNotFound
+	}
+	return nil
+}
+
 // Find returns a paymail by alias and domain
 func (s *Service) Find(ctx context.Context, alias, domain string) (*paymailsmodels.Paymail, error) {
 	paymail, err := s.paymailsRepo.Find(ctx, alias, domain)
@@ -33,13 +33,31 @@ type NewPaymail struct {
 	UserID     string
 }
 
+// PaymailUpdate represents data for updating a paymail; nil fields are left unchanged
+type PaymailUpdate struct {
+	PublicName *string
+	Avatar     *string
+}
+
+// ValidateAvatar checks if the new avatar is either empty string or a proper url link
+func (pu *PaymailUpdate) ValidateAvatar() error {
+	if pu.Avatar == nil {
+		return nil
+	}
+	return validateAvatar(*pu.Avatar)
+}
+
 // ValidateAvatar checks if avatar is either empty string or a proper url link
 func (np *NewPaymail) ValidateAvatar() error {
-	if np.Avatar == "" {
+	return validateAvatar(np.Avatar)
+}
+
+func validateAvatar(avatar string) error {
+	if avatar == "" {
 		return nil
 	}
 
-	URL, err := url.Parse(np.Avatar)
+	URL, err := url.Parse(avatar)
 	if err != nil {
 		return paymailerrors.ErrInvalidAvatarURL.Wrap(err)
 	}
@@ -102,6 +102,14 @@ func (s *serviceProvider) GetPaymailByAlias(ctx context.Context, alias, domain s
 }
 
 func (s *serviceProvider) RecordTransaction(ctx context.Context, p2pTx *paymailserver.P2PTransaction, requestMetadata *server.RequestMetadata) (*paymailserver.P2PTransactionPayload, error) {
+	receiver, err := s.paymails.Find(ctx, requestMetadata.Alias, requestMetadata.Domain)
+	if err != nil {
+		return nil, pmerrors.ErrPaymailDBFailed.Wrap(err)
+	}
+	if receiver == nil {
+		return nil, pmerrors.ErrPaymailNotFound
+	}
+
 	isBEEF := p2pTx.DecodedBeef != nil && p2pTx.Beef != ""
 	isRawTX := p2pTx.Hex != ""
 
@@ -110,11 +118,11 @@ func (s *serviceProvider) RecordTransaction(ctx context.Context, p2pTx *paymails
 	}
 
 	var tx *trx.Transaction
-	var err error
 	if isBEEF {
 		tx, err = trx.NewTransactionFromBEEFHex(p2pTx.Beef)
 	} else {
-		panic("not implemented yet")
+		// source transactions of the raw transaction are hydrated by the recorder
+		tx, err = trx.NewTransactionFromHex(p2pTx.Hex)
 	}
 
 	if err != nil {
@@ -180,6 +188,9 @@ func (s *serviceProvider) createDestinationForUser(ctx context.Context, alias, d
 	if err != nil {
 		return nil, pmerrors.ErrPaymailDBFailed.Wrap(err)
 	}
+	if paymailModel == nil {
+		return nil, pmerrors.ErrPaymailNotFound
+	}
 
 	pki, pkiDerivationKey, err := s.pki(ctx, paymailModel)
 	if err != nil {
@@ -35,6 +35,60 @@ var (
 	// ErrTxOutlinePaymailCannotSplitWhenRecipientSplitting is returned when user choose to split paymail output but the recipient responds from p2p destinations with multiple outputs.
 	ErrTxOutlinePaymailCannotSplitWhenRecipientSplitting = models.SPVError{Code: "tx-outline-paymail-cannot-split-when-recipient-splitting", Message: "cannot split paymail output when recipient responds with multiple outputs", StatusCode: 400}
 
+	// ErrTxOutlineInputDuplicated is returned when the same outpoint is provided more than once in the inputs specification.
+	ErrTxOutlineInputDuplicated = models.SPVError{Code: "tx-outline-input-duplicated", Message: "the same outpoint cannot be provided more than once in inputs specification", StatusCode: 400}
+
+	// ErrTxOutlineInputIncludedAndExcluded is returned when the outpoint is both included and excluded in the inputs specification.
+	ErrTxOutlineInputIncludedAndExcluded = models.SPVError{Code: "tx-outline-input-included-and-excluded", Message: "outpoint cannot be both included and excluded in inputs specification", StatusCode: 400}
+
+	// ErrTxOutlineInputUnavailable is returned when the outpoint to spend is not an unspent output of the user.
+	ErrTxOutlineInputUnavailable = models.SPVError{Code: "tx-outline-input-unavailable", Message: "provided outpoint is not an unspent output of the user", StatusCode: 400}
+
+	// ErrTxOutlineInputReserved is returned when the outpoint to spend is reserved for another transaction outline.
+	ErrTxOutlineInputReserved = models.SPVError{Code: "tx-outline-input-reserved", Message: "provided outpoint is reserved for another transaction outline", StatusCode: 409}
+
+	// ErrTxOutlineReservationIDRequired is returned when cancelling a reservation without providing its ID.
+	ErrTxOutlineReservationIDRequired = models.SPVError{Code: "tx-outline-reservation-id-required", Message: "reservation ID is required", StatusCode: 400}
+
+	// ErrTxOutlineInputsUnsupportedStrategy is returned when the UTXO selection strategy is not supported.
+	ErrTxOutlineInputsUnsupportedStrategy = models.SPVError{Code: "tx-outline-inputs-unsupported-strategy", Message: "unsupported UTXO selection strategy", StatusCode: 400}
+
+	// ErrTxOutlineSweepMultipleOutputs is returned when more than one sweep output is provided in the transaction specification.
+	ErrTxOutlineSweepMultipleOutputs = models.SPVError{Code: "tx-outline-sweep-multiple-outputs", Message: "transaction outline can contain only one sweep output", StatusCode: 400}
+
+	// ErrTxOutlineSweepWithInputOutpoints is returned when sweep output is combined with user-specified input outpoints.
+	ErrTxOutlineSweepWithInputOutpoints = models.SPVError{Code: "tx-outline-sweep-with-input-outpoints", Message: "sweep output cannot be combined with provided input outpoints", StatusCode: 400}
+
+	// ErrTxOutlineSweepWithChange is returned when sweep output is combined with the change specification.
+	ErrTxOutlineSweepWithChange = models.SPVError{Code: "tx-outline-sweep-with-change", Message: "sweep output cannot be combined with change specification", StatusCode: 400}
+
+	// ErrTxOutlineSweepInvalidDestination is returned when the sweep destination is neither a valid paymail nor a valid address.
+	ErrTxOutlineSweepInvalidDestination = models.SPVError{Code: "tx-outline-sweep-invalid-destination", Message: "sweep destination must be a valid paymail or address", StatusCode: 400}
+
+	// ErrTxOutlineSweepInvalidSatoshisRange is returned when the sweep minimal UTXO value is greater than the maximal one.
+	ErrTxOutlineSweepInvalidSatoshisRange = models.SPVError{Code: "tx-outline-sweep-invalid-satoshis-range", Message: "sweep minimal satoshis cannot be greater than maximal satoshis", StatusCode: 400}
+
+	// ErrTxOutlineSweepUnsupportedRecipientDestination is returned when the paymail recipient responds with outputs which cannot be funded by sweep.
+	ErrTxOutlineSweepUnsupportedRecipientDestination = models.SPVError{Code: "tx-outline-sweep-unsupported-recipient-destination", Message: "cannot sweep funds to paymail recipient responding with multiple or non-standard outputs", StatusCode: 400}
+
+	// ErrTxOutlineChangeTooManyOutputs is returned when the change is requested to be split into too many outputs.
+	ErrTxOutlineChangeTooManyOutputs = models.SPVError{Code: "tx-outline-change-too-many-outputs", Message: "change cannot be split into more than 100 outputs", StatusCode: 400}
+
+	// ErrTxOutlineChangeUnsupportedStrategy is returned when the change distribution strategy is not supported.
+	ErrTxOutlineChangeUnsupportedStrategy = models.SPVError{Code: "tx-outline-change-unsupported-strategy", Message: "unsupported change distribution strategy", StatusCode: 400}
+
+	// ErrTxOutlineAddressInvalid is returned when the address of the output is not a valid P2PKH address.
+	ErrTxOutlineAddressInvalid = models.SPVError{Code: "tx-outline-address-invalid", Message: "invalid P2PKH address of the output", StatusCode: 400}
+
+	// ErrTxOutlineAddressUnsupportedNetwork is returned when the address of the output doesn't belong to the network supported by the wallet.
+	ErrTxOutlineAddressUnsupportedNetwork = models.SPVError{Code: "tx-outline-address-unsupported-network", Message: "address of the output must be a mainnet address", StatusCode: 400}
+
+	// ErrTxOutlineScriptRequired is returned when the script output is created without the locking script.
+	ErrTxOutlineScriptRequired = models.SPVError{Code: "tx-outline-script-required", Message: "locking script is required for script output", StatusCode: 400}
+
+	// ErrTxOutlineScriptTooLarge is returned when the locking script of the output exceeds the maximal size.
+	ErrTxOutlineScriptTooLarge = models.SPVError{Code: "tx-outline-script-too-large", Message: "locking script of the output is too large", StatusCode: 400}
+
 	// ErrFailedToDecodeHex is returned when hex decoding fails.
 	ErrFailedToDecodeHex = models.SPVError{Code: "failed-to-decode-hex", Message: "failed to decode hex", StatusCode: 400}
 
@@ -124,4 +178,10 @@ var (
 
 	// ErrOutlineAddChangeOutput is returned when adding a change output to the transaction outline fails.
 	ErrOutlineAddChangeOutput = models.SPVError{Code: "error-outline-add-change-output", Message: "failed to add change output to the transaction outline", StatusCode: 500}
+
+	// ErrGettingSourceTransactions is returned when source transactions of the inputs cannot be retrieved.
+	ErrGettingSourceTransactions = models.SPVError{Code: "error-getting-source-transactions", Message: "failed to get source transactions", StatusCode: 500}
+
+	// ErrSourceTransactionNotFound is returned when a source transaction of the raw transaction input is neither stored in the database nor provided by the source transactions getter.
+	ErrSourceTransactionNotFound = models.SPVError{Code: "error-source-transaction-not-found", Message: "source transaction of the input was not found", StatusCode: 422}
 )
@@ -13,28 +13,31 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 )
 
-func addChangeOutput(ctx *evaluationContext, outputs annotatedOutputs, change bsv.Satoshis) (annotatedOutputs, error) {
+func addChangeOutputs(ctx *evaluationContext, outputs annotatedOutputs, changeValues []bsv.Satoshis) (annotatedOutputs, error) {
 	userPubKey, err := ctx.UserPubKey()
 	if err != nil {
 		return nil, spverrors.Wrapf(err, "failed to get user public key")
 	}
 
-	lockingScript, customInstructions, err := lockingScriptForChangeOutput(userPubKey)
-	if err != nil {
-		return nil, spverrors.Wrapf(err, "failed to create locking script for change output")
-	}
-	changeOutput := &annotatedOutput{
-		OutputAnnotation: &transaction.OutputAnnotation{
-			Bucket:             bucket.BSV,
-			CustomInstructions: &customInstructions,
-		},
-		TransactionOutput: &sdk.TransactionOutput{
-			LockingScript: lockingScript,
-			Satoshis:      uint64(change),
-		},
+	for _, change := range changeValues {
+		lockingScript, customInstructions, err := lockingScriptForChangeOutput(userPubKey)
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to create locking script for change output")
+		}
+		changeOutput := &annotatedOutput{
+			OutputAnnotation: &transaction.OutputAnnotation{
+				Bucket:             bucket.BSV,
+				CustomInstructions: &customInstructions,
+			},
+			TransactionOutput: &sdk.TransactionOutput{
+				LockingScript: lockingScript,
+				Satoshis:      uint64(change),
+			},
+		}
+		outputs = append(outputs, changeOutput)
 	}
 
-	return append(outputs, changeOutput), nil
+	return outputs, nil
 }
 
 func lockingScriptForChangeOutput(pubKey *primitives.PublicKey) (*script.Script, bsv.CustomInstructions, error) {
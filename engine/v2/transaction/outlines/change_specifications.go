@@ -0,0 +1,130 @@
+package outlines
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// ChangeStrategy is a strategy of distributing the change among the change outputs.
+type ChangeStrategy string
+
+// Enum values for ChangeStrategy
+const (
+	// ChangeStrategyEven divides the change evenly among the change outputs.
+	ChangeStrategyEven ChangeStrategy = "even"
+	// ChangeStrategyRandom divides the change among the change outputs in random proportions.
+	ChangeStrategyRandom ChangeStrategy = "random"
+)
+
+// MaxChangeOutputs is the maximal number of change outputs the change can be split into.
+const MaxChangeOutputs = 100
+
+const (
+	// randomChangeMinWeight and randomChangeMaxWeight are the bounds of the weight of a single output in ChangeStrategyRandom.
+	randomChangeMinWeight = 75
+	randomChangeMaxWeight = 125
+)
+
+// ChangeSpec are representing a client specification for change part of the transaction.
+// Without any options provided, the change is returned to the user in a single output.
+type ChangeSpec struct {
+	// Disabled suppresses the change outputs, so the whole change is left to the miners as a fee.
+	Disabled bool
+	// Outputs is the number of outputs the change is split into, zero means a single output.
+	Outputs uint64
+	// Strategy of distributing the change among the outputs, defaults to ChangeStrategyEven.
+	Strategy ChangeStrategy
+	// MinimumSatoshis is the dust threshold for the change outputs.
+	// The change is split into fewer outputs when a single output would get less,
+	// and the change lower than this threshold is folded into the fee.
+	MinimumSatoshis bsv.Satoshis
+}
+
+func (s *ChangeSpec) validate() error {
+	if s.Outputs > MaxChangeOutputs {
+		return txerrors.ErrTxOutlineChangeTooManyOutputs
+	}
+
+	switch s.Strategy {
+	case "", ChangeStrategyEven, ChangeStrategyRandom:
+		return nil
+	default:
+		return txerrors.ErrTxOutlineChangeUnsupportedStrategy
+	}
+}
+
+// selection sets the inputs selection so the fee covers the change outputs.
+func (s *ChangeSpec) selection(selection InputsSelection) InputsSelection {
+	selection.WithoutChange = s.Disabled
+	selection.ChangeOutputs = s.outputs()
+	return selection
+}
+
+func (s *ChangeSpec) outputs() uint64 {
+	if s.Outputs == 0 {
+		return 1
+	}
+	return s.Outputs
+}
+
+// split the change into the values of change outputs, the result is empty when there should be no change output.
+func (s *ChangeSpec) split(change bsv.Satoshis) ([]bsv.Satoshis, error) {
+	minimum := max(s.MinimumSatoshis, 1)
+	if s.Disabled || change < minimum {
+		return nil, nil
+	}
+
+	count := min(s.outputs(), uint64(change/minimum))
+
+	if s.Strategy == ChangeStrategyRandom {
+		return splitRandomly(change, count, minimum)
+	}
+	return splitEvenly(change, count), nil
+}
+
+func splitEvenly(change bsv.Satoshis, count uint64) []bsv.Satoshis {
+	values := make([]bsv.Satoshis, count)
+	perOutput := change / bsv.Satoshis(count)
+	for i := range values {
+		values[i] = perOutput
+	}
+
+	// handle remainder
+	values[count-1] += change - perOutput*bsv.Satoshis(count)
+	return values
+}
+
+// splitRandomly gives every output the minimum and distributes the rest in random proportions.
+func splitRandomly(change bsv.Satoshis, count uint64, minimum bsv.Satoshis) ([]bsv.Satoshis, error) {
+	weights := make([]uint64, count)
+	var weightsSum uint64
+	for i := range weights {
+		weight, err := rand.Int(rand.Reader, big.NewInt(randomChangeMaxWeight-randomChangeMinWeight+1))
+		if err != nil {
+			return nil, spverrors.Wrapf(err, "failed to randomize change distribution")
+		}
+		weights[i] = weight.Uint64() + randomChangeMinWeight
+		weightsSum += weights[i]
+	}
+
+	toDistribute := change - minimum*bsv.Satoshis(count)
+
+	values := make([]bsv.Satoshis, count)
+	var distributed bsv.Satoshis
+	for i, weight := range weights {
+		share := bsv.Satoshis(new(big.Int).Div(
+			new(big.Int).Mul(new(big.Int).SetUint64(uint64(toDistribute)), new(big.Int).SetUint64(weight)),
+			new(big.Int).SetUint64(weightsSum),
+		).Uint64())
+		values[i] = minimum + share
+		distributed += share
+	}
+
+	// handle remainder
+	values[count-1] += toDistribute - distributed
+	return values, nil
+}
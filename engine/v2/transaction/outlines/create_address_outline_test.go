@@ -0,0 +1,134 @@
+package outlines_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAddressTransactionOutline(t *testing.T) {
+	const satoshis = bsv.Satoshis(1000)
+
+	t.Run("pay to external address without annotation", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		recipient := fixtures.RecipientExternal
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Address{
+				To:       recipient.Address().AddressString,
+				Satoshis: satoshis,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.Output(0).
+			HasNoAnnotation().
+			HasSatoshis(satoshis).
+			HasLockingScript(recipient.P2PKHLockingScript().String())
+	})
+
+	t.Run("pay to user's own address with annotation", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Address{
+				To:       fixtures.Sender.Address().AddressString,
+				Satoshis: satoshis,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.Output(0).
+			HasBucket(bucket.BSV).
+			HasSatoshis(satoshis).
+			HasLockingScript(fixtures.Sender.P2PKHLockingScript().String())
+	})
+}
+
+func TestCreateAddressTransactionOutlineErrors(t *testing.T) {
+	testnetAddress, err := script.NewAddressFromPublicKey(fixtures.RecipientExternal.PublicKey(), false)
+	require.NoError(t, err)
+
+	errorTests := map[string]struct {
+		spec          *outlines.Address
+		expectedError models.SPVError
+	}{
+		"return error for no address": {
+			spec: &outlines.Address{
+				Satoshis: 1000,
+			},
+			expectedError: txerrors.ErrTxOutlineAddressInvalid,
+		},
+		"return error for invalid address": {
+			spec: &outlines.Address{
+				To:       "invalid address",
+				Satoshis: 1000,
+			},
+			expectedError: txerrors.ErrTxOutlineAddressInvalid,
+		},
+		"return error for testnet address": {
+			spec: &outlines.Address{
+				To:       testnetAddress.AddressString,
+				Satoshis: 1000,
+			},
+			expectedError: txerrors.ErrTxOutlineAddressUnsupportedNetwork,
+		},
+		"return error for value below dust limit": {
+			spec: &outlines.Address{
+				To: fixtures.RecipientExternal.Address().AddressString,
+			},
+			expectedError: txerrors.ErrOutputValueTooLow,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(test.spec),
+			}
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
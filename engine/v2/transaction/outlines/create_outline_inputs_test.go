@@ -0,0 +1,130 @@
+package outlines_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+var (
+	pinnedOutpoint = bsv.Outpoint{TxID: "b1e8b3b5e3a4c1a2f3e4d5c6b7a8998877665544332211ffeeddccbbaa009988", Vout: 1}
+	otherOutpoint  = bsv.Outpoint{TxID: "c2f9c4c6f4b5d2b3a4f5e6d7c8b9aa998877665544332211ffeeddccbbaa0099", Vout: 0}
+)
+
+func TestCreateTransactionOutlineWithInputs(t *testing.T) {
+	t.Run("spend only the provided outpoints", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := given.MinimumValidTransactionSpec()
+		spec.Inputs = outlines.InputsSpec{
+			Outpoints: []bsv.Outpoint{pinnedOutpoint, otherOutpoint},
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasInputs(2)
+
+		thenTx.Input(0).
+			HasOutpoint(pinnedOutpoint).
+			HasCustomInstructions(testabilities.UserFundsTransactionCustomInstructions)
+
+		thenTx.Input(1).
+			HasOutpoint(otherOutpoint).
+			HasCustomInstructions(testabilities.UserFundsTransactionCustomInstructions)
+	})
+
+	t.Run("spend the provided outpoint and select the remaining inputs", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		given.UTXOSelector().WillReturnUTXOs(0, 1)
+
+		// and:
+		spec := given.MinimumValidTransactionSpec()
+		spec.Inputs = outlines.InputsSpec{
+			Outpoints:       []bsv.Outpoint{pinnedOutpoint},
+			SelectRemaining: true,
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasInputs(2)
+
+		thenTx.Input(0).
+			HasOutpoint(pinnedOutpoint).
+			HasCustomInstructions(testabilities.UserFundsTransactionCustomInstructions)
+
+		thenTx.Input(1).
+			HasOutpoint(testabilities.UserFundsTransactionOutpoint).
+			HasCustomInstructions(testabilities.UserFundsTransactionCustomInstructions)
+	})
+}
+
+func TestCreateTransactionOutlineWithInputsErrors(t *testing.T) {
+	errorTests := map[string]struct {
+		inputs        outlines.InputsSpec
+		expectedError models.SPVError
+	}{
+		"return error for duplicated outpoint": {
+			inputs: outlines.InputsSpec{
+				Outpoints: []bsv.Outpoint{pinnedOutpoint, pinnedOutpoint},
+			},
+			expectedError: txerrors.ErrTxOutlineInputDuplicated,
+		},
+		"return error for outpoint both included and excluded": {
+			inputs: outlines.InputsSpec{
+				Outpoints: []bsv.Outpoint{pinnedOutpoint},
+				Exclude:   []bsv.Outpoint{otherOutpoint, pinnedOutpoint},
+			},
+			expectedError: txerrors.ErrTxOutlineInputIncludedAndExcluded,
+		},
+		"return error for unsupported selection strategy": {
+			inputs: outlines.InputsSpec{
+				Strategy: "first_come_first_served",
+			},
+			expectedError: txerrors.ErrTxOutlineInputsUnsupportedStrategy,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: given.MinimumValidTransactionSpec().Outputs,
+				Inputs:  test.inputs,
+			}
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
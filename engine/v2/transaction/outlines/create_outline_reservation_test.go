@@ -0,0 +1,83 @@
+package outlines_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTransactionOutlineWithReservation(t *testing.T) {
+	t.Run("reserve selected inputs for the transaction outline", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := given.MinimumValidTransactionSpec()
+
+		// when:
+		tx, err := service.CreateBEEF(context.Background(), spec)
+
+		// then:
+		then.Created(tx).WithNoError(err).WithParseableBEEFHex()
+
+		then.UTXOSelector().SelectedWithReservation(tx.ReservationID)
+		then.UTXOSelector().ReleasedNothing()
+	})
+
+	t.Run("release reserved inputs when the transaction outline cannot be created", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		given.UTXOSelector().WillReturnNoUTXOs()
+
+		// and:
+		spec := given.MinimumValidTransactionSpec()
+
+		// when:
+		tx, err := service.CreateBEEF(context.Background(), spec)
+
+		// then:
+		then.Created(tx).WithError(err).ThatIs(txerrors.ErrTxOutlineInsufficientFunds)
+
+		then.UTXOSelector().ReleasedSelectedReservation()
+	})
+}
+
+func TestCancelTransactionOutlineReservation(t *testing.T) {
+	t.Run("release reserved inputs", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// when:
+		err := service.CancelReservation(context.Background(), fixtures.Sender.ID(), "some-reservation-id")
+
+		// then:
+		require.NoError(t, err)
+		then.UTXOSelector().Released("some-reservation-id")
+	})
+
+	t.Run("return error when reservation ID is empty", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		service := given.NewTransactionOutlinesService()
+
+		// when:
+		err := service.CancelReservation(context.Background(), fixtures.Sender.ID(), "")
+
+		// then:
+		require.ErrorIs(t, err, txerrors.ErrTxOutlineReservationIDRequired)
+		then.UTXOSelector().ReleasedNothing()
+	})
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"testing"
 
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 )
@@ -57,3 +60,182 @@ func TestOutlineNoChange(t *testing.T) {
 
 	thenTx.HasOutputs(1)
 }
+
+func TestOutlineWithChangeSplitEvenly(t *testing.T) {
+	given, then := testabilities.New(t)
+
+	// given:
+	service := given.NewTransactionOutlinesService()
+
+	// and:
+	change := bsv.Satoshis(10)
+	utxoValue := bsv.Satoshis(11)
+
+	// and:
+	given.UTXOSelector().WillReturnUTXOs(change, utxoValue)
+
+	// and:
+	spec := given.MinimumValidTransactionSpec()
+	spec.Change = outlines.ChangeSpec{
+		Outputs: 3,
+	}
+
+	// when:
+	tx, err := service.CreateBEEF(context.Background(), spec)
+
+	// then:
+	thenTx := then.Created(tx).WithNoError(err).WithParseableBEEFHex()
+
+	thenTx.HasOutputs(4)
+
+	thenTx.Output(1).
+		HasBucket(bucket.BSV).
+		HasSatoshis(3).
+		UnlockableBySender()
+
+	thenTx.Output(2).
+		HasBucket(bucket.BSV).
+		HasSatoshis(3).
+		UnlockableBySender()
+
+	thenTx.Output(3).
+		HasBucket(bucket.BSV).
+		HasSatoshis(4).
+		UnlockableBySender()
+}
+
+func TestOutlineWithChangeSplitRandomly(t *testing.T) {
+	given, then := testabilities.New(t)
+
+	// given:
+	service := given.NewTransactionOutlinesService()
+
+	// and:
+	change := bsv.Satoshis(1000)
+	utxoValue := bsv.Satoshis(1001)
+
+	// and:
+	given.UTXOSelector().WillReturnUTXOs(change, utxoValue)
+
+	// and:
+	spec := given.MinimumValidTransactionSpec()
+	spec.Change = outlines.ChangeSpec{
+		Outputs:         4,
+		Strategy:        outlines.ChangeStrategyRandom,
+		MinimumSatoshis: 100,
+	}
+
+	// when:
+	tx, err := service.CreateRawTx(context.Background(), spec)
+
+	// then:
+	thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+	thenTx.HasOutputs(5)
+
+	thenTx.HasChangeOutputsSummingTo(1, change, 100)
+}
+
+func TestOutlineWithChangeOptions(t *testing.T) {
+	tests := map[string]struct {
+		change                bsv.Satoshis
+		spec                  outlines.ChangeSpec
+		expectedChangeOutputs []bsv.Satoshis
+	}{
+		"fold change lower than minimum into the fee": {
+			change: 9,
+			spec: outlines.ChangeSpec{
+				MinimumSatoshis: 10,
+			},
+		},
+		"keep change equal to minimum": {
+			change: 10,
+			spec: outlines.ChangeSpec{
+				MinimumSatoshis: 10,
+			},
+			expectedChangeOutputs: []bsv.Satoshis{10},
+		},
+		"split into fewer outputs when the parts would be lower than minimum": {
+			change: 25,
+			spec: outlines.ChangeSpec{
+				Outputs:         5,
+				MinimumSatoshis: 10,
+			},
+			expectedChangeOutputs: []bsv.Satoshis{12, 13},
+		},
+		"suppress change": {
+			change: 100,
+			spec: outlines.ChangeSpec{
+				Disabled: true,
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			given.UTXOSelector().WillReturnUTXOs(test.change, test.change+1)
+
+			// and:
+			spec := given.MinimumValidTransactionSpec()
+			spec.Change = test.spec
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), spec)
+
+			// then:
+			thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+			thenTx.HasOutputs(1 + len(test.expectedChangeOutputs))
+
+			for i, value := range test.expectedChangeOutputs {
+				thenTx.Output(uint32(i + 1)).
+					HasBucket(bucket.BSV).
+					HasSatoshis(value).
+					UnlockableBySender()
+			}
+		})
+	}
+}
+
+func TestOutlineWithChangeErrors(t *testing.T) {
+	errorTests := map[string]struct {
+		spec          outlines.ChangeSpec
+		expectedError models.SPVError
+	}{
+		"return error for too many change outputs": {
+			spec: outlines.ChangeSpec{
+				Outputs: outlines.MaxChangeOutputs + 1,
+			},
+			expectedError: txerrors.ErrTxOutlineChangeTooManyOutputs,
+		},
+		"return error for unsupported strategy": {
+			spec: outlines.ChangeSpec{
+				Strategy: "nominations",
+			},
+			expectedError: txerrors.ErrTxOutlineChangeUnsupportedStrategy,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := given.MinimumValidTransactionSpec()
+			spec.Change = test.spec
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package outlines_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+func TestCreateScriptTransactionOutline(t *testing.T) {
+	successTests := map[string]struct {
+		spec             *outlines.Script
+		expectAnnotation bool
+	}{
+		"pay to custom locking script": {
+			spec: &outlines.Script{
+				// OP_1 OP_ADD OP_2 OP_EQUAL
+				Script:   "518b5287",
+				Satoshis: 1000,
+			},
+		},
+		"pay to external P2PKH locking script": {
+			spec: &outlines.Script{
+				Script:   fixtures.RecipientExternal.P2PKHLockingScript().String(),
+				Satoshis: 1000,
+			},
+		},
+		"data locking script without value": {
+			spec: &outlines.Script{
+				Script: "006a0c4578616d706c652064617461",
+			},
+		},
+		"pay to user's own P2PKH locking script": {
+			spec: &outlines.Script{
+				Script:   fixtures.Sender.P2PKHLockingScript().String(),
+				Satoshis: 1000,
+			},
+			expectAnnotation: true,
+		},
+	}
+	for name, test := range successTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(test.spec),
+			}
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), spec)
+
+			// then:
+			thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+			thenOutput := thenTx.Output(0).
+				HasSatoshis(test.spec.Satoshis).
+				HasLockingScript(test.spec.Script)
+
+			if test.expectAnnotation {
+				thenOutput.HasBucket(bucket.BSV)
+			} else {
+				thenOutput.HasNoAnnotation()
+			}
+		})
+	}
+}
+
+func TestCreateScriptTransactionOutlineErrors(t *testing.T) {
+	errorTests := map[string]struct {
+		spec          *outlines.Script
+		expectedError models.SPVError
+	}{
+		"return error for no script": {
+			spec: &outlines.Script{
+				Satoshis: 1000,
+			},
+			expectedError: txerrors.ErrTxOutlineScriptRequired,
+		},
+		"return error for invalid hex": {
+			spec: &outlines.Script{
+				Script:   "invalid hex",
+				Satoshis: 1000,
+			},
+			expectedError: txerrors.ErrFailedToDecodeHex,
+		},
+		"return error for too large script": {
+			spec: &outlines.Script{
+				Script:   strings.Repeat("51", outlines.MaxLockingScriptSize+1),
+				Satoshis: 1000,
+			},
+			expectedError: txerrors.ErrTxOutlineScriptTooLarge,
+		},
+		"return error for value below dust limit": {
+			spec: &outlines.Script{
+				Script:   fixtures.RecipientExternal.P2PKHLockingScript().String(),
+				Satoshis: bsv.Satoshis(0),
+			},
+			expectedError: txerrors.ErrOutputValueTooLow,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// and:
+			spec := &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(test.spec),
+			}
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package outlines_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/optional"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+func TestCreateSweepTransactionOutline(t *testing.T) {
+	var recipient = fixtures.RecipientExternal.DefaultPaymail().Address()
+	var sender = fixtures.Sender.DefaultPaymail().Address()
+
+	t.Run("sweep all funds to paymail", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		given.ExternalRecipientHost().WillRespondWithP2PCapabilities()
+
+		// and:
+		sweepValue := bsv.Satoshis(29)
+		given.UTXOSelector().WillReturnUTXOs(sweepValue, 10, 20)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{
+				To:   recipient,
+				From: optional.Of(sender),
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		paymailHostResponse := then.ExternalPaymailHost().ReceivedP2PDestinationRequest(sweepValue)
+
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasInputs(2)
+
+		thenTx.HasOutputs(1)
+
+		thenTx.Output(0).
+			HasBucket(bucket.BSV).
+			HasSatoshis(sweepValue).
+			HasLockingScript(paymailHostResponse.Outputs[0].Script).
+			IsPaymail().
+			HasReceiver(recipient).
+			HasSender(sender).
+			HasReference(paymailHostResponse.Reference)
+	})
+
+	t.Run("sweep all funds to address", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		sweepValue := bsv.Satoshis(29)
+		given.UTXOSelector().WillReturnUTXOs(sweepValue, 10, 20)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{
+				To: fixtures.RecipientExternal.Address().AddressString,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasInputs(2)
+
+		thenTx.Output(0).
+			HasNoAnnotation().
+			HasSatoshis(sweepValue).
+			HasLockingScript(fixtures.RecipientExternal.P2PKHLockingScript().String())
+	})
+
+	t.Run("sweep all funds to user's own address with annotation", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		sweepValue := bsv.Satoshis(29)
+		given.UTXOSelector().WillReturnUTXOs(sweepValue, 10, 20)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{
+				To: fixtures.Sender.Address().AddressString,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.HasOutputs(1)
+
+		thenTx.Output(0).
+			HasBucket(bucket.BSV).
+			HasSatoshis(sweepValue).
+			HasLockingScript(fixtures.Sender.P2PKHLockingScript().String())
+	})
+
+	t.Run("sweep funds left after other outputs", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		sweepValue := bsv.Satoshis(29)
+		given.UTXOSelector().WillReturnUTXOs(sweepValue, 30)
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := given.MinimumValidTransactionSpec()
+		spec.Outputs.Add(&outlines.Sweep{
+			To: fixtures.RecipientExternal.Address().AddressString,
+		})
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		thenTx := then.Created(tx).WithNoError(err).WithParseableRawHex()
+
+		thenTx.Output(0).IsDataOnly()
+
+		thenTx.Output(1).
+			HasNoAnnotation().
+			HasSatoshis(sweepValue).
+			HasLockingScript(fixtures.RecipientExternal.P2PKHLockingScript().String())
+	})
+}
+
+func TestCreateSweepTransactionOutlineErrors(t *testing.T) {
+	var address = fixtures.RecipientExternal.Address().AddressString
+
+	errorTests := map[string]struct {
+		spec          *outlines.TransactionSpec
+		expectedError models.SPVError
+	}{
+		"return error for invalid destination": {
+			spec: &outlines.TransactionSpec{
+				UserID: fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{
+					To: "invalid address",
+				}),
+			},
+			expectedError: txerrors.ErrTxOutlineSweepInvalidDestination,
+		},
+		"return error for empty destination": {
+			spec: &outlines.TransactionSpec{
+				UserID:  fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{}),
+			},
+			expectedError: txerrors.ErrTxOutlineSweepInvalidDestination,
+		},
+		"return error for minimal satoshis greater than maximal": {
+			spec: &outlines.TransactionSpec{
+				UserID: fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{
+					To:          address,
+					MinSatoshis: 100,
+					MaxSatoshis: 10,
+				}),
+			},
+			expectedError: txerrors.ErrTxOutlineSweepInvalidSatoshisRange,
+		},
+		"return error for multiple sweep outputs": {
+			spec: &outlines.TransactionSpec{
+				UserID: fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(
+					&outlines.Sweep{To: address},
+					&outlines.Sweep{To: address},
+				),
+			},
+			expectedError: txerrors.ErrTxOutlineSweepMultipleOutputs,
+		},
+		"return error for sweep with input outpoints": {
+			spec: &outlines.TransactionSpec{
+				UserID: fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{
+					To: address,
+				}),
+				Inputs: outlines.InputsSpec{
+					Outpoints: []bsv.Outpoint{testabilities.UserFundsTransactionOutpoint},
+				},
+			},
+			expectedError: txerrors.ErrTxOutlineSweepWithInputOutpoints,
+		},
+		"return error for sweep with change specification": {
+			spec: &outlines.TransactionSpec{
+				UserID: fixtures.Sender.ID(),
+				Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{
+					To: address,
+				}),
+				Change: outlines.ChangeSpec{
+					Outputs: 2,
+				},
+			},
+			expectedError: txerrors.ErrTxOutlineSweepWithChange,
+		},
+	}
+	for name, test := range errorTests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+
+			// given:
+			service := given.NewTransactionOutlinesService()
+
+			// when:
+			tx, err := service.CreateRawTx(context.Background(), test.spec)
+
+			// then:
+			then.Created(tx).WithError(err).ThatIs(test.expectedError)
+		})
+	}
+
+	t.Run("return error when user has no funds to sweep", func(t *testing.T) {
+		given, then := testabilities.New(t)
+
+		// given:
+		given.UserHasNotEnoughFunds()
+
+		// and:
+		service := given.NewTransactionOutlinesService()
+
+		// and:
+		spec := &outlines.TransactionSpec{
+			UserID: fixtures.Sender.ID(),
+			Outputs: outlines.NewOutputsSpecs(&outlines.Sweep{
+				To: address,
+			}),
+		}
+
+		// when:
+		tx, err := service.CreateRawTx(context.Background(), spec)
+
+		// then:
+		then.Created(tx).WithError(err).ThatIs(txerrors.ErrTxOutlineInsufficientFunds)
+	})
+}
@@ -13,18 +13,24 @@ import (
 type evaluationContext struct {
 	context.Context
 	userID                string
+	reservationID         string
 	log                   *zerolog.Logger
 	paymail               paymail.ServiceClient
 	paymailAddressService PaymailAddressService
 	utxoSelector          UTXOSelector
 	feeUnit               bsvmodel.FeeUnit
 	usersService          UsersService
+	addressesService      AddressesService
 }
 
 func (c *evaluationContext) UserID() string {
 	return c.userID
 }
 
+func (c *evaluationContext) ReservationID() string {
+	return c.reservationID
+}
+
 func (c *evaluationContext) UserPubKey() (*primitives.PublicKey, error) {
 	pubKey, err := c.usersService.GetPubKey(c, c.userID)
 	if err != nil {
@@ -48,3 +54,7 @@ func (c *evaluationContext) PaymailAddressService() PaymailAddressService {
 func (c *evaluationContext) UTXOSelector() UTXOSelector {
 	return c.utxoSelector
 }
+
+func (c *evaluationContext) AddressesService() AddressesService {
+	return c.addressesService
+}
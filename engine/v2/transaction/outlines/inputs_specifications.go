@@ -1,6 +1,8 @@
 package outlines
 
 import (
+	"errors"
+
 	"github.com/bitcoin-sv/go-sdk/chainhash"
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
@@ -10,17 +12,55 @@ import (
 )
 
 // InputsSpec are representing a client specification for inputs part of the transaction.
+// Without any outpoints provided, the inputs are selected automatically from the user's UTXOs.
 type InputsSpec struct {
+	// Outpoints are the user's UTXOs which must be spent by the transaction.
+	Outpoints []bsv.Outpoint
+	// SelectRemaining enables selecting more user's UTXOs when the provided Outpoints don't cover the outputs and the fee.
+	SelectRemaining bool
+	// Exclude are the user's UTXOs which must not be selected automatically.
+	Exclude []bsv.Outpoint
+	// Strategy of selecting the user's UTXOs automatically, empty means the strategy configured for the wallet.
+	Strategy UTXOSelectionStrategy
+}
+
+func (s *InputsSpec) evaluate(ctx *evaluationContext, outputs annotatedOutputs, change *ChangeSpec) (annotatedInputs, bsv.Satoshis, error) {
+	selection, err := s.selection()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.selectInputs(ctx, outputs, change.selection(selection))
+}
+
+// evaluateSweep selects all user's UTXOs matching the sweep, the returned value is what's left for the sweep output.
+func (s *InputsSpec) evaluateSweep(ctx *evaluationContext, outputs annotatedOutputs, sweep *Sweep) (annotatedInputs, bsv.Satoshis, error) {
+	if len(s.Outpoints) > 0 {
+		return nil, 0, txerrors.ErrTxOutlineSweepWithInputOutpoints
+	}
+
+	selection := InputsSelection{
+		Excluded:    s.Exclude,
+		All:         true,
+		MinSatoshis: sweep.MinSatoshis,
+		MaxSatoshis: sweep.MaxSatoshis,
+	}
+
+	return s.selectInputs(ctx, outputs, selection)
 }
 
-func (s *InputsSpec) evaluate(ctx *evaluationContext, outputs annotatedOutputs) (annotatedInputs, bsv.Satoshis, error) {
+func (s *InputsSpec) selectInputs(ctx *evaluationContext, outputs annotatedOutputs, selection InputsSelection) (annotatedInputs, bsv.Satoshis, error) {
 	outs := outputs.toTransactionOutputs()
 
 	tx := sdk.NewTransaction()
 	tx.Outputs = outs
 
-	utxos, change, err := ctx.UTXOSelector().Select(ctx, tx, ctx.UserID())
-	if err != nil {
+	selection.ReservationID = ctx.ReservationID()
+
+	utxos, change, err := ctx.UTXOSelector().Select(ctx, tx, ctx.UserID(), selection)
+	if errors.Is(err, txerrors.ErrTxOutlineInputUnavailable) || errors.Is(err, txerrors.ErrTxOutlineInputReserved) {
+		return nil, 0, err
+	} else if err != nil {
 		return nil, 0, spverrors.ErrInternal.Wrap(err)
 	}
 
@@ -49,6 +89,33 @@ func (s *InputsSpec) evaluate(ctx *evaluationContext, outputs annotatedOutputs)
 	return inputs, change, nil
 }
 
+func (s *InputsSpec) selection() (InputsSelection, error) {
+	if s.Strategy != "" && !s.Strategy.IsValid() {
+		return InputsSelection{}, txerrors.ErrTxOutlineInputsUnsupportedStrategy
+	}
+
+	included := make(map[bsv.Outpoint]struct{}, len(s.Outpoints))
+	for _, outpoint := range s.Outpoints {
+		if _, ok := included[outpoint]; ok {
+			return InputsSelection{}, txerrors.ErrTxOutlineInputDuplicated
+		}
+		included[outpoint] = struct{}{}
+	}
+
+	for _, outpoint := range s.Exclude {
+		if _, ok := included[outpoint]; ok {
+			return InputsSelection{}, txerrors.ErrTxOutlineInputIncludedAndExcluded
+		}
+	}
+
+	return InputsSelection{
+		Pinned:     s.Outpoints,
+		Excluded:   s.Exclude,
+		PinnedOnly: len(s.Outpoints) > 0 && !s.SelectRemaining,
+		Strategy:   s.Strategy,
+	}, nil
+}
+
 type annotatedInputs []*annotatedInput
 
 type annotatedInput struct {
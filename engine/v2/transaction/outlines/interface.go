@@ -2,9 +2,11 @@ package outlines
 
 import (
 	"context"
+	"iter"
 
 	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses/addressesmodels"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/bsv"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	bsvmodel "github.com/bitcoin-sv/spv-wallet/models/bsv"
@@ -18,13 +20,41 @@ type PaymailAddressService interface {
 
 // UTXOSelector is a component that provides methods for selecting UTXOs of given user to fund a transaction.
 type UTXOSelector interface {
-	Select(ctx context.Context, tx *sdk.Transaction, userID string) (utxos []*UTXO, change bsvmodel.Satoshis, err error)
+	Select(ctx context.Context, tx *sdk.Transaction, userID string, selection InputsSelection) (utxos []*UTXO, change bsvmodel.Satoshis, err error)
+	Release(ctx context.Context, userID string, reservationID string) error
+}
+
+// InputsSelection narrows down the UTXOs which can be used by UTXOSelector to fund a transaction.
+type InputsSelection struct {
+	// Pinned are the outpoints which must be spent by the transaction, they're returned before the selected UTXOs.
+	Pinned []bsvmodel.Outpoint
+	// Excluded are the outpoints which cannot be selected to fund the transaction.
+	Excluded []bsvmodel.Outpoint
+	// PinnedOnly disables selecting UTXOs other than the pinned ones.
+	PinnedOnly bool
+	// ChangeOutputs is the number of change outputs the fee is estimated for, zero means a single change output.
+	ChangeOutputs uint64
+	// WithoutChange marks that the transaction won't get any change output, so the fee isn't estimated for it.
+	WithoutChange bool
+	// All selects all the user's UTXOs from BSV bucket (except the excluded ones) instead of only those needed to fund the transaction.
+	// In such case the returned change is the value left after covering the outputs and the fee, without adding a change output.
+	All bool
+	// MinSatoshis limits selecting All to the UTXOs with at least this value, zero means no limit.
+	MinSatoshis bsvmodel.Satoshis
+	// MaxSatoshis limits selecting All to the UTXOs with at most this value, zero means no limit.
+	MaxSatoshis bsvmodel.Satoshis
+	// Strategy of selecting the UTXOs other than the pinned ones, empty means the default strategy of UTXOSelector.
+	Strategy UTXOSelectionStrategy
+	// ReservationID identifies the reservation of the selected UTXOs, so they're not selected for other transactions
+	// until the reservation is released or expires. Empty means that the selected UTXOs are not reserved.
+	ReservationID string
 }
 
 // Service is a service for creating transaction outlines.
 type Service interface {
 	CreateBEEF(ctx context.Context, spec *TransactionSpec) (*Transaction, error)
 	CreateRawTx(ctx context.Context, spec *TransactionSpec) (*Transaction, error)
+	CancelReservation(ctx context.Context, userID string, reservationID string) error
 }
 
 // UsersService is a service for working with users.
@@ -32,6 +62,11 @@ type UsersService interface {
 	GetPubKey(ctx context.Context, userID string) (*primitives.PublicKey, error)
 }
 
+// AddressesService is a service for working with users' addresses.
+type AddressesService interface {
+	FindByStringAddresses(ctx context.Context, addresses iter.Seq[string]) ([]addressesmodels.Address, error)
+}
+
 // TransactionBEEFService provides functionality to generate a BEEF-encoded
 // hex string from a given Bitcoin transaction.
 type TransactionBEEFService interface {
@@ -49,4 +84,6 @@ type UTXO struct {
 type Transaction struct {
 	Hex         bsv.TxHex
 	Annotations transaction.Annotations
+	// ReservationID identifies the reservation of the UTXOs spent by the transaction outline.
+	ReservationID string
 }
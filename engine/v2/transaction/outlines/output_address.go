@@ -0,0 +1,87 @@
+package outlines
+
+import (
+	"slices"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+)
+
+// dustLimit is the minimal value of the output which is not a data output.
+const dustLimit = bsv.Satoshis(1)
+
+// Address represents an output paying to P2PKH address.
+type Address struct {
+	To       string
+	Satoshis bsv.Satoshis
+}
+
+func (a *Address) evaluate(ctx *evaluationContext) (annotatedOutputs, error) {
+	if a.Satoshis < dustLimit {
+		return nil, txerrors.ErrOutputValueTooLow
+	}
+
+	address, err := script.NewAddressFromString(a.To)
+	if err != nil {
+		return nil, txerrors.ErrTxOutlineAddressInvalid.Wrap(err)
+	}
+
+	if !isMainnetAddress(address) {
+		return nil, txerrors.ErrTxOutlineAddressUnsupportedNetwork
+	}
+
+	lockingScript, err := p2pkh.Lock(address)
+	if err != nil {
+		return nil, txerrors.ErrTxOutlineAddressInvalid.Wrap(err)
+	}
+
+	output := &sdk.TransactionOutput{
+		Satoshis:      uint64(a.Satoshis),
+		LockingScript: lockingScript,
+	}
+
+	annotation, err := userOutputAnnotation(ctx, lockingScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleAnnotatedOutput(output, annotation), nil
+}
+
+func isMainnetAddress(address *script.Address) bool {
+	mainnetAddress, err := script.NewAddressFromPublicKeyHash(address.PublicKeyHash, true)
+	return err == nil && mainnetAddress.AddressString == address.AddressString
+}
+
+// userOutputAnnotation returns the annotation for the P2PKH output paying to the user's own address,
+// the outputs which don't belong to the user are not annotated.
+func userOutputAnnotation(ctx *evaluationContext, lockingScript *script.Script) (*transaction.OutputAnnotation, error) {
+	if !lockingScript.IsP2PKH() {
+		return nil, nil
+	}
+
+	address, err := lockingScript.Address()
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get address from locking script")
+	}
+
+	userAddresses, err := ctx.AddressesService().FindByStringAddresses(ctx, slices.Values([]string{address.AddressString}))
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to check if address %s belongs to user %s", address.AddressString, ctx.UserID())
+	}
+
+	for _, userAddress := range userAddresses {
+		if userAddress.UserID == ctx.UserID() {
+			return &transaction.OutputAnnotation{
+				Bucket: bucket.BSV,
+			}, nil
+		}
+	}
+	return nil, nil
+}
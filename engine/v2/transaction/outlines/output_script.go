@@ -0,0 +1,50 @@
+package outlines
+
+import (
+	"github.com/bitcoin-sv/go-sdk/script"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+)
+
+// MaxLockingScriptSize is the maximal size (in bytes) of the locking script of the script output.
+const MaxLockingScriptSize = 100_000
+
+// Script represents an output with arbitrary locking script.
+type Script struct {
+	// Script is the hex of the locking script.
+	Script   string
+	Satoshis bsv.Satoshis
+}
+
+func (s *Script) evaluate(ctx *evaluationContext) (annotatedOutputs, error) {
+	if s.Script == "" {
+		return nil, txerrors.ErrTxOutlineScriptRequired
+	}
+
+	lockingScript, err := script.NewFromHex(s.Script)
+	if err != nil {
+		return nil, txerrors.ErrFailedToDecodeHex.Wrap(err)
+	}
+
+	if len(*lockingScript) > MaxLockingScriptSize {
+		return nil, txerrors.ErrTxOutlineScriptTooLarge
+	}
+
+	// data outputs are unspendable, so they don't need any value
+	if !lockingScript.IsData() && s.Satoshis < dustLimit {
+		return nil, txerrors.ErrOutputValueTooLow
+	}
+
+	output := &sdk.TransactionOutput{
+		Satoshis:      uint64(s.Satoshis),
+		LockingScript: lockingScript,
+	}
+
+	annotation, err := userOutputAnnotation(ctx, lockingScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleAnnotatedOutput(output, annotation), nil
+}
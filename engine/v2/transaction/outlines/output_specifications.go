@@ -60,6 +60,20 @@ func (s *OutputsSpec) evaluate(ctx *evaluationContext) (annotatedOutputs, error)
 	return outputs, nil
 }
 
+// sweep returns the sweep output specification if provided, there can be at most one.
+func (s *OutputsSpec) sweep() (*Sweep, error) {
+	var sweep *Sweep
+	for _, spec := range s.Outputs {
+		if sweepSpec, ok := spec.(*Sweep); ok {
+			if sweep != nil {
+				return nil, txerrors.ErrTxOutlineSweepMultipleOutputs
+			}
+			sweep = sweepSpec
+		}
+	}
+	return sweep, nil
+}
+
 type annotatedOutputs []*annotatedOutput
 
 type annotatedOutput struct {
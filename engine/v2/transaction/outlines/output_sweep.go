@@ -0,0 +1,131 @@
+package outlines
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/bitcoin-sv/go-sdk/script"
+	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/go-sdk/transaction/template/p2pkh"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/optional"
+)
+
+// p2pkhLockingScriptSize is the size of P2PKH locking script,
+// used to estimate the fee when the sweep output is sent to paymail and its locking script is not known yet.
+const p2pkhLockingScriptSize = 25
+
+// Sweep represents an output transferring all user's BSV funds, left after the other outputs and the fee, to paymail or address.
+// The sweep output is placed after all the other outputs and the transaction doesn't get a change output,
+// so the sweep cannot be combined with the change specification.
+type Sweep struct {
+	// To is a paymail or an address of the receiver.
+	To string
+	// From is the sender paymail, used only when sweeping to paymail.
+	From optional.Param[string]
+	// MinSatoshis skips the user's UTXOs with lower value, zero means no limit.
+	MinSatoshis bsv.Satoshis
+	// MaxSatoshis skips the user's UTXOs with higher value, zero means no limit.
+	MaxSatoshis bsv.Satoshis
+}
+
+// evaluate only validates the sweep specification, the sweep output is added when the inputs are selected.
+func (s *Sweep) evaluate(*evaluationContext) (annotatedOutputs, error) {
+	if s.To == "" {
+		return nil, txerrors.ErrTxOutlineSweepInvalidDestination
+	}
+
+	if s.MaxSatoshis > 0 && s.MinSatoshis > s.MaxSatoshis {
+		return nil, txerrors.ErrTxOutlineSweepInvalidSatoshisRange
+	}
+
+	return nil, nil
+}
+
+func (s *Sweep) fund(ctx *evaluationContext, inputsSpec *InputsSpec, outputs annotatedOutputs) (annotatedInputs, annotatedOutputs, error) {
+	placeholder, err := s.placeholderOutput(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inputs, value, err := inputsSpec.evaluateSweep(ctx, append(slices.Clone(outputs), placeholder), s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if value == 0 {
+		return nil, nil, txerrors.ErrTxOutlineInsufficientFunds
+	}
+
+	sweepOutputs, err := s.resolveOutputs(ctx, placeholder, value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inputs, append(outputs, sweepOutputs...), nil
+}
+
+func (s *Sweep) isPaymail() bool {
+	return strings.Contains(s.To, "@")
+}
+
+// placeholderOutput is the sweep output without value, used to calculate the fee.
+func (s *Sweep) placeholderOutput(ctx *evaluationContext) (*annotatedOutput, error) {
+	if s.isPaymail() {
+		_, err := ctx.Paymail().GetSanitizedPaymail(s.To)
+		if err != nil {
+			return nil, txerrors.ErrReceiverPaymailAddressIsInvalid.Wrap(err)
+		}
+
+		lockingScript := script.Script(make([]byte, p2pkhLockingScriptSize))
+		return &annotatedOutput{
+			TransactionOutput: &sdk.TransactionOutput{LockingScript: &lockingScript},
+		}, nil
+	}
+
+	address, err := script.NewAddressFromString(s.To)
+	if err != nil {
+		return nil, txerrors.ErrTxOutlineSweepInvalidDestination.Wrap(err)
+	}
+
+	lockingScript, err := p2pkh.Lock(address)
+	if err != nil {
+		return nil, txerrors.ErrTxOutlineSweepInvalidDestination.Wrap(err)
+	}
+
+	annotation, err := userOutputAnnotation(ctx, lockingScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return &annotatedOutput{
+		TransactionOutput: &sdk.TransactionOutput{LockingScript: lockingScript},
+		OutputAnnotation:  annotation,
+	}, nil
+}
+
+func (s *Sweep) resolveOutputs(ctx *evaluationContext, placeholder *annotatedOutput, value bsv.Satoshis) (annotatedOutputs, error) {
+	if !s.isPaymail() {
+		placeholder.Satoshis = uint64(value)
+		return annotatedOutputs{placeholder}, nil
+	}
+
+	paymailSpec := &Paymail{
+		To:       s.To,
+		Satoshis: value,
+		From:     s.From,
+	}
+
+	outputs, err := paymailSpec.evaluate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the fee was calculated for a single P2PKH output, so recipient's destination cannot be bigger
+	if len(outputs) != 1 || len(*outputs[0].LockingScript) > len(*placeholder.LockingScript) {
+		return nil, txerrors.ErrTxOutlineSweepUnsupportedRecipientDestination
+	}
+
+	return outputs, nil
+}
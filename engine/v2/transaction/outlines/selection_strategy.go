@@ -0,0 +1,33 @@
+package outlines
+
+// UTXOSelectionStrategy is a strategy of selecting user's UTXOs to fund a transaction.
+type UTXOSelectionStrategy string
+
+// Enum values for UTXOSelectionStrategy
+const (
+	// UTXOSelectionStrategyOldestFirst selects the least recently touched UTXOs first.
+	UTXOSelectionStrategyOldestFirst UTXOSelectionStrategy = "oldest_first"
+	// UTXOSelectionStrategyLargestFirst selects the UTXOs with the highest value first, so the transaction has as few inputs as possible.
+	UTXOSelectionStrategyLargestFirst UTXOSelectionStrategy = "largest_first"
+	// UTXOSelectionStrategySmallestFirst selects the UTXOs with the lowest value first, which consolidates the dust.
+	UTXOSelectionStrategySmallestFirst UTXOSelectionStrategy = "smallest_first"
+	// UTXOSelectionStrategyBranchAndBound searches for the UTXOs matching the outputs and the fee so exactly that no change output is needed.
+	// When there is no such match, the UTXOs are selected the same way as with UTXOSelectionStrategyOldestFirst.
+	UTXOSelectionStrategyBranchAndBound UTXOSelectionStrategy = "branch_and_bound"
+	// UTXOSelectionStrategyRandom selects the UTXOs in random order, so the selection doesn't reveal anything about the user's wallet.
+	UTXOSelectionStrategyRandom UTXOSelectionStrategy = "random"
+)
+
+// IsValid checks if the strategy is one of the supported ones.
+func (s UTXOSelectionStrategy) IsValid() bool {
+	switch s {
+	case UTXOSelectionStrategyOldestFirst,
+		UTXOSelectionStrategyLargestFirst,
+		UTXOSelectionStrategySmallestFirst,
+		UTXOSelectionStrategyBranchAndBound,
+		UTXOSelectionStrategyRandom:
+		return true
+	default:
+		return false
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 type OutputAssertion interface {
 	HasBucket(bucket bucket.Name) OutputAssertion
+	HasNoAnnotation() OutputAssertion
 	HasSatoshis(satoshis bsv.Satoshis) OutputAssertion
 	HasLockingScript(lockingScript string) OutputAssertion
 	IsDataOnly() OutputAssertion
@@ -46,6 +47,12 @@ func (a *txOutputAssertion) HasBucket(bucket bucket.Name) OutputAssertion {
 	return a
 }
 
+func (a *txOutputAssertion) HasNoAnnotation() OutputAssertion {
+	a.t.Helper()
+	a.assert.Nil(a.annotation, "Output %d has unexpected annotation", a.index)
+	return a
+}
+
 func (a *txOutputAssertion) HasSatoshis(satoshis bsv.Satoshis) OutputAssertion {
 	a.t.Helper()
 	a.assert.EqualValues(satoshis, a.txout.Satoshis, "Output %d has invalid satoshis value", a.index)
@@ -8,6 +8,8 @@ import (
 	testpaymail "github.com/bitcoin-sv/spv-wallet/engine/paymail/testabilities"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures/txtestability"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,6 +18,7 @@ import (
 type TransactionOutlineAssertion interface {
 	Created(transaction *outlines.Transaction) CreatedTransactionOutlineAssertion
 	ExternalPaymailHost() testpaymail.PaymailExternalAssertions
+	UTXOSelector() UTXOSelectorAssertions
 }
 
 type CreatedTransactionOutlineAssertion interface {
@@ -41,6 +44,9 @@ type WithParseableBEEFTransactionOutlineAssertion interface {
 	Input(index int) InputAssertion
 	HasOutputs(count int) WithParseableBEEFTransactionOutlineAssertion
 	Output(index uint32) OutputAssertion
+	// HasChangeOutputsSummingTo asserts that outputs starting from given index are change outputs
+	// of at least minimum value each, summing up to the total change.
+	HasChangeOutputsSummingTo(from uint32, total bsv.Satoshis, minimum bsv.Satoshis) WithParseableBEEFTransactionOutlineAssertion
 }
 
 func Then(t testing.TB, fixture TransactionOutlineFixture) TransactionOutlineAssertion {
@@ -51,6 +57,7 @@ func Then(t testing.TB, fixture TransactionOutlineFixture) TransactionOutlineAss
 		assert:            assert.New(t),
 		paymailAssertions: testpaymail.Then(t, fixture.ExternalRecipientHost().MockedPaymailClient()),
 		txFixture:         txtestability.Given(t),
+		utxoSelector:      fixture.UTXOSelector().(UTXOSelectorAssertions),
 	}
 }
 
@@ -63,6 +70,7 @@ type assertion struct {
 	err               error
 	paymailAssertions testpaymail.PaymailExternalAssertions
 	txFixture         txtestability.TransactionsFixtures
+	utxoSelector      UTXOSelectorAssertions
 }
 
 func (a *assertion) Created(transaction *outlines.Transaction) CreatedTransactionOutlineAssertion {
@@ -166,6 +174,22 @@ func (a *assertion) Output(index uint32) OutputAssertion {
 	}
 }
 
+func (a *assertion) HasChangeOutputsSummingTo(from uint32, total bsv.Satoshis, minimum bsv.Satoshis) WithParseableBEEFTransactionOutlineAssertion {
+	a.t.Helper()
+	var sum bsv.Satoshis
+	for index := from; index < uint32(len(a.tx.Outputs)); index++ {
+		a.Output(index).HasBucket(bucket.BSV).UnlockableBySender()
+		a.assert.GreaterOrEqual(a.tx.Outputs[index].Satoshis, uint64(minimum), "Change output %d value is lower than minimum", index)
+		sum += bsv.Satoshis(a.tx.Outputs[index].Satoshis)
+	}
+	a.assert.Equal(total, sum, "Change outputs don't sum up to the change")
+	return a
+}
+
 func (a *assertion) ExternalPaymailHost() testpaymail.PaymailExternalAssertions {
 	return a.paymailAssertions
 }
+
+func (a *assertion) UTXOSelector() UTXOSelectorAssertions {
+	return a.utxoSelector
+}
@@ -25,6 +25,7 @@ type transactionOutlineAbility struct {
 	t                      testing.TB
 	paymailClientAbility   tpaymail.PaymailClientFixture
 	paymailAddressService  outlines.PaymailAddressService
+	addressesService       outlines.AddressesService
 	transactionBEEFService outlines.TransactionBEEFService
 	utxoSelector           mockedUTXOSelector
 	feeUnit                bsv.FeeUnit
@@ -45,8 +46,10 @@ func Given(t testing.TB) (given TransactionOutlineFixture) {
 		t:                      t,
 		paymailClientAbility:   tpaymail.Given(t),
 		paymailAddressService:  newPaymailAddressServiceMock(t),
+		addressesService:       newAddressesServiceMock(t),
 		feeUnit:                bsv.FeeUnit{Satoshis: 1, Bytes: 1000},
 		transactionBEEFService: newTransactionBEEFServiceMock(t),
+		utxoSelector:           mockedUTXOSelector{t: t},
 	}
 	return ability
 }
@@ -66,6 +69,7 @@ func (a *transactionOutlineAbility) NewTransactionOutlinesService() outlines.Ser
 		a.feeUnit,
 		tester.Logger(a.t),
 		pubKeyGetter{},
+		a.addressesService,
 	)
 }
 
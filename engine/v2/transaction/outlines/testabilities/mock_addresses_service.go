@@ -0,0 +1,37 @@
+package testabilities
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/addresses/addressesmodels"
+)
+
+type mockAddressesService struct {
+	t     testing.TB
+	users []fixtures.User
+}
+
+func newAddressesServiceMock(t testing.TB) *mockAddressesService {
+	return &mockAddressesService{
+		t:     t,
+		users: fixtures.InternalUsers(),
+	}
+}
+
+func (m *mockAddressesService) FindByStringAddresses(_ context.Context, addresses iter.Seq[string]) ([]addressesmodels.Address, error) {
+	var found []addressesmodels.Address
+	for address := range addresses {
+		for _, user := range m.users {
+			if user.Address().AddressString == address {
+				found = append(found, addressesmodels.Address{
+					Address: address,
+					UserID:  user.ID(),
+				})
+			}
+		}
+	}
+	return found, nil
+}
@@ -3,12 +3,14 @@ package testabilities
 import (
 	"context"
 	"fmt"
+	"testing"
 
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
 )
 
 type UTXOSelectorFixture interface {
@@ -17,6 +19,13 @@ type UTXOSelectorFixture interface {
 	WillReturnUTXOs(change bsv.Satoshis, utxos ...bsv.Satoshis)
 }
 
+type UTXOSelectorAssertions interface {
+	SelectedWithReservation(reservationID string)
+	ReleasedSelectedReservation()
+	Released(reservationID string)
+	ReleasedNothing()
+}
+
 func templatedOutpoint(index uint) bsv.Outpoint {
 	return bsv.Outpoint{
 		TxID: fmt.Sprintf("a%010de1b81dd2c9c0c6cd67f9bdf832e9c2bb12a1d57f30cb6ebbe78d9", index),
@@ -32,13 +41,19 @@ var UserFundsTransactionCustomInstructions = bsv.CustomInstructions{
 }
 
 type mockedUTXOSelector struct {
+	t              testing.TB
 	returnNothing  bool
 	returnError    bool
 	utxosToReturn  []bsv.Satoshis
 	changeToReturn bsv.Satoshis
+
+	selectedReservations []string
+	releasedReservations []string
 }
 
-func (m *mockedUTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, userID string) ([]*outlines.UTXO, bsv.Satoshis, error) {
+func (m *mockedUTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, userID string, selection outlines.InputsSelection) ([]*outlines.UTXO, bsv.Satoshis, error) {
+	m.selectedReservations = append(m.selectedReservations, selection.ReservationID)
+
 	if m.returnError {
 		return nil, 0, spverrors.Newf("mocked: failed to select utxos for transaction")
 	}
@@ -47,6 +62,18 @@ func (m *mockedUTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, us
 		return nil, 0, nil
 	}
 
+	pinned := lo.Map(selection.Pinned, func(outpoint bsv.Outpoint, _ int) *outlines.UTXO {
+		return &outlines.UTXO{
+			TxID:               outpoint.TxID,
+			Vout:               outpoint.Vout,
+			CustomInstructions: UserFundsTransactionCustomInstructions,
+		}
+	})
+
+	if selection.PinnedOnly {
+		return pinned, m.changeToReturn, nil
+	}
+
 	var distribution []bsv.Satoshis
 	if m.utxosToReturn != nil {
 		distribution = m.utxosToReturn
@@ -58,14 +85,21 @@ func (m *mockedUTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, us
 		}
 	}
 
-	return lo.Map(distribution, func(satoshis bsv.Satoshis, index int) *outlines.UTXO {
+	selected := lo.Map(distribution, func(satoshis bsv.Satoshis, index int) *outlines.UTXO {
 		outpoint := templatedOutpoint(uint(index))
 		return &outlines.UTXO{
 			TxID:               outpoint.TxID,
 			Vout:               outpoint.Vout,
 			CustomInstructions: UserFundsTransactionCustomInstructions,
 		}
-	}), m.changeToReturn, nil
+	})
+
+	return append(pinned, selected...), m.changeToReturn, nil
+}
+
+func (m *mockedUTXOSelector) Release(ctx context.Context, userID string, reservationID string) error {
+	m.releasedReservations = append(m.releasedReservations, reservationID)
+	return nil
 }
 
 func (m *mockedUTXOSelector) WillReturnNoUTXOs() {
@@ -80,3 +114,25 @@ func (m *mockedUTXOSelector) WillReturnUTXOs(change bsv.Satoshis, utxos ...bsv.S
 	m.utxosToReturn = utxos
 	m.changeToReturn = change
 }
+
+func (m *mockedUTXOSelector) SelectedWithReservation(reservationID string) {
+	m.t.Helper()
+	require.NotEmpty(m.t, reservationID, "expected reservation ID")
+	require.Contains(m.t, m.selectedReservations, reservationID)
+}
+
+func (m *mockedUTXOSelector) ReleasedSelectedReservation() {
+	m.t.Helper()
+	require.NotEmpty(m.t, m.selectedReservations, "expected selection with reservation")
+	m.Released(m.selectedReservations[len(m.selectedReservations)-1])
+}
+
+func (m *mockedUTXOSelector) Released(reservationID string) {
+	m.t.Helper()
+	require.Contains(m.t, m.releasedReservations, reservationID)
+}
+
+func (m *mockedUTXOSelector) ReleasedNothing() {
+	m.t.Helper()
+	require.Empty(m.t, m.releasedReservations)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction"
 	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	bsvmodel "github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 )
 
@@ -21,6 +22,7 @@ type service struct {
 	utxoSelector           UTXOSelector
 	feeUnit                bsvmodel.FeeUnit
 	usersService           UsersService
+	addressesService       AddressesService
 }
 
 // NewService creates a new transaction outlines service.
@@ -32,6 +34,7 @@ func NewService(
 	feeUnit bsvmodel.FeeUnit,
 	logger zerolog.Logger,
 	usersService UsersService,
+	addressesService AddressesService,
 ) Service {
 	if paymailService == nil {
 		panic("paymail.ServiceClient is required to create transaction outlines service")
@@ -49,6 +52,10 @@ func NewService(
 		panic("UTXO selector is required to create transaction outlines service")
 	}
 
+	if addressesService == nil {
+		panic("Addresses service is required to create transaction outlines service")
+	}
+
 	return &service{
 		logger:                 &logger,
 		paymailService:         paymailService,
@@ -57,66 +64,94 @@ func NewService(
 		utxoSelector:           utxoSelector,
 		feeUnit:                feeUnit,
 		usersService:           usersService,
+		addressesService:       addressesService,
 	}
 }
 
 func (s *service) CreateRawTx(ctx context.Context, spec *TransactionSpec) (*Transaction, error) {
-	tx, annotations, err := s.evaluateSpec(ctx, spec)
+	tx, annotations, reservationID, err := s.evaluateSpec(ctx, spec)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Transaction{
-		Hex:         bsv.TxHex(tx.Hex()),
-		Annotations: annotations,
+		Hex:           bsv.TxHex(tx.Hex()),
+		Annotations:   annotations,
+		ReservationID: reservationID,
 	}, nil
 }
 
 // CreateBEEF creates a new transaction outline based on specification.
 func (s *service) CreateBEEF(ctx context.Context, spec *TransactionSpec) (*Transaction, error) {
-	tx, annotations, err := s.evaluateSpec(ctx, spec)
+	tx, annotations, reservationID, err := s.evaluateSpec(ctx, spec)
 	if err != nil {
 		return nil, err
 	}
 
 	beef, err := s.transactionBEEFService.PrepareBEEF(ctx, tx)
 	if err != nil {
+		s.releaseAfterFailure(ctx, spec.UserID, reservationID)
 		return nil, spverrors.Wrapf(err, "failed to make BEEF format for transaction outline")
 	}
 
 	return &Transaction{
-		Hex:         bsv.TxHex(beef),
-		Annotations: annotations,
+		Hex:           bsv.TxHex(beef),
+		Annotations:   annotations,
+		ReservationID: reservationID,
 	}, nil
 }
 
-func (s *service) evaluateSpec(ctx context.Context, spec *TransactionSpec) (*sdk.Transaction, transaction.Annotations, error) {
+// CancelReservation releases the UTXOs reserved for the transaction outline, so they can be selected for other transactions.
+func (s *service) CancelReservation(ctx context.Context, userID string, reservationID string) error {
+	if reservationID == "" {
+		return txerrors.ErrTxOutlineReservationIDRequired
+	}
+
+	if err := s.utxoSelector.Release(ctx, userID, reservationID); err != nil {
+		return spverrors.ErrInternal.Wrap(err)
+	}
+	return nil
+}
+
+func (s *service) evaluateSpec(ctx context.Context, spec *TransactionSpec) (*sdk.Transaction, transaction.Annotations, string, error) {
 	if spec == nil {
-		return nil, transaction.Annotations{}, txerrors.ErrTxOutlineSpecificationRequired
+		return nil, transaction.Annotations{}, "", txerrors.ErrTxOutlineSpecificationRequired
 	}
 
 	if spec.UserID == "" {
-		return nil, transaction.Annotations{}, txerrors.ErrTxOutlineSpecificationUserIDRequired
+		return nil, transaction.Annotations{}, "", txerrors.ErrTxOutlineSpecificationUserIDRequired
 	}
 
-	evaluationCtx := s.createEvaluationContext(ctx, spec.UserID)
+	evaluationCtx := s.createEvaluationContext(ctx, spec.UserID, uuid.NewString())
 
 	tx, annotations, err := spec.evaluate(evaluationCtx)
 	if err != nil {
-		return nil, transaction.Annotations{}, err
+		// the UTXOs could be already reserved when evaluating the rest of the specification failed
+		s.releaseAfterFailure(ctx, spec.UserID, evaluationCtx.ReservationID())
+		return nil, transaction.Annotations{}, "", err
+	}
+	return tx, annotations, evaluationCtx.ReservationID(), err
+}
+
+// releaseAfterFailure releases the reservation of the outline which cannot be returned to the user.
+// It's not critical when it fails, because the reservation expires anyway.
+func (s *service) releaseAfterFailure(ctx context.Context, userID string, reservationID string) {
+	if err := s.utxoSelector.Release(ctx, userID, reservationID); err != nil {
+		s.logger.Warn().Err(err).Str("reservationID", reservationID).Msg("Failed to release utxos reserved for transaction outline")
 	}
-	return tx, annotations, err
 }
 
-func (s *service) createEvaluationContext(ctx context.Context, userID string) *evaluationContext {
+func (s *service) createEvaluationContext(ctx context.Context, userID string, reservationID string) *evaluationContext {
 	return &evaluationContext{
 		Context:               ctx,
 		userID:                userID,
+		reservationID:         reservationID,
 		log:                   s.logger,
 		paymail:               s.paymailService,
 		paymailAddressService: s.paymailAddressService,
 		utxoSelector:          s.utxoSelector,
 		feeUnit:               s.feeUnit,
 		usersService:          s.usersService,
+		addressesService:      s.addressesService,
 	}
 }
@@ -12,6 +12,7 @@ type TransactionSpec struct {
 	Outputs OutputsSpec
 	UserID  string
 	Inputs  InputsSpec
+	Change  ChangeSpec
 }
 
 func (t *TransactionSpec) evaluate(ctx *evaluationContext) (*sdk.Transaction, transaction.Annotations, error) {
@@ -20,16 +21,22 @@ func (t *TransactionSpec) evaluate(ctx *evaluationContext) (*sdk.Transaction, tr
 		return nil, transaction.Annotations{}, spverrors.Wrapf(err, "failed to evaluate outputs")
 	}
 
-	inputs, change, err := t.Inputs.evaluate(ctx, outputs)
+	sweep, err := t.Outputs.sweep()
 	if err != nil {
 		return nil, transaction.Annotations{}, err
 	}
 
-	if change > 0 {
-		outputs, err = addChangeOutput(ctx, outputs, change)
-		if err != nil {
-			return nil, transaction.Annotations{}, txerrors.ErrOutlineAddChangeOutput.Wrap(err)
+	var inputs annotatedInputs
+	if sweep != nil {
+		if t.Change != (ChangeSpec{}) {
+			return nil, transaction.Annotations{}, txerrors.ErrTxOutlineSweepWithChange
 		}
+		inputs, outputs, err = sweep.fund(ctx, &t.Inputs, outputs)
+	} else {
+		inputs, outputs, err = t.fund(ctx, outputs)
+	}
+	if err != nil {
+		return nil, transaction.Annotations{}, err
 	}
 
 	txOuts, outputsAnnotations := outputs.splitIntoTransactionOutputsAndAnnotations()
@@ -46,3 +53,29 @@ func (t *TransactionSpec) evaluate(ctx *evaluationContext) (*sdk.Transaction, tr
 
 	return tx, annotations, nil
 }
+
+func (t *TransactionSpec) fund(ctx *evaluationContext, outputs annotatedOutputs) (annotatedInputs, annotatedOutputs, error) {
+	err := t.Change.validate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inputs, change, err := t.Inputs.evaluate(ctx, outputs, &t.Change)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changeValues, err := t.Change.split(change)
+	if err != nil {
+		return nil, nil, txerrors.ErrOutlineAddChangeOutput.Wrap(err)
+	}
+
+	if len(changeValues) > 0 {
+		outputs, err = addChangeOutputs(ctx, outputs, changeValues)
+		if err != nil {
+			return nil, nil, txerrors.ErrOutlineAddChangeOutput.Wrap(err)
+		}
+	}
+
+	return inputs, outputs, nil
+}
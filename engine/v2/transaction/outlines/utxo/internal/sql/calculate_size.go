@@ -32,6 +32,17 @@ func outputsSize(outputs []*sdk.TransactionOutput) uint64 {
 	return size
 }
 
+// changeOutputsSize is the size increase when adding change outputs to the transaction with given number of outputs.
+func changeOutputsSize(outputsCount int, changeOutputs uint64) uint64 {
+	if changeOutputs == 0 {
+		return 0
+	}
+	size := changeOutputs * estimatedChangeOutputSize
+	//nolint:gosec // No need to check for overflows from uint64 to int here
+	size += varIntSize(outputsCount+int(changeOutputs)) - varIntSize(outputsCount)
+	return size
+}
+
 //nolint:gosec // No need to check for overflows from int to uint64 here
 func varIntSize(val int) uint64 {
 	length := sdk.VarInt(val).Length()
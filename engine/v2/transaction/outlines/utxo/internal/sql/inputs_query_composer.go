@@ -3,9 +3,11 @@ package sql
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
@@ -17,11 +19,38 @@ type selectedUTXO struct {
 	Change             uint64
 }
 
+// pinnedInputs are the UTXOs which must be spent by the transaction, so the query only selects the remaining ones.
+type pinnedInputs struct {
+	utxos []*selectedUTXO
+	value bsv.Satoshis
+	size  uint64
+}
+
+func (p *pinnedInputs) outpoints() []bsv.Outpoint {
+	outpoints := make([]bsv.Outpoint, len(p.utxos))
+	for i, utxo := range p.utxos {
+		outpoints[i] = bsv.Outpoint{TxID: utxo.TxID, Vout: utxo.Vout}
+	}
+	return outpoints
+}
+
+func (p *pinnedInputs) withChange(change uint64) []*selectedUTXO {
+	for _, utxo := range p.utxos {
+		utxo.Change = change
+	}
+	return p.utxos
+}
+
 type inputsQueryComposer struct {
 	userID              string
 	outputsTotalValue   bsv.Satoshis
+	pinnedInputsValue   bsv.Satoshis
 	txWithoutInputsSize uint64
+	changeOutputsSize   uint64
 	feeUnit             bsv.FeeUnit
+	excluded            []bsv.Outpoint
+	order               string
+	now                 time.Time
 }
 
 func (c *inputsQueryComposer) build(db *gorm.DB) *gorm.DB {
@@ -40,7 +69,7 @@ func (c *inputsQueryComposer) build(db *gorm.DB) *gorm.DB {
 }
 
 func (c *inputsQueryComposer) utxos(db *gorm.DB) *gorm.DB {
-	return db.Model(&database.UserUTXO{}).
+	query := db.Model(&database.UserUTXO{}).
 		Select(
 			txIdColumn,
 			voutColumn,
@@ -48,7 +77,15 @@ func (c *inputsQueryComposer) utxos(db *gorm.DB) *gorm.DB {
 			c.feeCalculatedWithoutChangeOutput(),
 			c.feeCalculatedWithChangeOutput(),
 		).
-		Where("user_id = @userId", sql.Named("userId", c.userID))
+		Where("user_id = @userId", sql.Named("userId", c.userID)).
+		Where("bucket = @bucket", sql.Named("bucket", bucket.BSV)).
+		Where("reserved_until is null or reserved_until < @now", sql.Named("now", c.now))
+
+	if len(c.excluded) > 0 {
+		query = query.Where("(tx_id, vout) not in (?)", outpointsToValues(c.excluded))
+	}
+
+	return query
 }
 
 func (c *inputsQueryComposer) addChangeValueCalculation(db *gorm.DB, utxoTab *gorm.DB) *gorm.DB {
@@ -74,13 +111,24 @@ func (c *inputsQueryComposer) searchForMinimalChangeValue(db *gorm.DB, utxoWithC
 }
 
 func (c *inputsQueryComposer) feeCalculatedWithChangeOutput() string {
-	return fmt.Sprintf("ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + %d + %d) / cast(%d as float)) * %d as fee_with_change_output", c.txWithoutInputsSize, estimatedChangeOutputSize, c.feeUnit.Bytes, c.feeUnit.Satoshis)
+	return fmt.Sprintf("ceil((sum(estimated_input_size) over (order by %s) + %d + %d) / cast(%d as float)) * %d as fee_with_change_output", c.order, c.txWithoutInputsSize, c.changeOutputsSize, c.feeUnit.Bytes, c.feeUnit.Satoshis)
 }
 
 func (c *inputsQueryComposer) feeCalculatedWithoutChangeOutput() string {
-	return fmt.Sprintf("ceil((sum(estimated_input_size) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) + %d) / cast(%d as float)) * %d as fee_no_change_output", c.txWithoutInputsSize, c.feeUnit.Bytes, c.feeUnit.Satoshis)
+	return fmt.Sprintf("ceil((sum(estimated_input_size) over (order by %s) + %d) / cast(%d as float)) * %d as fee_no_change_output", c.order, c.txWithoutInputsSize, c.feeUnit.Bytes, c.feeUnit.Satoshis)
 }
 
 func (c *inputsQueryComposer) remainingValue() string {
-	return fmt.Sprintf("sum(satoshis) over (order by touched_at ASC, created_at ASC, tx_id ASC, vout ASC) - %d as remaining_value", c.outputsTotalValue)
+	if c.pinnedInputsValue > 0 {
+		return fmt.Sprintf("sum(satoshis) over (order by %s) + %d - %d as remaining_value", c.order, c.pinnedInputsValue, c.outputsTotalValue)
+	}
+	return fmt.Sprintf("sum(satoshis) over (order by %s) - %d as remaining_value", c.order, c.outputsTotalValue)
+}
+
+func outpointsToValues(outpoints []bsv.Outpoint) [][]any {
+	values := make([][]any, 0, len(outpoints))
+	for _, outpoint := range outpoints {
+		values = append(values, []any{outpoint.TxID, outpoint.Vout})
+	}
+	return values
 }
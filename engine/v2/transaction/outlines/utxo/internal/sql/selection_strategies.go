@@ -0,0 +1,146 @@
+package sql
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
+	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+	"gorm.io/gorm"
+)
+
+// maxExactMatchTries limits the number of combinations checked by the branch and bound search.
+const maxExactMatchTries = 100_000
+
+// maxSelectionCandidates limits the number of UTXOs loaded by the strategies which select the inputs in memory.
+const maxSelectionCandidates = 1000
+
+// selectRandomly adds the randomly sampled user's UTXOs until they cover the outputs and the fee.
+func (r *UTXOSelector) selectRandomly(db *gorm.DB, params *fundingParams) ([]*selectedUTXO, error) {
+	var candidates []*database.UserUTXO
+	err := r.buildQueryForCandidates(db, params).
+		Order("random()").
+		Limit(maxSelectionCandidates).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to select candidate utxos for transaction")
+	}
+
+	pinned := params.pinned
+	inputsValue := pinned.value
+	txSize := params.txWithoutInputsSize + pinned.size
+	for i, candidate := range candidates {
+		inputsValue += bsv.Satoshis(candidate.Satoshis)
+		txSize += candidate.EstimatedInputSize
+
+		change, funded := r.changeFor(inputsValue, params.outputsTotalValue, txSize, params.changeOutputsSize)
+		if funded {
+			return append(pinned.withChange(change), toSelectedUTXOs(candidates[:i+1], change)...), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// selectExactMatch searches (with branch and bound algorithm) for the user's UTXOs which cover the outputs and the fee
+// leaving less than it would cost to add a change output and spend it later.
+// Such a leftover is left to the miners, so the returned change is always zero.
+// Only the largest candidates are searched, so the exact match may be missed for the user with a lot of UTXOs.
+func (r *UTXOSelector) selectExactMatch(db *gorm.DB, params *fundingParams) ([]*selectedUTXO, error) {
+	var candidates []*database.UserUTXO
+	err := r.buildQueryForCandidates(db, params).
+		// UTXOs which don't cover the fee for spending them only increase the fee
+		Where("satoshis > ceil(estimated_input_size / cast(? as float)) * ?", r.feeUnit.Bytes, r.feeUnit.Satoshis).
+		Order(selectionOrders[outlines.UTXOSelectionStrategyLargestFirst]).
+		Limit(maxSelectionCandidates).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to select candidate utxos for transaction")
+	}
+
+	pinned := params.pinned
+	search := &exactMatchSearch{
+		selector:          r,
+		candidates:        candidates,
+		available:         make([]bsv.Satoshis, len(candidates)+1),
+		outputsTotalValue: params.outputsTotalValue,
+		costOfChange:      r.fee(estimatedChangeOutputSize + database.EstimatedInputSizeForP2PKH),
+	}
+	for i := len(candidates) - 1; i >= 0; i-- {
+		search.available[i] = search.available[i+1] + bsv.Satoshis(candidates[i].Satoshis)
+	}
+
+	if !search.run(0, pinned.value, params.txWithoutInputsSize+pinned.size) {
+		return nil, nil
+	}
+
+	return append(pinned.withChange(0), toSelectedUTXOs(search.selected, 0)...), nil
+}
+
+// exactMatchSearch is a depth-first search over the candidates sorted by value descending,
+// where each candidate is first included and then excluded from the selection.
+type exactMatchSearch struct {
+	selector          *UTXOSelector
+	candidates        []*database.UserUTXO
+	available         []bsv.Satoshis // available[i] is the total value of candidates starting from i
+	outputsTotalValue bsv.Satoshis
+	costOfChange      int64
+	selected          []*database.UserUTXO
+	tries             int
+}
+
+//nolint:gosec // No need to check for overflows from uint64 to int64 here
+func (s *exactMatchSearch) run(index int, inputsValue bsv.Satoshis, txSize uint64) bool {
+	s.tries++
+	if s.tries > maxExactMatchTries {
+		return false
+	}
+
+	excess := int64(inputsValue) - int64(s.outputsTotalValue) - s.selector.fee(txSize)
+	if excess >= 0 {
+		// adding more inputs would only increase the excess
+		return excess <= s.costOfChange && len(s.selected) > 0
+	}
+
+	if index == len(s.candidates) || excess+int64(s.available[index]) < 0 {
+		// remaining candidates cannot cover the outputs and the fee
+		return false
+	}
+
+	candidate := s.candidates[index]
+	s.selected = append(s.selected, candidate)
+	if s.run(index+1, inputsValue+bsv.Satoshis(candidate.Satoshis), txSize+candidate.EstimatedInputSize) {
+		return true
+	}
+	s.selected = s.selected[:len(s.selected)-1]
+
+	return s.run(index+1, inputsValue, txSize)
+}
+
+// buildQueryForCandidates returns the query for the user's not reserved UTXOs from BSV bucket which can be selected to fund the transaction.
+func (r *UTXOSelector) buildQueryForCandidates(db *gorm.DB, params *fundingParams) *gorm.DB {
+	query := db.Model(&database.UserUTXO{}).
+		Where("user_id = ?", params.userID).
+		Where("bucket = ?", bucket.BSV).
+		Where(notReserved, params.now)
+
+	excluded := append(params.pinned.outpoints(), params.excluded...)
+	if len(excluded) > 0 {
+		query = query.Where("(tx_id, vout) not in (?)", outpointsToValues(excluded))
+	}
+
+	return query
+}
+
+func toSelectedUTXOs(rows []*database.UserUTXO, change uint64) []*selectedUTXO {
+	utxos := make([]*selectedUTXO, len(rows))
+	for i, row := range rows {
+		utxos[i] = &selectedUTXO{
+			TxID:               row.TxID,
+			Vout:               row.Vout,
+			CustomInstructions: row.CustomInstructions,
+			Change:             change,
+		}
+	}
+	return utxos
+}
@@ -2,14 +2,17 @@ package sql
 
 import (
 	"context"
+	"errors"
+	"math"
 	"time"
 
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
-	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 	"gorm.io/gorm"
 )
 
@@ -23,32 +26,72 @@ const (
 const (
 	// estimatedChangeOutputSize is the estimated size of a change output
 	// that will be added to transaction in case there are a change from transaction.
-	// Currently, for this estimation we're assuming single change output with P2PKH locking script.
+	// Currently, for this estimation we're assuming each change output has P2PKH locking script.
 	estimatedChangeOutputSize = 34
 )
 
+// maxReservationAttempts is the number of times the selection is repeated
+// when some of the selected UTXOs were reserved by a concurrent selection in the meantime.
+const maxReservationAttempts = 3
+
+// notReserved is the condition matching the UTXOs without reservation or with expired one.
+const notReserved = "reserved_until is null or reserved_until < ?"
+
+var errReservationConflict = errors.New("selected utxos were reserved by concurrent selection")
+
+// selectionOrders are the orders in which the inputs query accumulates the UTXOs for given strategy.
+var selectionOrders = map[outlines.UTXOSelectionStrategy]string{
+	outlines.UTXOSelectionStrategyOldestFirst:   "touched_at ASC, created_at ASC, tx_id ASC, vout ASC",
+	outlines.UTXOSelectionStrategyLargestFirst:  "satoshis DESC, tx_id ASC, vout ASC",
+	outlines.UTXOSelectionStrategySmallestFirst: "satoshis ASC, tx_id ASC, vout ASC",
+}
+
 // UTXOSelector is responsible for selecting UTXOs for a transaction in SQL databases.
 type UTXOSelector struct {
-	feeUnit bsv.FeeUnit
-	db      *gorm.DB
+	feeUnit        bsv.FeeUnit
+	db             *gorm.DB
+	strategy       outlines.UTXOSelectionStrategy
+	reservationTTL time.Duration
+	now            func() time.Time
+}
+
+// fundingParams are the parameters of funding a transaction, shared by the selection strategies.
+type fundingParams struct {
+	userID              string
+	now                 time.Time
+	outputsTotalValue   bsv.Satoshis
+	txWithoutInputsSize uint64
+	changeOutputsSize   uint64
+	pinned              *pinnedInputs
+	excluded            []bsv.Outpoint
 }
 
 // NewUTXOSelector creates a new instance of UTXOSelector.
-func NewUTXOSelector(db *gorm.DB, feeUnit bsv.FeeUnit) *UTXOSelector {
+// The strategy is used when the selection doesn't specify one, empty strategy means outlines.UTXOSelectionStrategyOldestFirst.
+// The reservationTTL is the time for which the selected UTXOs are reserved for the transaction outline.
+func NewUTXOSelector(db *gorm.DB, feeUnit bsv.FeeUnit, strategy outlines.UTXOSelectionStrategy, reservationTTL time.Duration) *UTXOSelector {
+	if strategy == "" {
+		strategy = outlines.UTXOSelectionStrategyOldestFirst
+	}
+
 	return &UTXOSelector{
-		db:      db,
-		feeUnit: feeUnit,
+		db:             db,
+		feeUnit:        feeUnit,
+		strategy:       strategy,
+		reservationTTL: reservationTTL,
+		now:            time.Now,
 	}
 }
 
 // Select selects UTXOs of user to fund a transaction.
-func (r *UTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, userID string) (utxos []*outlines.UTXO, change bsv.Satoshis, err error) {
-	// NOTE: this approach assumes that tx doesn't contain any predefined inputs and all should be selected to cover outputs
+// The pinned UTXOs are always spent, the other ones are selected only when the pinned don't cover the outputs and the fee.
+func (r *UTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, userID string, selection outlines.InputsSelection) (utxos []*outlines.UTXO, change bsv.Satoshis, err error) {
 	outputsTotalValue := tx.TotalOutputSatoshis()
 	byteSizeOfTxToFund := outputOnlyTxSize(tx.Outputs)
+	byteSizeOfChangeOutputs := changeOutputsSize(len(tx.Outputs), changeOutputsCount(selection))
 
 	var selected []*selectedUTXO
-	selected, err = r.selectInputsForTransaction(ctx, userID, bsv.Satoshis(outputsTotalValue), byteSizeOfTxToFund)
+	selected, err = r.selectInputsForTransaction(ctx, userID, selection, bsv.Satoshis(outputsTotalValue), byteSizeOfTxToFund, byteSizeOfChangeOutputs)
 	if err != nil {
 		return nil, bsv.Satoshis(0), err
 	}
@@ -66,48 +109,290 @@ func (r *UTXOSelector) Select(ctx context.Context, tx *sdk.Transaction, userID s
 			CustomInstructions: bsv.CustomInstructions(utxo.CustomInstructions),
 		}
 	}
+
 	return
 }
 
-func (r *UTXOSelector) selectInputsForTransaction(ctx context.Context, userID string, outputsTotalValue bsv.Satoshis, byteSizeOfTxWithoutInputs uint64) (utxos []*selectedUTXO, err error) {
+// Release removes the reservation of the user's UTXOs, so they can be selected for other transaction outlines.
+func (r *UTXOSelector) Release(ctx context.Context, userID string, reservationID string) error {
+	err := r.db.WithContext(ctx).Model(&database.UserUTXO{}).
+		Where("user_id = ?", userID).
+		Where("reservation_id = ?", reservationID).
+		Updates(map[string]any{
+			"reservation_id": nil,
+			"reserved_until": nil,
+		}).Error
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to release reserved utxos")
+	}
+	return nil
+}
+
+func (r *UTXOSelector) selectInputsForTransaction(ctx context.Context, userID string, selection outlines.InputsSelection, outputsTotalValue bsv.Satoshis, byteSizeOfTxWithoutInputs uint64, byteSizeOfChangeOutputs uint64) (utxos []*selectedUTXO, err error) {
+	for range maxReservationAttempts {
+		utxos, err = r.reserveInputsForTransaction(ctx, userID, selection, outputsTotalValue, byteSizeOfTxWithoutInputs, byteSizeOfChangeOutputs)
+		if !errors.Is(err, errReservationConflict) {
+			break
+		}
+	}
+
+	if errors.Is(err, txerrors.ErrTxOutlineInputUnavailable) || errors.Is(err, txerrors.ErrTxOutlineInputReserved) {
+		return nil, err
+	} else if err != nil {
+		return nil, txerrors.ErrUnexpectedErrorDuringInputsSelection.Wrap(err)
+	}
+
+	return utxos, nil
+}
+
+// reserveInputsForTransaction selects the inputs and reserves them in one database transaction.
+// It returns errReservationConflict when some of the selected UTXOs were reserved by a concurrent selection.
+func (r *UTXOSelector) reserveInputsForTransaction(ctx context.Context, userID string, selection outlines.InputsSelection, outputsTotalValue bsv.Satoshis, byteSizeOfTxWithoutInputs uint64, byteSizeOfChangeOutputs uint64) (utxos []*selectedUTXO, err error) {
+	now := r.now()
+
 	err = r.db.WithContext(ctx).Transaction(func(db *gorm.DB) error {
-		inputsQuery := r.buildQueryForInputs(db, userID, outputsTotalValue, byteSizeOfTxWithoutInputs)
+		pinned, err := r.getPinnedInputs(db, userID, selection.Pinned, now)
+		if err != nil {
+			return err
+		}
 
-		if err := inputsQuery.Find(&utxos).Error; err != nil {
+		utxos, err = r.fundTransaction(db, selection, &fundingParams{
+			userID:              userID,
+			now:                 now,
+			outputsTotalValue:   outputsTotalValue,
+			txWithoutInputsSize: byteSizeOfTxWithoutInputs,
+			changeOutputsSize:   byteSizeOfChangeOutputs,
+			pinned:              pinned,
+			excluded:            selection.Excluded,
+		})
+		if err != nil {
 			utxos = nil
-			return spverrors.Wrapf(err, "failed to select utxos for transaction")
+			return err
 		}
 
 		if len(utxos) == 0 {
 			return nil
 		}
 
-		updateQuery := r.buildUpdateTouchedAtQuery(db, utxos)
+		updates := map[string]any{"touched_at": now}
+		if selection.ReservationID != "" {
+			updates["reservation_id"] = selection.ReservationID
+			updates["reserved_until"] = now.Add(r.reservationTTL)
+		}
 
-		if err := updateQuery.Update("touched_at", time.Now()).Error; err != nil {
+		updateQuery := r.buildUpdateTouchedAtQuery(db, utxos).Where(notReserved, now).Updates(updates)
+		if updateQuery.Error != nil {
+			utxos = nil
+			return spverrors.Wrapf(updateQuery.Error, "failed to reserve selected inputs")
+		}
+		if updateQuery.RowsAffected != int64(len(utxos)) {
 			utxos = nil
-			return spverrors.Wrapf(err, "failed to update touched_at for selected inputs")
+			return errReservationConflict
 		}
 
 		return nil
 	})
+
+	return utxos, err
+}
+
+// fundTransaction returns the pinned inputs and, when they don't cover the outputs and the fee, the inputs selected with the strategy.
+func (r *UTXOSelector) fundTransaction(db *gorm.DB, selection outlines.InputsSelection, params *fundingParams) ([]*selectedUTXO, error) {
+	if selection.All {
+		return r.selectAll(db, selection, params)
+	}
+
+	pinned := params.pinned
+	if len(pinned.utxos) > 0 {
+		change, funded := r.changeFor(pinned.value, params.outputsTotalValue, params.txWithoutInputsSize+pinned.size, params.changeOutputsSize)
+		if funded {
+			return pinned.withChange(change), nil
+		}
+		if selection.PinnedOnly {
+			return nil, nil
+		}
+	}
+
+	strategy := r.strategyFor(selection)
+	switch strategy {
+	case outlines.UTXOSelectionStrategyRandom:
+		selected, err := r.selectRandomly(db, params)
+		if err != nil || len(selected) > 0 {
+			return selected, err
+		}
+		// the sampled candidates don't cover the outputs and the fee, so fallback to the default way of selecting
+		strategy = outlines.UTXOSelectionStrategyOldestFirst
+	case outlines.UTXOSelectionStrategyBranchAndBound:
+		selected, err := r.selectExactMatch(db, params)
+		if err != nil || len(selected) > 0 {
+			return selected, err
+		}
+		// there is no exact match, so fallback to the default way of selecting
+		strategy = outlines.UTXOSelectionStrategyOldestFirst
+	}
+
+	var selected []*selectedUTXO
+	inputsQuery := r.buildQueryForInputs(db, strategy, params)
+	if err := inputsQuery.Find(&selected).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to select utxos for transaction")
+	}
+
+	if len(selected) == 0 {
+		return nil, nil
+	}
+
+	return append(pinned.withChange(selected[0].Change), selected...), nil
+}
+
+// selectAll returns all user's not reserved UTXOs from BSV bucket matching the selection, with the change being the value left after covering the outputs and the fee.
+func (r *UTXOSelector) selectAll(db *gorm.DB, selection outlines.InputsSelection, params *fundingParams) ([]*selectedUTXO, error) {
+	query := db.Model(&database.UserUTXO{}).
+		Where("user_id = ?", params.userID).
+		Where("bucket = ?", bucket.BSV).
+		Where(notReserved, params.now).
+		Order(selectionOrders[outlines.UTXOSelectionStrategyOldestFirst])
+
+	if len(selection.Excluded) > 0 {
+		query = query.Where("(tx_id, vout) not in (?)", outpointsToValues(selection.Excluded))
+	}
+	if selection.MinSatoshis > 0 {
+		query = query.Where("satoshis >= ?", uint64(selection.MinSatoshis))
+	}
+	if selection.MaxSatoshis > 0 {
+		query = query.Where("satoshis <= ?", uint64(selection.MaxSatoshis))
+	}
+
+	var rows []*database.UserUTXO
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, spverrors.Wrapf(err, "failed to select all utxos for transaction")
+	}
+
+	var inputsValue bsv.Satoshis
+	txSize := params.txWithoutInputsSize
+	for _, row := range rows {
+		inputsValue += bsv.Satoshis(row.Satoshis)
+		txSize += row.EstimatedInputSize
+	}
+
+	//nolint:gosec // No need to check for overflows from uint64 to int64 here
+	remainingValue := int64(inputsValue) - int64(params.outputsTotalValue) - r.fee(txSize)
+	if len(rows) == 0 || remainingValue <= 0 {
+		return nil, nil
+	}
+
+	return toSelectedUTXOs(rows, uint64(remainingValue)), nil
+}
+
+func (r *UTXOSelector) getPinnedInputs(db *gorm.DB, userID string, outpoints []bsv.Outpoint, now time.Time) (*pinnedInputs, error) {
+	pinned := &pinnedInputs{}
+	if len(outpoints) == 0 {
+		return pinned, nil
+	}
+
+	var rows []*database.UserUTXO
+	err := db.Model(&database.UserUTXO{}).
+		Where("user_id = ?", userID).
+		Where("(tx_id, vout) in (?)", outpointsToValues(outpoints)).
+		Find(&rows).Error
 	if err != nil {
-		return nil, txerrors.ErrUnexpectedErrorDuringInputsSelection.Wrap(err)
+		return nil, spverrors.Wrapf(err, "failed to get pinned utxos")
 	}
 
-	return utxos, nil
+	if len(rows) != len(outpoints) {
+		return nil, txerrors.ErrTxOutlineInputUnavailable
+	}
+
+	byOutpoint := make(map[bsv.Outpoint]*database.UserUTXO, len(rows))
+	for _, row := range rows {
+		if row.ReservedUntil != nil && !row.ReservedUntil.Before(now) {
+			return nil, txerrors.ErrTxOutlineInputReserved
+		}
+		byOutpoint[bsv.Outpoint{TxID: row.TxID, Vout: row.Vout}] = row
+	}
+
+	// keep the order of the inputs as provided by the user
+	for _, outpoint := range outpoints {
+		row := byOutpoint[outpoint]
+		pinned.utxos = append(pinned.utxos, &selectedUTXO{
+			TxID:               row.TxID,
+			Vout:               row.Vout,
+			CustomInstructions: row.CustomInstructions,
+		})
+		pinned.value += bsv.Satoshis(row.Satoshis)
+		pinned.size += row.EstimatedInputSize
+	}
+
+	return pinned, nil
+}
+
+// changeFor calculates the change of transaction funded with inputs of given value the same way as inputs query does.
+//
+//nolint:gosec // No need to check for overflows from uint64 to int64 here
+func (r *UTXOSelector) changeFor(inputsValue, outputsTotalValue bsv.Satoshis, txSize uint64, changeOutputsSize uint64) (change uint64, funded bool) {
+	remainingValue := int64(inputsValue) - int64(outputsTotalValue)
+
+	changeWithoutChangeOutput := remainingValue - r.fee(txSize)
+	if changeWithoutChangeOutput <= 0 {
+		return 0, changeWithoutChangeOutput == 0
+	}
+
+	changeValue := remainingValue - r.fee(txSize+changeOutputsSize)
+	if changeValue < 0 {
+		return 0, false
+	}
+
+	return uint64(changeValue), true
 }
 
-func (r *UTXOSelector) buildQueryForInputs(db *gorm.DB, userID string, outputsTotalValue bsv.Satoshis, txWithoutInputsSize uint64) *gorm.DB {
+//nolint:gosec // No need to check for overflows from uint64 to int64 here
+func (r *UTXOSelector) fee(txSize uint64) int64 {
+	return int64(math.Ceil(float64(txSize)/float64(r.feeUnit.Bytes))) * int64(r.feeUnit.Satoshis)
+}
+
+func (r *UTXOSelector) buildQueryForInputs(db *gorm.DB, strategy outlines.UTXOSelectionStrategy, params *fundingParams) *gorm.DB {
+	order, ok := selectionOrders[strategy]
+	if !ok {
+		order = selectionOrders[outlines.UTXOSelectionStrategyOldestFirst]
+	}
+
 	composer := &inputsQueryComposer{
-		userID:              userID,
-		outputsTotalValue:   outputsTotalValue,
-		txWithoutInputsSize: txWithoutInputsSize,
+		userID:              params.userID,
+		outputsTotalValue:   params.outputsTotalValue,
+		txWithoutInputsSize: params.txWithoutInputsSize,
+		changeOutputsSize:   params.changeOutputsSize,
 		feeUnit:             r.feeUnit,
+		excluded:            params.excluded,
+		order:               order,
+		now:                 params.now,
+	}
+
+	if params.pinned != nil {
+		composer.pinnedInputsValue = params.pinned.value
+		composer.txWithoutInputsSize += params.pinned.size
+		composer.excluded = append(params.pinned.outpoints(), params.excluded...)
 	}
+
 	return composer.build(db)
 }
 
+func (r *UTXOSelector) strategyFor(selection outlines.InputsSelection) outlines.UTXOSelectionStrategy {
+	if selection.Strategy != "" {
+		return selection.Strategy
+	}
+	return r.strategy
+}
+
+// changeOutputsCount is the number of change outputs the fee should be estimated for.
+func changeOutputsCount(selection outlines.InputsSelection) uint64 {
+	if selection.WithoutChange {
+		return 0
+	}
+	if selection.ChangeOutputs == 0 {
+		return 1
+	}
+	return selection.ChangeOutputs
+}
+
 func (r *UTXOSelector) buildUpdateTouchedAtQuery(db *gorm.DB, utxos []*selectedUTXO) *gorm.DB {
 	outpoints := make([][]any, 0, len(utxos))
 	for _, utxo := range utxos {
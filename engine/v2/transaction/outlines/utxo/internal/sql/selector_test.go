@@ -3,12 +3,17 @@ package sql_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/testabilities/testmode"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/utxo/internal/sql/testabilities"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,7 +28,7 @@ func TestInputsSelector(t *testing.T) {
 		selector := given.NewInputSelector()
 
 		// when:
-		utxos, change, err := selector.Select(context.Background(), sdk.NewTransaction(), fixtures.Sender.ID())
+		utxos, change, err := selector.Select(context.Background(), sdk.NewTransaction(), fixtures.Sender.ID(), outlines.InputsSelection{})
 
 		// then:
 		thenSuccess := then.WithoutError(err)
@@ -106,7 +111,7 @@ func TestInputsSelector(t *testing.T) {
 			selector := given.NewInputSelector()
 
 			// when:
-			utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID())
+			utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{})
 
 			// then:
 			thenSuccess := then.WithoutError(err)
@@ -161,13 +166,13 @@ func TestInputsSelector(t *testing.T) {
 			selector := given.NewInputSelector()
 
 			// when:
-			_, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID())
+			_, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{})
 
 			// then:
 			require.NoError(t, err)
 
 			// when:
-			utxos, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID())
+			utxos, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{})
 
 			// then:
 			then.WithoutError(err).SelectedInputs(utxos).
@@ -177,6 +182,635 @@ func TestInputsSelector(t *testing.T) {
 	}
 }
 
+func TestInputsSelectorWithChangeOutputs(t *testing.T) {
+	tests := map[string]struct {
+		selectBy             selectBy
+		changeOutputs        uint64
+		withoutChange        bool
+		expectToSelectInputs []int
+		expectedChange       uint
+	}{
+		"select inputs with fee for single change output": {
+			selectBy: selectBy{
+				satoshis:            8,
+				txSizeWithoutInputs: testabilities.MaxSizeWithoutFeeForSingleInput - testabilities.SizeOfP2PKHChangeOutput,
+			},
+			expectToSelectInputs: []int{0},
+			expectedChange:       1, // utxo0(10) - output(8) - fee(1)
+		},
+		"select inputs with fee for multiple change outputs": {
+			selectBy: selectBy{
+				satoshis:            8,
+				txSizeWithoutInputs: testabilities.MaxSizeWithoutFeeForSingleInput - testabilities.SizeOfP2PKHChangeOutput,
+			},
+			changeOutputs:        2,
+			expectToSelectInputs: []int{0},
+			expectedChange:       0, // utxo0(10) - output(8) - feeForTwoChangeOutputs(2)
+		},
+		"select inputs with fee for change output by default": {
+			selectBy: selectBy{
+				satoshis:            8,
+				txSizeWithoutInputs: testabilities.MaxSizeWithoutFeeForSingleInput,
+			},
+			expectToSelectInputs: []int{0},
+			expectedChange:       0, // utxo0(10) - output(8) - feeForChangeOutput(2)
+		},
+		"select inputs without fee for change output": {
+			selectBy: selectBy{
+				satoshis:            8,
+				txSizeWithoutInputs: testabilities.MaxSizeWithoutFeeForSingleInput,
+			},
+			withoutChange:        true,
+			expectToSelectInputs: []int{0},
+			expectedChange:       1, // utxo0(10) - output(8) - fee(1)
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, then, cleanup := testabilities.New(t)
+			defer cleanup()
+
+			// and: having some utxo in database
+			ownedInputs := []*database.UserUTXO{
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+			}
+
+			// and:
+			bsvTransaction := given.Transaction().ForSatoshisAndSize(&test.selectBy)
+
+			// and:
+			selector := given.NewInputSelector()
+
+			// when:
+			utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+				ChangeOutputs: test.changeOutputs,
+				WithoutChange: test.withoutChange,
+			})
+
+			// then:
+			thenSuccess := then.WithoutError(err)
+			thenSuccess.SelectedInputs(utxos).
+				ComparingTo(ownedInputs).AreEntries(test.expectToSelectInputs)
+			thenSuccess.Change(change).EqualsTo(test.expectedChange)
+		})
+	}
+}
+
+func TestInputsSelectorWithPinnedInputs(t *testing.T) {
+	tests := map[string]struct {
+		selectBy             selectBy
+		pinned               []int
+		excluded             []int
+		pinnedOnly           bool
+		expectToSelectInputs []int
+		expectedChange       uint
+	}{
+		"spend only pinned input that covers outputs and fee without change": {
+			selectBy:             selectBy{satoshis: 9},
+			pinned:               []int{2},
+			pinnedOnly:           true,
+			expectToSelectInputs: []int{2},
+			expectedChange:       0, // utxo2(10) - output(9) - fee(1)
+		},
+		"spend only pinned inputs that covers outputs and fee with change": {
+			selectBy:             selectBy{satoshis: 15},
+			pinned:               []int{2, 3},
+			pinnedOnly:           true,
+			expectToSelectInputs: []int{2, 3},
+			expectedChange:       4, // (utxo2(10) + utxo3(10)) - output(15) - fee(1)
+		},
+		"select empty list when pinned only inputs are not enough": {
+			selectBy:   selectBy{satoshis: 15},
+			pinned:     []int{2},
+			pinnedOnly: true,
+		},
+		"select no more inputs when pinned inputs are enough": {
+			selectBy:             selectBy{satoshis: 9},
+			pinned:               []int{3},
+			expectToSelectInputs: []int{3},
+			expectedChange:       0, // utxo3(10) - output(9) - fee(1)
+		},
+		"select remaining inputs when pinned inputs are not enough": {
+			selectBy:             selectBy{satoshis: 15},
+			pinned:               []int{3},
+			expectToSelectInputs: []int{3, 0},
+			expectedChange:       4, // (utxo3(10) + utxo0(10)) - output(15) - fee(1)
+		},
+		"select remaining inputs without selecting pinned input twice": {
+			selectBy:             selectBy{satoshis: 25},
+			pinned:               []int{0},
+			expectToSelectInputs: []int{0, 1, 2},
+			expectedChange:       4, // (utxo0(10) + utxo1(10) + utxo2(10)) - output(25) - fee(1)
+		},
+		"select inputs without excluded ones": {
+			selectBy:             selectBy{satoshis: 15},
+			excluded:             []int{0},
+			expectToSelectInputs: []int{1, 2},
+			expectedChange:       4, // (utxo1(10) + utxo2(10)) - output(15) - fee(1)
+		},
+		"select empty list when excluded inputs are needed": {
+			selectBy: selectBy{satoshis: 35},
+			excluded: []int{0},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, then, cleanup := testabilities.New(t)
+			defer cleanup()
+
+			// and: having some utxo in database
+			ownedInputs := []*database.UserUTXO{
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedByRecipient().P2PKH().WithSatoshis(10).Stored(),
+			}
+
+			// and:
+			bsvTransaction := given.Transaction().ForSatoshisAndSize(&test.selectBy)
+
+			// and:
+			selector := given.NewInputSelector()
+
+			// when:
+			utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+				Pinned:     outpointsOf(ownedInputs, test.pinned),
+				Excluded:   outpointsOf(ownedInputs, test.excluded),
+				PinnedOnly: test.pinnedOnly,
+			})
+
+			// then:
+			thenSuccess := then.WithoutError(err)
+			thenSuccess.SelectedInputs(utxos).
+				ComparingTo(ownedInputs).AreEntries(test.expectToSelectInputs)
+			thenSuccess.Change(change).EqualsTo(test.expectedChange)
+		})
+	}
+
+	unavailableTests := map[string]struct {
+		pinned func(ownedInputs []*database.UserUTXO) []bsv.Outpoint
+	}{
+		"return error when pinned input belongs to other user": {
+			pinned: func(ownedInputs []*database.UserUTXO) []bsv.Outpoint {
+				return outpointsOf(ownedInputs, []int{0, 1})
+			},
+		},
+		"return error when pinned input doesn't exist": {
+			pinned: func(ownedInputs []*database.UserUTXO) []bsv.Outpoint {
+				return append(outpointsOf(ownedInputs, []int{0}), bsv.Outpoint{TxID: ownedInputs[0].TxID, Vout: 99})
+			},
+		},
+	}
+	for name, test := range unavailableTests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, _, cleanup := testabilities.New(t)
+			defer cleanup()
+
+			// and:
+			ownedInputs := []*database.UserUTXO{
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedByRecipient().P2PKH().WithSatoshis(10).Stored(),
+			}
+
+			// and:
+			bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 1})
+
+			// and:
+			selector := given.NewInputSelector()
+
+			// when:
+			utxos, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+				Pinned: test.pinned(ownedInputs),
+			})
+
+			// then:
+			require.ErrorIs(t, err, txerrors.ErrTxOutlineInputUnavailable)
+			require.Empty(t, utxos)
+		})
+	}
+}
+
+func TestInputsSelectorSelectingAll(t *testing.T) {
+	tests := map[string]struct {
+		selectBy             selectBy
+		excluded             []int
+		minSatoshis          bsv.Satoshis
+		maxSatoshis          bsv.Satoshis
+		expectToSelectInputs []int
+		expectedChange       uint
+	}{
+		"select all user's utxos": {
+			selectBy:             selectBy{satoshis: 0},
+			expectToSelectInputs: []int{0, 1, 2, 3},
+			expectedChange:       99, // (utxo0(10) + utxo1(20) + utxo2(30) + utxo3(40)) - output(0) - fee(1)
+		},
+		"select all user's utxos covering other outputs": {
+			selectBy:             selectBy{satoshis: 50},
+			expectToSelectInputs: []int{0, 1, 2, 3},
+			expectedChange:       49, // (utxo0(10) + utxo1(20) + utxo2(30) + utxo3(40)) - output(50) - fee(1)
+		},
+		"select all user's utxos without excluded ones": {
+			selectBy:             selectBy{satoshis: 0},
+			excluded:             []int{1},
+			expectToSelectInputs: []int{0, 2, 3},
+			expectedChange:       79, // (utxo0(10) + utxo2(30) + utxo3(40)) - output(0) - fee(1)
+		},
+		"select all user's utxos with minimal value": {
+			selectBy:             selectBy{satoshis: 0},
+			minSatoshis:          20,
+			expectToSelectInputs: []int{1, 2, 3},
+			expectedChange:       89, // (utxo1(20) + utxo2(30) + utxo3(40)) - output(0) - fee(1)
+		},
+		"select all user's utxos with maximal value": {
+			selectBy:             selectBy{satoshis: 0},
+			maxSatoshis:          20,
+			expectToSelectInputs: []int{0, 1},
+			expectedChange:       29, // (utxo0(10) + utxo1(20)) - output(0) - fee(1)
+		},
+		"select all user's utxos within value range": {
+			selectBy:             selectBy{satoshis: 0},
+			minSatoshis:          20,
+			maxSatoshis:          30,
+			expectToSelectInputs: []int{1, 2},
+			expectedChange:       49, // (utxo1(20) + utxo2(30)) - output(0) - fee(1)
+		},
+		"select empty list when all utxos don't cover outputs and fee": {
+			selectBy: selectBy{satoshis: 100},
+		},
+		"select empty list when no utxo matches the value range": {
+			selectBy:    selectBy{satoshis: 0},
+			minSatoshis: 50,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, then, cleanup := testabilities.New(t)
+			defer cleanup()
+
+			// and: having some utxo in database
+			ownedInputs := []*database.UserUTXO{
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(20).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(30).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(40).Stored(),
+				given.DB().HasUTXO().OwnedByRecipient().P2PKH().WithSatoshis(10).Stored(),
+			}
+
+			// and:
+			bsvTransaction := given.Transaction().ForSatoshisAndSize(&test.selectBy)
+
+			// and:
+			selector := given.NewInputSelector()
+
+			// when:
+			utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+				Excluded:    outpointsOf(ownedInputs, test.excluded),
+				All:         true,
+				MinSatoshis: test.minSatoshis,
+				MaxSatoshis: test.maxSatoshis,
+			})
+
+			// then:
+			thenSuccess := then.WithoutError(err)
+			thenSuccess.SelectedInputs(utxos).
+				ComparingTo(ownedInputs).AreEntries(test.expectToSelectInputs)
+			thenSuccess.Change(change).EqualsTo(test.expectedChange)
+		})
+	}
+}
+
+func TestInputsSelectorWithStrategies(t *testing.T) {
+	tests := map[string]struct {
+		selectBy             selectBy
+		strategy             outlines.UTXOSelectionStrategy
+		pinned               []int
+		excluded             []int
+		expectToSelectInputs []int
+		expectedChange       uint
+	}{
+		"select the oldest inputs first": {
+			selectBy:             selectBy{satoshis: 25},
+			strategy:             outlines.UTXOSelectionStrategyOldestFirst,
+			expectToSelectInputs: []int{0},
+			expectedChange:       4, // utxo0(30) - output(25) - fee(1)
+		},
+		"select the largest inputs first": {
+			selectBy:             selectBy{satoshis: 25},
+			strategy:             outlines.UTXOSelectionStrategyLargestFirst,
+			expectToSelectInputs: []int{2},
+			expectedChange:       14, // utxo2(40) - output(25) - fee(1)
+		},
+		"select the largest remaining inputs when pinned inputs are not enough": {
+			selectBy:             selectBy{satoshis: 25},
+			strategy:             outlines.UTXOSelectionStrategyLargestFirst,
+			pinned:               []int{1},
+			expectToSelectInputs: []int{1, 2},
+			expectedChange:       24, // (utxo1(10) + utxo2(40)) - output(25) - fee(1)
+		},
+		"select the smallest inputs first": {
+			selectBy:             selectBy{satoshis: 25},
+			strategy:             outlines.UTXOSelectionStrategySmallestFirst,
+			expectToSelectInputs: []int{1, 3},
+			expectedChange:       4, // (utxo1(10) + utxo3(20)) - output(25) - fee(1)
+		},
+		"select the inputs matching outputs and fee exactly": {
+			selectBy:             selectBy{satoshis: 48},
+			strategy:             outlines.UTXOSelectionStrategyBranchAndBound,
+			expectToSelectInputs: []int{2, 1},
+			expectedChange:       0, // (utxo2(40) + utxo1(10)) - output(48) - fee(1), the leftover(1) doesn't cover a change output
+		},
+		"select the remaining inputs matching outputs and fee exactly with pinned inputs": {
+			selectBy:             selectBy{satoshis: 38},
+			strategy:             outlines.UTXOSelectionStrategyBranchAndBound,
+			pinned:               []int{1},
+			expectToSelectInputs: []int{1, 0},
+			expectedChange:       0, // (utxo1(10) + utxo0(30)) - output(38) - fee(1), the leftover(1) doesn't cover a change output
+		},
+		"select the oldest inputs when there is no exact match": {
+			selectBy:             selectBy{satoshis: 55},
+			strategy:             outlines.UTXOSelectionStrategyBranchAndBound,
+			expectToSelectInputs: []int{0, 1, 2},
+			expectedChange:       24, // (utxo0(30) + utxo1(10) + utxo2(40)) - output(55) - fee(1)
+		},
+		"select empty list when there is no exact match and user has not enough funds": {
+			selectBy: selectBy{satoshis: 1_000},
+			strategy: outlines.UTXOSelectionStrategyBranchAndBound,
+		},
+		"select random inputs covering outputs and fee": {
+			selectBy:             selectBy{satoshis: 95},
+			strategy:             outlines.UTXOSelectionStrategyRandom,
+			expectToSelectInputs: []int{0, 1, 2, 3},
+			expectedChange:       4, // (utxo0(30) + utxo1(10) + utxo2(40) + utxo3(20)) - output(95) - fee(1)
+		},
+		"select random inputs without excluded ones": {
+			selectBy:             selectBy{satoshis: 55},
+			strategy:             outlines.UTXOSelectionStrategyRandom,
+			excluded:             []int{2},
+			expectToSelectInputs: []int{0, 1, 3},
+			expectedChange:       4, // (utxo0(30) + utxo1(10) + utxo3(20)) - output(55) - fee(1)
+		},
+		"select empty list when random inputs don't cover outputs and fee": {
+			selectBy: selectBy{satoshis: 1_000},
+			strategy: outlines.UTXOSelectionStrategyRandom,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given:
+			given, then, cleanup := testabilities.New(t)
+			defer cleanup()
+
+			// and: having some utxo in database
+			ownedInputs := []*database.UserUTXO{
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(30).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(40).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(20).Stored(),
+				given.DB().HasUTXO().OwnedByRecipient().P2PKH().WithSatoshis(100).Stored(),
+			}
+
+			// and:
+			bsvTransaction := given.Transaction().ForSatoshisAndSize(&test.selectBy)
+
+			// and:
+			selector := given.NewInputSelector()
+
+			// when:
+			utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+				Pinned:   outpointsOf(ownedInputs, test.pinned),
+				Excluded: outpointsOf(ownedInputs, test.excluded),
+				Strategy: test.strategy,
+			})
+
+			// then:
+			thenSuccess := then.WithoutError(err)
+			thenSuccess.SelectedInputs(utxos).
+				ComparingTo(ownedInputs).AreEntries(test.expectToSelectInputs)
+			thenSuccess.Change(change).EqualsTo(test.expectedChange)
+		})
+	}
+
+	t.Run("select inputs with default strategy of selector", func(t *testing.T) {
+		// given:
+		given, then, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and: having some utxo in database
+		ownedInputs := []*database.UserUTXO{
+			given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(30).Stored(),
+			given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+			given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(40).Stored(),
+		}
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 25})
+
+		// and:
+		selector := given.NewInputSelectorWithDefaultStrategy(outlines.UTXOSelectionStrategyLargestFirst)
+
+		// when:
+		utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{})
+
+		// then:
+		thenSuccess := then.WithoutError(err)
+		thenSuccess.SelectedInputs(utxos).
+			ComparingTo(ownedInputs).AreEntries([]int{2})
+		thenSuccess.Change(change).EqualsTo(14) // utxo2(40) - output(25) - fee(1)
+	})
+}
+
+// TestInputsSelectorWithStrategiesOnPostgres checks the SQL of each strategy on actual Postgres,
+// run it with TEST_DB_MODE=postgres environment variable.
+func TestInputsSelectorWithStrategiesOnPostgres(t *testing.T) {
+	if ok, _ := testmode.CheckPostgresMode(); !ok {
+		t.Skip("Postgres mode is not enabled, set TEST_DB_MODE=postgres to run this test")
+	}
+
+	strategies := []outlines.UTXOSelectionStrategy{
+		outlines.UTXOSelectionStrategyOldestFirst,
+		outlines.UTXOSelectionStrategyLargestFirst,
+		outlines.UTXOSelectionStrategySmallestFirst,
+		outlines.UTXOSelectionStrategyBranchAndBound,
+		outlines.UTXOSelectionStrategyRandom,
+	}
+	for _, strategy := range strategies {
+		t.Run(string(strategy), func(t *testing.T) {
+			// given:
+			given, then, cleanup := testabilities.New(t)
+			defer cleanup()
+
+			// and: having some utxo in database
+			ownedInputs := []*database.UserUTXO{
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(30).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(40).Stored(),
+				given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(20).Stored(),
+				given.DB().HasUTXO().OwnedByRecipient().P2PKH().WithSatoshis(100).Stored(),
+			}
+
+			// and:
+			bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 55})
+
+			// and:
+			selector := given.NewInputSelector()
+
+			// when:
+			utxos, change, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+				Pinned:        outpointsOf(ownedInputs, []int{1}),
+				Excluded:      outpointsOf(ownedInputs, []int{3}),
+				Strategy:      strategy,
+				ReservationID: "reservation-id",
+			})
+
+			// then:
+			thenSuccess := then.WithoutError(err)
+			thenSuccess.SelectedInputs(utxos).
+				ComparingTo(ownedInputs).AreEntries([]int{1, 0, 2})
+			thenSuccess.Change(change).EqualsTo(24) // (utxo1(10) + utxo0(30) + utxo2(40)) - output(55) - fee(1)
+		})
+	}
+}
+
+func TestInputsSelectorWithReservations(t *testing.T) {
+	const otherReservationID = "other-reservation-id"
+
+	t.Run("skip inputs reserved for other transaction outline", func(t *testing.T) {
+		// given:
+		given, then, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		ownedInputs := []*database.UserUTXO{
+			given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).ReservedUntil(otherReservationID, time.Now().Add(time.Hour)).Stored(),
+			given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+		}
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 9})
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		utxos, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{})
+
+		// then:
+		then.WithoutError(err).SelectedInputs(utxos).
+			ComparingTo(ownedInputs).AreEntries([]int{1})
+	})
+
+	t.Run("select inputs with expired reservation", func(t *testing.T) {
+		// given:
+		given, then, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		ownedInputs := []*database.UserUTXO{
+			given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).ReservedUntil(otherReservationID, time.Now().Add(-time.Hour)).Stored(),
+		}
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 9})
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		utxos, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{})
+
+		// then:
+		then.WithoutError(err).SelectedInputs(utxos).
+			ComparingTo(ownedInputs).AreEntries([]int{0})
+	})
+
+	t.Run("return error when pinned input is reserved for other transaction outline", func(t *testing.T) {
+		// given:
+		given, _, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		ownedInputs := []*database.UserUTXO{
+			given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).ReservedUntil(otherReservationID, time.Now().Add(time.Hour)).Stored(),
+		}
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 1})
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		utxos, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+			Pinned: outpointsOf(ownedInputs, []int{0}),
+		})
+
+		// then:
+		require.ErrorIs(t, err, txerrors.ErrTxOutlineInputReserved)
+		require.Empty(t, utxos)
+	})
+
+	t.Run("reserve selected inputs until they are released", func(t *testing.T) {
+		// given:
+		given, then, cleanup := testabilities.New(t)
+		defer cleanup()
+
+		// and:
+		ownedInputs := []*database.UserUTXO{
+			given.DB().HasUTXO().OwnedBySender().P2PKH().WithSatoshis(10).Stored(),
+		}
+
+		// and:
+		bsvTransaction := given.Transaction().ForSatoshisAndSize(&selectBy{satoshis: 9})
+
+		// and:
+		selector := given.NewInputSelector()
+
+		// when:
+		utxos, _, err := selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+			ReservationID: "first-reservation-id",
+		})
+
+		// then:
+		then.WithoutError(err).SelectedInputs(utxos).
+			ComparingTo(ownedInputs).AreEntries([]int{0})
+
+		// when:
+		utxos, _, err = selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+			ReservationID: "second-reservation-id",
+		})
+
+		// then:
+		then.WithoutError(err).SelectedInputs(utxos).AreEmpty()
+
+		// when:
+		err = selector.Release(context.Background(), fixtures.Sender.ID(), "first-reservation-id")
+
+		// then:
+		require.NoError(t, err)
+
+		// when:
+		utxos, _, err = selector.Select(context.Background(), bsvTransaction, fixtures.Sender.ID(), outlines.InputsSelection{
+			ReservationID: "second-reservation-id",
+		})
+
+		// then:
+		then.WithoutError(err).SelectedInputs(utxos).
+			ComparingTo(ownedInputs).AreEntries([]int{0})
+	})
+}
+
+func outpointsOf(utxos []*database.UserUTXO, indexes []int) []bsv.Outpoint {
+	return lo.Map(indexes, func(index int, _ int) bsv.Outpoint {
+		return bsv.Outpoint{TxID: utxos[index].TxID, Vout: utxos[index].Vout}
+	})
+}
+
 type selectBy struct {
 	satoshis            bsv.Satoshis
 	txSizeWithoutInputs int
@@ -3,6 +3,7 @@ package testabilities
 import (
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/bitcoin-sv/go-sdk/script"
 	sdk "github.com/bitcoin-sv/go-sdk/transaction"
@@ -10,6 +11,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures/txtestability"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/database/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/utxo/internal/sql"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/stretchr/testify/require"
@@ -19,9 +21,13 @@ import (
 type InputsSelectorFixture interface {
 	testabilities.DatabaseFixture
 	NewInputSelector() *sql.UTXOSelector
+	NewInputSelectorWithDefaultStrategy(strategy outlines.UTXOSelectionStrategy) *sql.UTXOSelector
 	Transaction() InputsSelectorTransactionFixture
 }
 
+// ReservationTTL is the time for which the selectors created by the fixture reserve the selected UTXOs.
+const ReservationTTL = 10 * time.Minute
+
 type InputsSelectorTransactionFixture interface {
 	ForSatoshisAndSize(SatoshisAndSizeProvider) *sdk.Transaction
 }
@@ -50,7 +56,11 @@ func newFixture(t testing.TB) (InputsSelectorFixture, func()) {
 }
 
 func (i *inputsSelectorFixture) NewInputSelector() *sql.UTXOSelector {
-	return sql.NewUTXOSelector(i.db, fixtures.DefaultFeeUnit)
+	return sql.NewUTXOSelector(i.db, fixtures.DefaultFeeUnit, "", ReservationTTL)
+}
+
+func (i *inputsSelectorFixture) NewInputSelectorWithDefaultStrategy(strategy outlines.UTXOSelectionStrategy) *sql.UTXOSelector {
+	return sql.NewUTXOSelector(i.db, fixtures.DefaultFeeUnit, strategy, ReservationTTL)
 }
 
 func (i *inputsSelectorFixture) Transaction() InputsSelectorTransactionFixture {
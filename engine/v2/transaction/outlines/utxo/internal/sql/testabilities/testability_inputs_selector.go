@@ -9,6 +9,9 @@ import (
 
 const SizeOfTransactionWithOnlyP2PKHOutput = 44
 
+// SizeOfP2PKHChangeOutput is the size of a single P2PKH change output that selector reserves fee for.
+const SizeOfP2PKHChangeOutput = 34
+
 // MaxSizeWithoutFeeForSingleInput is the maximum size of a transaction that can be created without a fee for a single P2PKH input.
 //
 // We're calculating it by taking Fee Unit bytes and subtracting the estimated size of unlocking script for P2PKH
@@ -1,14 +1,21 @@
 package utxo
 
 import (
+	"time"
+
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines/utxo/internal/sql"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"gorm.io/gorm"
 )
 
+// DefaultReservationTTL is the time for which the selected UTXOs are reserved when no other is configured.
+const DefaultReservationTTL = 10 * time.Minute
+
 // NewSelector creates a new instance of UTXOSelector.
-func NewSelector(db *gorm.DB, feeUnit bsv.FeeUnit) outlines.UTXOSelector {
+// The strategy is used for selections not specifying one, empty strategy means outlines.UTXOSelectionStrategyOldestFirst.
+// The selected UTXOs are reserved for the transaction outline for reservationTTL, zero means DefaultReservationTTL.
+func NewSelector(db *gorm.DB, feeUnit bsv.FeeUnit, strategy outlines.UTXOSelectionStrategy, reservationTTL time.Duration) outlines.UTXOSelector {
 	if db == nil {
 		panic("db is required")
 	}
@@ -17,5 +24,17 @@ func NewSelector(db *gorm.DB, feeUnit bsv.FeeUnit) outlines.UTXOSelector {
 		panic("valid fee unit is required")
 	}
 
-	return sql.NewUTXOSelector(db, feeUnit)
+	if strategy != "" && !strategy.IsValid() {
+		panic("unsupported utxo selection strategy")
+	}
+
+	if reservationTTL < 0 {
+		panic("reservation ttl cannot be negative")
+	}
+
+	if reservationTTL == 0 {
+		reservationTTL = DefaultReservationTTL
+	}
+
+	return sql.NewUTXOSelector(db, feeUnit, strategy, reservationTTL)
 }
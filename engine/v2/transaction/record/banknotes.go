@@ -0,0 +1,37 @@
+package record
+
+import (
+	"github.com/bitcoin-sv/go-sdk/script"
+	"github.com/bitcoin-sv/spv-wallet/engine/tokens"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	sdkscript "github.com/bsv-blockchain/go-sdk/script"
+)
+
+// p2pkhScriptLength is the length of the P2PKH locking script which precedes the inscription of the token output.
+const p2pkhScriptLength = 25
+
+// parseTokenOutput returns the P2PKH lock and the banknote of the BSV-21 token output.
+// It reports false if the locking script is not a P2PKH script followed by the BSV-21 inscription.
+func parseTokenOutput(lockingScript *script.Script) (*script.Script, *txmodels.NewBanknote, bool) {
+	if lockingScript == nil || len(*lockingScript) <= p2pkhScriptLength {
+		return nil, nil, false
+	}
+
+	lock := script.NewFromBytes((*lockingScript)[:p2pkhScriptLength])
+	if !lock.IsP2PKH() {
+		return nil, nil, false
+	}
+
+	operation, err := tokens.ParseTokenScript(sdkscript.NewFromBytes(*lockingScript))
+	if err != nil {
+		return nil, nil, false
+	}
+
+	serial := string(operation.ID)
+	return lock, &txmodels.NewBanknote{
+		Serial: serial,
+		// without the transfer metadata, the token ID is the best known identifier of the stablecoin
+		StablecoinID: serial,
+		Amount:       operation.Amount,
+	}, true
+}
@@ -50,6 +50,13 @@ type Broadcaster interface {
 	Broadcast(ctx context.Context, tx *trx.Transaction) (*chainmodels.TXInfo, error)
 }
 
+// SourceTxGetter is an interface for getting source transactions which are not stored in the database.
+// The returned transactions should have their merkle paths (or their own source transactions) attached,
+// so the recorded transaction can be verified and serialized as BEEF.
+type SourceTxGetter interface {
+	GetTransactions(ctx context.Context, ids iter.Seq[string]) ([]*trx.Transaction, error)
+}
+
 // PaymailNotifier is an interface for notifying paymail recipients about incoming transactions.
 type PaymailNotifier interface {
 	Notify(ctx context.Context, address string, p2pMetadata *paymail.P2PMetaData, reference string, tx *trx.Transaction) error
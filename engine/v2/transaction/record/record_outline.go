@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
@@ -13,13 +14,9 @@ import (
 
 // RecordTransactionOutline will validate, broadcast and save a transaction outline
 func (s *Service) RecordTransactionOutline(ctx context.Context, userID string, outline *outlines.Transaction) (*txmodels.RecordedOutline, error) {
-	if outline.Hex.IsRawTx() {
-		return nil, spverrors.Newf("not implemented recording outline with raw transaction")
-	}
-
-	tx, err := outline.Hex.ToBEEFTransaction()
+	tx, err := s.parseOutlineTransaction(ctx, outline)
 	if err != nil {
-		return nil, txerrors.ErrTxValidation.Wrap(err)
+		return nil, err
 	}
 
 	s.logger.Trace().Func(func(e *zerolog.Event) {
@@ -37,6 +34,8 @@ func (s *Service) RecordTransactionOutline(ctx context.Context, userID string, o
 	if err != nil {
 		return nil, err
 	}
+	flow.txRow.ReservationID = outline.ReservationID
+	flow.txRow.ReservationUserID = userID
 
 	if err = flow.verifyScripts(); err != nil {
 		return nil, err
@@ -120,3 +119,24 @@ func (s *Service) RecordTransactionOutline(ctx context.Context, userID string, o
 		TxID: tx.TxID().String(),
 	}, nil
 }
+
+// parseOutlineTransaction parses the outline hex, a raw transaction gets its source transactions hydrated.
+func (s *Service) parseOutlineTransaction(ctx context.Context, outline *outlines.Transaction) (*trx.Transaction, error) {
+	if !outline.Hex.IsRawTx() {
+		tx, err := outline.Hex.ToBEEFTransaction()
+		if err != nil {
+			return nil, txerrors.ErrTxValidation.Wrap(err)
+		}
+		return tx, nil
+	}
+
+	tx, err := outline.Hex.ToRawTransaction()
+	if err != nil {
+		return nil, txerrors.ErrTxValidation.Wrap(err)
+	}
+
+	if _, err = s.hydrateSourceTransactions(ctx, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
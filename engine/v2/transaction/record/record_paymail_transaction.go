@@ -9,12 +9,24 @@ import (
 )
 
 // RecordPaymailTransaction will validate, broadcast and save paymail transaction
+// The transaction without source transactions (e.g. received as raw hex) gets them hydrated and its scripts verified.
 func (s *Service) RecordPaymailTransaction(ctx context.Context, tx *trx.Transaction, senderPaymail, receiverPaymail string) error {
+	hydrated, err := s.hydrateSourceTransactions(ctx, tx)
+	if err != nil {
+		return err
+	}
+
 	flow, err := newTxFlow(ctx, s, tx)
 	if err != nil {
 		return err
 	}
 
+	if hydrated {
+		if err = flow.verifyScripts(); err != nil {
+			return err
+		}
+	}
+
 	trackedOutputs, err := flow.processInputs()
 	if err != nil {
 		return err
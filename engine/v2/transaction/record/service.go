@@ -17,12 +17,14 @@ type Service struct {
 	operations   OperationsRepo
 	transactions TransactionsRepo
 
+	sourceTxGetter  SourceTxGetter
 	broadcaster     Broadcaster
 	paymailNotifier PaymailNotifier
 	logger          zerolog.Logger
 }
 
 // NewService creates a new service for transactions
+// The sourceTxGetter is optional, without it the source transactions of raw transactions are searched only in the database.
 func NewService(
 	logger zerolog.Logger,
 	addresses AddressesService,
@@ -32,6 +34,7 @@ func NewService(
 	transactionsRepo TransactionsRepo,
 	broadcaster Broadcaster,
 	paymailNotifier PaymailNotifier,
+	sourceTxGetter SourceTxGetter,
 ) *Service {
 	return &Service{
 		addresses:       addresses,
@@ -42,6 +45,7 @@ func NewService(
 		transactions:    transactionsRepo,
 		logger:          logger,
 		paymailNotifier: paymailNotifier,
+		sourceTxGetter:  sourceTxGetter,
 	}
 }
 
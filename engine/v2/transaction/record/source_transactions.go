@@ -0,0 +1,105 @@
+package record
+
+import (
+	"context"
+	"slices"
+
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/beef"
+	txerrors "github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/errors"
+)
+
+// hydrateSourceTransactions attaches source transactions to the inputs which don't have them (e.g. when the transaction was parsed from raw hex).
+// The source transactions are searched in the database first and then, if not found, provided by the source transactions getter.
+// It returns true if any source transaction was attached.
+func (s *Service) hydrateSourceTransactions(ctx context.Context, tx *trx.Transaction) (bool, error) {
+	missingIDs := missingSourceTXIDs(tx)
+	if len(missingIDs) == 0 {
+		return false, nil
+	}
+
+	queryResults, err := s.transactions.FindTransactionInputSources(ctx, missingIDs...)
+	if err != nil {
+		return false, txerrors.ErrGettingSourceTransactions.Wrap(err)
+	}
+
+	stored, err := queryResults.SourceTxMap()
+	if err != nil {
+		return false, txerrors.ErrGettingSourceTransactions.Wrap(err)
+	}
+
+	external, err := s.getExternalSourceTransactions(ctx, slices.DeleteFunc(missingIDs, stored.Has))
+	if err != nil {
+		return false, err
+	}
+
+	if err = attachSourceTransactions(tx.Inputs, stored, external); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *Service) getExternalSourceTransactions(ctx context.Context, ids []string) (map[string]*trx.Transaction, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if s.sourceTxGetter == nil {
+		return nil, txerrors.ErrSourceTransactionNotFound
+	}
+
+	txs, err := s.sourceTxGetter.GetTransactions(ctx, slices.Values(ids))
+	if err != nil {
+		return nil, txerrors.ErrGettingSourceTransactions.Wrap(err)
+	}
+
+	external := make(map[string]*trx.Transaction, len(txs))
+	for _, tx := range txs {
+		external[tx.TxID().String()] = tx
+	}
+	return external, nil
+}
+
+// attachSourceTransactions sets the source transactions for the inputs,
+// going deeper only for the stored raw transactions, because BEEF and external transactions carry their own ancestry.
+func attachSourceTransactions(inputs []*trx.TransactionInput, stored beef.SourceTxMap, external map[string]*trx.Transaction) error {
+	for _, input := range inputs {
+		if input.SourceTransaction != nil {
+			continue
+		}
+
+		sourceTXID := input.SourceTXID.String()
+		if sourceTx, ok := external[sourceTXID]; ok {
+			input.SourceTransaction = sourceTx
+			continue
+		}
+
+		sourceTx := stored.Value(sourceTXID)
+		if sourceTx.IsZero() {
+			return txerrors.ErrSourceTransactionNotFound
+		}
+
+		input.SourceTransaction = sourceTx.Tx
+		if sourceTx.IsBeef() {
+			continue
+		}
+
+		if err := attachSourceTransactions(sourceTx.Tx.Inputs, stored, external); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func missingSourceTXIDs(tx *trx.Transaction) []string {
+	ids := make([]string, 0, len(tx.Inputs))
+	for _, input := range tx.Inputs {
+		if input.SourceTransaction != nil {
+			continue
+		}
+		if id := input.SourceTXID.String(); !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -136,6 +136,10 @@ func (f *txFlow) allP2PKHAddresses() addresses {
 	addrs := make(addresses)
 	for vout, output := range f.tx.Outputs {
 		lockingScript := output.LockingScript
+		if tokenLock, _, ok := parseTokenOutput(lockingScript); ok {
+			// the token output is tracked by the address of its P2PKH lock
+			lockingScript = tokenLock
+		}
 		if !lockingScript.IsP2PKH() {
 			continue
 		}
@@ -169,17 +173,23 @@ func (f *txFlow) createUTXOsForTrackedAddresses(potentialTrackedUniqueAddresses
 				continue
 			}
 			for voutsContainingAddress := range addrInfo.vouts {
-				yield(txmodels.NewOutputForP2PKH(
-					bsv.Outpoint{TxID: f.txID, Vout: voutsContainingAddress},
-					tracked.UserID,
-					bsv.Satoshis(f.tx.Outputs[voutsContainingAddress].Satoshis),
-					tracked.CustomInstructions,
-				))
+				yield(f.newOutputOfTrackedAddress(voutsContainingAddress, tracked.UserID, tracked.CustomInstructions))
 			}
 		}
 	}, nil
 }
 
+// newOutputOfTrackedAddress creates the output locked to the user's address, with the banknote if the output carries a BSV-21 token.
+func (f *txFlow) newOutputOfTrackedAddress(vout uint32, userID string, customInstructions bsv.CustomInstructions) txmodels.NewOutput {
+	outpoint := bsv.Outpoint{TxID: f.txID, Vout: vout}
+	satoshis := bsv.Satoshis(f.tx.Outputs[vout].Satoshis)
+
+	if _, banknote, ok := parseTokenOutput(f.tx.Outputs[vout].LockingScript); ok {
+		return txmodels.NewOutputForBanknote(outpoint, userID, satoshis, customInstructions, *banknote)
+	}
+	return txmodels.NewOutputForP2PKH(outpoint, userID, satoshis, customInstructions)
+}
+
 func (f *txFlow) verify() error {
 	if len(f.operations) == 0 {
 		return txerrors.ErrNoOperations
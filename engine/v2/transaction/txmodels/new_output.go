@@ -32,6 +32,15 @@ type NewOutput struct {
 	UTXO *SpendableUTXO
 
 	Data []byte
+
+	Banknote *NewBanknote
+}
+
+// NewBanknote holds the data of the BSV-21 token carried by the output.
+type NewBanknote struct {
+	Serial       string
+	StablecoinID string
+	Amount       uint64
 }
 
 // NewOutputForP2PKH creates a new output for P2PKH address.
@@ -49,6 +58,22 @@ func NewOutputForP2PKH(outpoint bsv.Outpoint, userID string, satoshis bsv.Satosh
 	}
 }
 
+// NewOutputForBanknote creates a new output for P2PKH address carrying a BSV-21 token.
+func NewOutputForBanknote(outpoint bsv.Outpoint, userID string, satoshis bsv.Satoshis, customInstructions bsv.CustomInstructions, banknote NewBanknote) NewOutput {
+	return NewOutput{
+		UserID:   userID,
+		TxID:     outpoint.TxID,
+		Vout:     outpoint.Vout,
+		Satoshis: satoshis,
+		Bucket:   "token",
+		UTXO: &SpendableUTXO{
+			EstimatedInputSize: EstimatedInputSizeForP2PKH,
+			CustomInstructions: customInstructions,
+		},
+		Banknote: &banknote,
+	}
+}
+
 // NewOutputForData creates a new output for data.
 func NewOutputForData(outpoint bsv.Outpoint, userID string, data []byte) NewOutput {
 	return NewOutput{
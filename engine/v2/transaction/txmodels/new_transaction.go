@@ -20,6 +20,11 @@ type NewTransaction struct {
 	Inputs  []TrackedOutput
 	Outputs []NewOutput
 
+	// ReservationID is the reservation of UTXOs made for the outline of the transaction, empty when there is none.
+	ReservationID string
+	// ReservationUserID is the owner of the reserved UTXOs, the reservation of other users is never released.
+	ReservationUserID string
+
 	transactionInputSources []TransactionInputSource
 	beefHex                 string
 	rawHex                  string
@@ -19,8 +19,14 @@ type TrackedTransaction struct {
 	BlockHeight *int64
 	BlockHash   *string
 
+	// Confirmations is the number of blocks mined on top of (and including) the block of the transaction,
+	// counted up to the confirmation depth configured for the wallet.
+	Confirmations uint32
+
 	BeefHex *string
 	RawHex  *string
+
+	sourceTXIDs []string
 }
 
 // TX returns the transaction object for the tracked transaction based on the hex representation (either BEEF or raw).
@@ -56,6 +62,10 @@ func (tt *TrackedTransaction) Mined(blockHash string, bump *trx.MerklePath) erro
 		return spverrors.Wrapf(err, "failed to get BEEF hex for transaction %s", tt.ID)
 	}
 
+	if tt.BlockHash == nil || *tt.BlockHash != blockHash {
+		tt.Confirmations = 1
+	}
+
 	tt.BeefHex = &beefHex
 	tt.RawHex = nil
 	tt.BlockHash = &blockHash
@@ -64,3 +74,84 @@ func (tt *TrackedTransaction) Mined(blockHash string, bump *trx.MerklePath) erro
 
 	return nil
 }
+
+// Orphaned moves the mined transaction back to BROADCASTED status, because its block is no longer part of the longest chain.
+// The merkle path of the orphaned block is removed if the transaction can be stored without it:
+// as BEEF when it carries its whole ancestry, or as raw hex linked to its source transactions when they are stored (sourcesStored).
+// Otherwise, the BEEF is kept as is until the transaction is mined again.
+func (tt *TrackedTransaction) Orphaned(sourcesStored bool) error {
+	tx, err := tt.TX()
+	if err != nil {
+		return err
+	}
+
+	tx.MerklePath = nil
+
+	if hasAllSourceTransactions(tx) {
+		beefHex, err := tx.BEEFHex()
+		if err != nil {
+			return spverrors.Wrapf(err, "failed to get BEEF hex for transaction %s", tt.ID)
+		}
+		tt.BeefHex = &beefHex
+		tt.RawHex = nil
+	} else if sourcesStored {
+		tt.RawHex = lo.ToPtr(tx.Hex())
+		tt.BeefHex = nil
+		tt.sourceTXIDs = inputSourceTXIDs(tx)
+	}
+
+	tt.BlockHash = nil
+	tt.BlockHeight = nil
+	tt.Confirmations = 0
+	tt.TxStatus = TxStatusBroadcasted
+
+	return nil
+}
+
+// InputSourceTXIDs returns the unique IDs of the transactions which outputs are spent by the inputs of the transaction.
+func (tt *TrackedTransaction) InputSourceTXIDs() ([]string, error) {
+	tx, err := tt.TX()
+	if err != nil {
+		return nil, err
+	}
+	return inputSourceTXIDs(tx), nil
+}
+
+// MerkleRoot returns the merkle root of the block in which the transaction was mined.
+func (tt *TrackedTransaction) MerkleRoot() (string, error) {
+	tx, err := tt.TX()
+	if err != nil {
+		return "", err
+	}
+
+	if tx.MerklePath == nil {
+		return "", spverrors.Newf("tracked transaction %s has no merkle path", tt.ID)
+	}
+
+	merkleRoot, err := tx.MerklePath.ComputeRootHex(&tt.ID)
+	if err != nil {
+		return "", spverrors.Wrapf(err, "failed to compute merkle root for transaction %s", tt.ID)
+	}
+
+	return merkleRoot, nil
+}
+
+// SourceTXIDs returns the IDs of the source transactions which the raw hex of the transaction should be linked to.
+func (tt *TrackedTransaction) SourceTXIDs() []string {
+	return tt.sourceTXIDs
+}
+
+func inputSourceTXIDs(tx *trx.Transaction) []string {
+	return lo.Uniq(lo.Map(tx.Inputs, func(input *trx.TransactionInput, _ int) string {
+		return input.SourceTXID.String()
+	}))
+}
+
+func hasAllSourceTransactions(tx *trx.Transaction) bool {
+	for _, input := range tx.Inputs {
+		if input.SourceTransaction == nil {
+			return false
+		}
+	}
+	return true
+}
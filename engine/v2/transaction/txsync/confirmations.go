@@ -0,0 +1,152 @@
+package txsync
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/notifications"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
+	"github.com/samber/lo"
+)
+
+type minedBlock struct {
+	hash   string
+	height int64
+}
+
+func blockOf(trackedTx *txmodels.TrackedTransaction) *minedBlock {
+	return &minedBlock{
+		hash:   *trackedTx.BlockHash,
+		height: *trackedTx.BlockHeight,
+	}
+}
+
+// SyncConfirmations updates the confirmations of the mined transactions which haven't reached the confirmation depth yet.
+// Transactions from the blocks which are no longer part of the longest chain are moved back to BROADCASTED status and queried again from ARC.
+func (s *Service) SyncConfirmations(ctx context.Context) error {
+	tip, err := s.chainService.GetChainTip(ctx)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get chain tip")
+	}
+
+	trackedTxs, err := s.transactionsRepo.FindMinedTransactionsBelowDepth(ctx, s.confirmationDepth)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get mined transactions below confirmation depth")
+	}
+
+	txsByBlock := lo.GroupBy(trackedTxs, func(trackedTx *txmodels.TrackedTransaction) string {
+		return *trackedTx.BlockHash
+	})
+
+	for _, blockTxs := range txsByBlock {
+		if err = s.syncBlockConfirmations(ctx, tip, blockOf(blockTxs[0]), blockTxs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) syncBlockConfirmations(ctx context.Context, tip *chainmodels.ChainTip, block *minedBlock, trackedTxs []*txmodels.TrackedTransaction) error {
+	if block.height > tip.Height {
+		// Block Headers Service is behind ARC, so the block will be checked next time
+		return nil
+	}
+
+	merkleRoot, err := trackedTxs[0].MerkleRoot()
+	if err != nil {
+		return err
+	}
+
+	inLongestChain, err := s.chainService.VerifyMerkleRoots(ctx, []*spv.MerkleRootConfirmationRequestItem{{
+		MerkleRoot:  merkleRoot,
+		BlockHeight: uint64(block.height), //nolint:gosec // block height is never negative
+	}})
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to verify merkle root of block %s", block.hash)
+	}
+
+	if inLongestChain {
+		confirmations := min(tip.Height-block.height+1, int64(s.confirmationDepth))
+		err = s.transactionsRepo.UpdateConfirmations(ctx, block.hash, uint32(confirmations)) //nolint:gosec // confirmations are between 1 and confirmation depth
+		if err != nil {
+			return spverrors.Wrapf(err, "failed to update confirmations of transactions from block %s", block.hash)
+		}
+		return nil
+	}
+
+	s.logger.Warn().
+		Str("blockHash", block.hash).
+		Int64("blockHeight", block.height).
+		Int("transactions", len(trackedTxs)).
+		Msg("Block is no longer part of the longest chain. Moving its transactions back to BROADCASTED")
+
+	for _, trackedTx := range trackedTxs {
+		if err = s.revertOrphaned(ctx, trackedTx, block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) revertOrphaned(ctx context.Context, trackedTx *txmodels.TrackedTransaction, orphanedBlock *minedBlock) error {
+	sourceTXIDs, err := trackedTx.InputSourceTXIDs()
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to get input sources of orphaned transaction %s", trackedTx.ID)
+	}
+
+	sourcesStored, err := s.transactionsRepo.HasTransactionInputSources(ctx, sourceTXIDs...)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to check input sources of orphaned transaction %s", trackedTx.ID)
+	}
+
+	err = trackedTx.Orphaned(sourcesStored)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to revert orphaned transaction %s", trackedTx.ID)
+	}
+
+	err = s.transactionsRepo.UpdateTransaction(ctx, trackedTx)
+	if err != nil {
+		return spverrors.Wrapf(err, "failed to set BROADCASTED status for orphaned transaction %s", trackedTx.ID)
+	}
+
+	txInfo, err := s.chainService.QueryTransaction(ctx, trackedTx.ID)
+	switch {
+	case err != nil:
+		s.logger.Warn().Err(err).
+			Str("TxID", trackedTx.ID).
+			Msg("Failed to query ARC for transaction from orphaned block. Waiting for ARC callback")
+	case !txInfo.Found():
+		s.logger.Warn().
+			Str("TxID", trackedTx.ID).
+			Msg("Transaction from orphaned block is not known by ARC")
+	case txInfo.TXStatus.IsMined() && txInfo.BlockHash == orphanedBlock.hash:
+		s.logger.Info().
+			Str("TxID", trackedTx.ID).
+			Msg("ARC still reports transaction in orphaned block. Waiting for ARC callback")
+	default:
+		if err = s.applyTXInfo(ctx, trackedTx, *txInfo); err != nil {
+			return err
+		}
+	}
+
+	s.notifyReorg(trackedTx, orphanedBlock)
+	return nil
+}
+
+func (s *Service) notifyReorg(trackedTx *txmodels.TrackedTransaction, orphanedBlock *minedBlock) {
+	if s.notifications == nil {
+		return
+	}
+
+	notifications.Notify(s.notifications, &models.TransactionReorgEvent{
+		TransactionID:       trackedTx.ID,
+		Status:              string(trackedTx.TxStatus),
+		OrphanedBlockHash:   orphanedBlock.hash,
+		OrphanedBlockHeight: orphanedBlock.height,
+	})
+}
@@ -200,3 +200,25 @@ func TestDoNothingOnCallbackWithOldStatus(t *testing.T) {
 	// then:
 	then.WithNoError(err).TransactionNotUpdated()
 }
+
+func TestNotifyReorgOnCallbackWithDifferentBlock(t *testing.T) {
+	given, then := testabilities.New(t)
+	// given:
+	service := given.Service()
+
+	// and:
+	given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 3)
+
+	// and:
+	spec := testabilities.MinedTXInfo(t).WithBlockHash(testabilities.NewBlockHash)
+
+	// when:
+	err := service.Handle(context.Background(), chainmodels.TXInfo(spec))
+
+	// then:
+	then.WithNoError(err).
+		ReorgNotified(txmodels.TxStatusMined).
+		TransactionUpdated(txmodels.TxStatusMined).
+		HasBlockHashEqual(testabilities.NewBlockHash).
+		HasConfirmations(1)
+}
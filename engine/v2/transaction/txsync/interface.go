@@ -3,6 +3,8 @@ package txsync
 import (
 	"context"
 
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
 )
 
@@ -10,4 +12,14 @@ import (
 type TransactionsRepo interface {
 	UpdateTransaction(ctx context.Context, trackedTx *txmodels.TrackedTransaction) error
 	GetTransaction(ctx context.Context, txID string) (transaction *txmodels.TrackedTransaction, err error)
+	FindMinedTransactionsBelowDepth(ctx context.Context, confirmationDepth uint32) ([]*txmodels.TrackedTransaction, error)
+	UpdateConfirmations(ctx context.Context, blockHash string, confirmations uint32) error
+	HasTransactionInputSources(ctx context.Context, sourceTXIDs ...string) (bool, error)
+}
+
+// ChainService is an interface for the chain services used to track the confirmations of the mined transactions.
+type ChainService interface {
+	QueryTransaction(ctx context.Context, txID string) (*chainmodels.TXInfo, error)
+	GetChainTip(ctx context.Context) (*chainmodels.ChainTip, error)
+	VerifyMerkleRoots(ctx context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (bool, error)
 }
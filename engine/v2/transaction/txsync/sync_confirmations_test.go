@@ -0,0 +1,281 @@
+package txsync_test
+
+import (
+	"context"
+	"testing"
+
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txsync/testabilities"
+)
+
+func TestSyncConfirmations(t *testing.T) {
+	tests := map[string]struct {
+		tipAboveTxBlock       int64
+		expectedConfirmations uint32
+	}{
+		"tip at the block of the transaction": {
+			tipAboveTxBlock:       0,
+			expectedConfirmations: 1,
+		},
+		"tip two blocks above the block of the transaction": {
+			tipAboveTxBlock:       2,
+			expectedConfirmations: 3,
+		},
+		"tip above the confirmation depth": {
+			tipAboveTxBlock:       100,
+			expectedConfirmations: 6,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			given, then := testabilities.New(t)
+			// given:
+			service := given.Service()
+
+			// and:
+			given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 1)
+
+			// and:
+			given.Chain().HasTipAboveTxBlock(test.tipAboveTxBlock)
+
+			// when:
+			err := service.SyncConfirmations(context.Background())
+
+			// then:
+			then.WithNoError(err).
+				ConfirmationsUpdated(test.expectedConfirmations).
+				ReorgNotNotified().
+				TransactionNotUpdated()
+		})
+	}
+}
+
+func TestSyncConfirmationsSkipsBlocksAboveTip(t *testing.T) {
+	given, then := testabilities.New(t)
+	// given:
+	service := given.Service()
+
+	// and:
+	given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 1)
+
+	// and:
+	given.Chain().HasTipAboveTxBlock(-1)
+
+	// when:
+	err := service.SyncConfirmations(context.Background())
+
+	// then:
+	then.WithNoError(err).
+		ConfirmationsNotUpdated().
+		TransactionNotUpdated()
+}
+
+func TestSyncConfirmationsSkipsTransactionsAtConfirmationDepth(t *testing.T) {
+	given, then := testabilities.New(t)
+	// given:
+	service := given.Service()
+
+	// and:
+	given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 6)
+
+	// and:
+	given.Chain().
+		HasTipAboveTxBlock(10).
+		WillReportBlockOrphaned()
+
+	// when:
+	err := service.SyncConfirmations(context.Background())
+
+	// then:
+	then.WithNoError(err).
+		ConfirmationsNotUpdated().
+		ReorgNotNotified().
+		TransactionNotUpdated()
+}
+
+func TestSyncConfirmationsOnOrphanedBlock(t *testing.T) {
+	t.Run("move transaction back to BROADCASTED keeping its BEEF when source transactions are not stored", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		// given:
+		service := given.Service()
+
+		// and:
+		given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 1)
+
+		// and:
+		given.Chain().
+			HasTipAboveTxBlock(1).
+			WillReportBlockOrphaned()
+
+		// when:
+		err := service.SyncConfirmations(context.Background())
+
+		// then:
+		then.WithNoError(err).
+			ConfirmationsNotUpdated().
+			ReorgNotified(txmodels.TxStatusBroadcasted).
+			TransactionUpdated(txmodels.TxStatusBroadcasted).
+			HasNoBlock().
+			HasConfirmations(0).
+			HasBEEF()
+	})
+
+	t.Run("move transaction back to BROADCASTED as raw hex when source transactions are stored", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		// given:
+		service := given.Service()
+
+		// and:
+		given.Repo().ContainsMinedTx(testabilities.FormatHex, 1)
+
+		// and:
+		given.Chain().
+			HasTipAboveTxBlock(1).
+			WillReportBlockOrphaned()
+
+		// when:
+		err := service.SyncConfirmations(context.Background())
+
+		// then:
+		then.WithNoError(err).
+			ReorgNotified(txmodels.TxStatusBroadcasted).
+			TransactionUpdated(txmodels.TxStatusBroadcasted).
+			HasNoBlock().
+			HasRawHex()
+	})
+
+	t.Run("keep transaction BROADCASTED when ARC still reports the orphaned block", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		// given:
+		service := given.Service()
+
+		// and:
+		given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 1)
+
+		// and:
+		given.Chain().
+			HasTipAboveTxBlock(1).
+			WillReportBlockOrphaned().
+			WillRespondForQuery(testabilities.MinedTXInfo(t))
+
+		// when:
+		err := service.SyncConfirmations(context.Background())
+
+		// then:
+		then.WithNoError(err).
+			ReorgNotified(txmodels.TxStatusBroadcasted).
+			TransactionUpdated(txmodels.TxStatusBroadcasted).
+			HasNoBlock()
+	})
+
+	t.Run("mark transaction MINED in the new block reported by ARC", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		// given:
+		service := given.Service()
+
+		// and:
+		given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 1)
+
+		// and:
+		given.Chain().
+			HasTipAboveTxBlock(1).
+			WillReportBlockOrphaned().
+			WillRespondForQuery(testabilities.MinedTXInfo(t).WithBlockHash(testabilities.NewBlockHash))
+
+		// when:
+		err := service.SyncConfirmations(context.Background())
+
+		// then:
+		then.WithNoError(err).
+			ReorgNotified(txmodels.TxStatusMined).
+			TransactionUpdated(txmodels.TxStatusMined).
+			HasBlockHashEqual(testabilities.NewBlockHash).
+			HasBlockHeight().
+			HasConfirmations(1).
+			HasBEEF()
+	})
+
+	t.Run("mark transaction PROBLEMATIC when ARC rejects it", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		// given:
+		service := given.Service()
+
+		// and:
+		given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 1)
+
+		// and:
+		given.Chain().
+			HasTipAboveTxBlock(1).
+			WillReportBlockOrphaned().
+			WillRespondForQuery(testabilities.TXInfo(t, chainmodels.Rejected))
+
+		// when:
+		err := service.SyncConfirmations(context.Background())
+
+		// then:
+		then.WithNoError(err).
+			ReorgNotified(txmodels.TxStatusProblematic).
+			TransactionUpdated(txmodels.TxStatusProblematic)
+	})
+}
+
+func TestSyncConfirmationsFails(t *testing.T) {
+	t.Run("fails on get chain tip", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		// given:
+		service := given.Service()
+
+		// and:
+		given.Repo().ContainsMinedTx(testabilities.FormatBEEF, 1)
+
+		// and:
+		given.Chain().WillFailOnChainTip()
+
+		// when:
+		err := service.SyncConfirmations(context.Background())
+
+		// then:
+		then.WithError(err)
+	})
+
+	t.Run("fails on find transactions in DB", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		// given:
+		service := given.Service()
+
+		// and:
+		given.Repo().
+			ContainsMinedTx(testabilities.FormatBEEF, 1).
+			WillFailOn(testabilities.FailingPointFind)
+
+		// and:
+		given.Chain().HasTipAboveTxBlock(1)
+
+		// when:
+		err := service.SyncConfirmations(context.Background())
+
+		// then:
+		then.WithError(err)
+	})
+
+	t.Run("fails on update confirmations in DB", func(t *testing.T) {
+		given, then := testabilities.New(t)
+		// given:
+		service := given.Service()
+
+		// and:
+		given.Repo().
+			ContainsMinedTx(testabilities.FormatBEEF, 1).
+			WillFailOn(testabilities.FailingPointUpdate)
+
+		// and:
+		given.Chain().HasTipAboveTxBlock(1)
+
+		// when:
+		err := service.SyncConfirmations(context.Background())
+
+		// then:
+		then.WithError(err)
+	})
+}
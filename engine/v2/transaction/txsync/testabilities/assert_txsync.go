@@ -2,9 +2,12 @@ package testabilities
 
 import (
 	"testing"
+	"time"
 
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
+	"github.com/bitcoin-sv/spv-wallet/engine/notifications"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,12 +19,20 @@ type AssertTXsync interface {
 type AssertSucceededTXsync interface {
 	TransactionUpdated(expectedStatus txmodels.TxStatus) AssertUpdatedTX
 	TransactionNotUpdated()
+	ConfirmationsUpdated(expected uint32) AssertSucceededTXsync
+	ConfirmationsNotUpdated() AssertSucceededTXsync
+	ReorgNotified(expectedStatus txmodels.TxStatus) AssertSucceededTXsync
+	ReorgNotNotified() AssertSucceededTXsync
 }
 
 type AssertUpdatedTX interface {
 	HasBlockHash() AssertUpdatedTX
+	HasBlockHashEqual(expected string) AssertUpdatedTX
 	HasBlockHeight() AssertUpdatedTX
+	HasNoBlock() AssertUpdatedTX
+	HasConfirmations(expected uint32) AssertUpdatedTX
 	HasBEEF() AssertUpdatedTX
+	HasRawHex() AssertUpdatedTX
 	HasEmptyRawHex() AssertUpdatedTX
 }
 
@@ -66,6 +77,23 @@ func (a *assertTXsync) HasBlockHash() AssertUpdatedTX {
 	return a
 }
 
+func (a *assertTXsync) HasBlockHashEqual(expected string) AssertUpdatedTX {
+	a.require.NotNil(a.given.repo.updated.BlockHash)
+	a.require.Equal(expected, *a.given.repo.updated.BlockHash)
+	return a
+}
+
+func (a *assertTXsync) HasNoBlock() AssertUpdatedTX {
+	a.require.Nil(a.given.repo.updated.BlockHash)
+	a.require.Nil(a.given.repo.updated.BlockHeight)
+	return a
+}
+
+func (a *assertTXsync) HasConfirmations(expected uint32) AssertUpdatedTX {
+	a.require.Equal(expected, a.given.repo.updated.Confirmations)
+	return a
+}
+
 func (a *assertTXsync) HasBlockHeight() AssertUpdatedTX {
 	a.require.NotNil(a.given.repo.updated.BlockHeight)
 	a.require.Equal(int64(mockBlockHeight), *a.given.repo.updated.BlockHeight)
@@ -84,7 +112,50 @@ func (a *assertTXsync) HasBEEF() AssertUpdatedTX {
 	return a
 }
 
+func (a *assertTXsync) HasRawHex() AssertUpdatedTX {
+	a.require.NotNil(a.given.repo.updated.RawHex)
+	a.require.Equal(a.given.repo.subjectTx.RawTX(), *a.given.repo.updated.RawHex)
+	a.require.Nil(a.given.repo.updated.BeefHex)
+	a.require.NotEmpty(a.given.repo.updated.SourceTXIDs())
+	return a
+}
+
 func (a *assertTXsync) HasEmptyRawHex() AssertUpdatedTX {
 	a.require.Nil(a.given.repo.updated.RawHex)
 	return a
 }
+
+func (a *assertTXsync) ConfirmationsUpdated(expected uint32) AssertSucceededTXsync {
+	a.require.Contains(a.given.repo.updatedConfirmations, mockBlockHash, "Confirmations not updated")
+	a.require.Equal(expected, a.given.repo.updatedConfirmations[mockBlockHash])
+	return a
+}
+
+func (a *assertTXsync) ConfirmationsNotUpdated() AssertSucceededTXsync {
+	a.require.Empty(a.given.repo.updatedConfirmations)
+	return a
+}
+
+func (a *assertTXsync) ReorgNotified(expectedStatus txmodels.TxStatus) AssertSucceededTXsync {
+	select {
+	case rawEvent := <-a.given.events:
+		event, err := notifications.GetEventContent[models.TransactionReorgEvent](rawEvent)
+		a.require.NoError(err)
+		a.require.Equal(a.given.repo.subjectTx.ID(), event.TransactionID)
+		a.require.Equal(string(expectedStatus), event.Status)
+		a.require.Equal(mockBlockHash, event.OrphanedBlockHash)
+		a.require.Equal(int64(mockBlockHeight), event.OrphanedBlockHeight)
+	case <-time.After(time.Second):
+		a.require.Fail("Expected reorg notification")
+	}
+	return a
+}
+
+func (a *assertTXsync) ReorgNotNotified() AssertSucceededTXsync {
+	select {
+	case rawEvent := <-a.given.events:
+		a.require.Failf("Unexpected notification", "got event %s", rawEvent.Type)
+	case <-time.After(50 * time.Millisecond):
+	}
+	return a
+}
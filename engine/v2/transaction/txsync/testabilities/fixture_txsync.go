@@ -1,38 +1,59 @@
 package testabilities
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/bitcoin-sv/go-sdk/chainhash"
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/notifications"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures/txtestability"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txsync"
+	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/samber/lo"
 )
 
 const mockBlockHash = "00000000000000000f0905597b6cac80031f0f56834e74dce1a714c682a9ed38"
 const mockBlockHeight = 885803
+const mockConfirmationDepth = 6
+
+// NewBlockHash is a hash of the block in which the transaction is mined after a reorg.
+const NewBlockHash = "0000000000000000025855b1f2e3c3e9bc73c0cfbc7d1a4b57e3aaf5fdbb5ce8"
 
 type FixtureTXsync interface {
 	Service() *txsync.Service
 	Repo() RepoFixtures
+	Chain() ChainFixtures
 }
 
 func Given(t testing.TB) FixtureTXsync {
 	repo := newMockRepo(t)
+	chain := newMockChain(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	logger := tester.Logger(t)
+	events := make(chan *models.RawEvent, 10)
+	notificationsService := notifications.NewNotifications(ctx, &logger)
+	notificationsService.AddNotifier("txsync-test", events)
+
 	return &fixtureTXsync{
 		t:       t,
 		repo:    repo,
-		service: txsync.NewService(tester.Logger(t), repo),
+		chain:   chain,
+		events:  events,
+		service: txsync.NewService(logger, repo, chain, notificationsService, mockConfirmationDepth),
 		givenTx: txtestability.Given(t),
 	}
 }
 
 type RepoFixtures interface {
 	ContainsBroadcastedTx(format Format) RepoFixtures
+	ContainsMinedTx(format Format, confirmations uint32) RepoFixtures
 	WillFailOn(f FailingPoint)
 }
 
@@ -41,6 +62,8 @@ type fixtureTXsync struct {
 	givenTx txtestability.TransactionsFixtures
 	service *txsync.Service
 	repo    *MockRepo
+	chain   *mockChain
+	events  chan *models.RawEvent
 }
 
 func (f *fixtureTXsync) Service() *txsync.Service {
@@ -51,6 +74,10 @@ func (f *fixtureTXsync) Repo() RepoFixtures {
 	return f.repo
 }
 
+func (f *fixtureTXsync) Chain() ChainFixtures {
+	return f.chain
+}
+
 func TXInfo(t testing.TB, status chainmodels.TXStatus) TXInfoSpec {
 
 	return TXInfoSpec{
@@ -90,6 +117,11 @@ func (f TXInfoSpec) WithSubjectTxOutOfMerklePath() TXInfoSpec {
 	return f
 }
 
+func (f TXInfoSpec) WithBlockHash(blockHash string) TXInfoSpec {
+	f.BlockHash = blockHash
+	return f
+}
+
 func (f TXInfoSpec) IncrementBlockHeight() TXInfoSpec {
 	f.BlockHeight++
 	return f
@@ -0,0 +1,80 @@
+package testabilities
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bitcoin-sv/go-paymail/spv"
+	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/stretchr/testify/require"
+)
+
+type ChainFixtures interface {
+	HasTipAt(height int64) ChainFixtures
+	HasTipAboveTxBlock(blocks int64) ChainFixtures
+	WillReportBlockOrphaned() ChainFixtures
+	WillRespondForQuery(txInfo TXInfoSpec) ChainFixtures
+	WillFailOnChainTip() ChainFixtures
+}
+
+type mockChain struct {
+	t             testing.TB
+	tip           *chainmodels.ChainTip
+	orphaned      bool
+	txInfo        *chainmodels.TXInfo
+	failOnTip     bool
+	verifiedRoots []*spv.MerkleRootConfirmationRequestItem
+}
+
+func newMockChain(t testing.TB) *mockChain {
+	return &mockChain{
+		t: t,
+	}
+}
+
+func (m *mockChain) HasTipAt(height int64) ChainFixtures {
+	m.tip = &chainmodels.ChainTip{Height: height}
+	return m
+}
+
+func (m *mockChain) HasTipAboveTxBlock(blocks int64) ChainFixtures {
+	return m.HasTipAt(mockBlockHeight + blocks)
+}
+
+func (m *mockChain) WillReportBlockOrphaned() ChainFixtures {
+	m.orphaned = true
+	return m
+}
+
+func (m *mockChain) WillRespondForQuery(txInfo TXInfoSpec) ChainFixtures {
+	info := chainmodels.TXInfo(txInfo)
+	m.txInfo = &info
+	return m
+}
+
+func (m *mockChain) WillFailOnChainTip() ChainFixtures {
+	m.failOnTip = true
+	return m
+}
+
+func (m *mockChain) QueryTransaction(_ context.Context, txID string) (*chainmodels.TXInfo, error) {
+	if m.txInfo == nil {
+		return nil, nil
+	}
+	require.Equal(m.t, m.txInfo.TxID, txID, "Service queried ARC for wrong transaction ID than expected")
+	return m.txInfo, nil
+}
+
+func (m *mockChain) GetChainTip(_ context.Context) (*chainmodels.ChainTip, error) {
+	if m.failOnTip {
+		return nil, spverrors.Newf("GetChainTip failed")
+	}
+	require.NotNil(m.t, m.tip, "Chain tip is not set")
+	return m.tip, nil
+}
+
+func (m *mockChain) VerifyMerkleRoots(_ context.Context, merkleRoots []*spv.MerkleRootConfirmationRequestItem) (bool, error) {
+	m.verifiedRoots = append(m.verifiedRoots, merkleRoots...)
+	return !m.orphaned, nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures/txtestability"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
@@ -17,16 +18,19 @@ func MockTx(t testing.TB) txtestability.TransactionSpec {
 }
 
 type MockRepo struct {
-	t          testing.TB
-	subjectTx  txtestability.TransactionSpec
-	row        *txmodels.TrackedTransaction
-	updated    *txmodels.TrackedTransaction
-	willFailOn FailingPoint
+	t                    testing.TB
+	subjectTx            txtestability.TransactionSpec
+	row                  *txmodels.TrackedTransaction
+	updated              *txmodels.TrackedTransaction
+	updatedConfirmations map[string]uint32
+	sourcesStored        bool
+	willFailOn           FailingPoint
 }
 
 func newMockRepo(t testing.TB) *MockRepo {
 	return &MockRepo{
-		t: t,
+		t:                    t,
+		updatedConfirmations: make(map[string]uint32),
 	}
 }
 
@@ -53,6 +57,30 @@ func (m *MockRepo) GetTransaction(_ context.Context, txID string) (transaction *
 	return m.row, nil
 }
 
+func (m *MockRepo) FindMinedTransactionsBelowDepth(_ context.Context, confirmationDepth uint32) ([]*txmodels.TrackedTransaction, error) {
+	if m.willFailOn == FailingPointFind {
+		return nil, spverrors.Newf("FindMinedTransactionsBelowDepth failed")
+	}
+
+	if m.row == nil || m.row.TxStatus != txmodels.TxStatusMined || m.row.Confirmations >= confirmationDepth {
+		return nil, nil
+	}
+	return []*txmodels.TrackedTransaction{m.row}, nil
+}
+
+func (m *MockRepo) UpdateConfirmations(_ context.Context, blockHash string, confirmations uint32) error {
+	if m.willFailOn == FailingPointUpdate {
+		return spverrors.Newf("UpdateConfirmations failed")
+	}
+	m.updatedConfirmations[blockHash] = confirmations
+	return nil
+}
+
+func (m *MockRepo) HasTransactionInputSources(_ context.Context, sourceTXIDs ...string) (bool, error) {
+	require.NotEmpty(m.t, sourceTXIDs)
+	return m.sourcesStored, nil
+}
+
 func (m *MockRepo) createTrackedTx() *txmodels.TrackedTransaction {
 	m.subjectTx = MockTx(m.t)
 
@@ -81,11 +109,37 @@ func (m *MockRepo) ContainsBroadcastedTx(format Format) RepoFixtures {
 	return m
 }
 
+func (m *MockRepo) ContainsMinedTx(format Format, confirmations uint32) RepoFixtures {
+	m.row = m.createTrackedTx()
+	m.sourcesStored = format == FormatHex
+
+	var tx *trx.Transaction
+	var err error
+	if format == FormatHex {
+		tx, err = trx.NewTransactionFromHex(m.subjectTx.RawTX())
+	} else {
+		tx, err = trx.NewTransactionFromBEEFHex(m.subjectTx.BEEF())
+	}
+	require.NoError(m.t, err)
+	tx.MerklePath = mockBump(m.subjectTx.ID())
+
+	beefHex, err := tx.BEEFHex()
+	require.NoError(m.t, err)
+
+	m.row.TxStatus = txmodels.TxStatusMined
+	m.row.BeefHex = &beefHex
+	m.row.BlockHash = lo.ToPtr(mockBlockHash)
+	m.row.BlockHeight = lo.ToPtr(int64(mockBlockHeight))
+	m.row.Confirmations = confirmations
+	return m
+}
+
 type FailingPoint int
 
 const (
 	FailingPointGet = iota + 1
 	FailingPointUpdate
+	FailingPointFind
 )
 
 func (m *MockRepo) WillFailOn(f FailingPoint) {
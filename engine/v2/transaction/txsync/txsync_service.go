@@ -5,22 +5,37 @@ import (
 
 	trx "github.com/bitcoin-sv/go-sdk/transaction"
 	chainmodels "github.com/bitcoin-sv/spv-wallet/engine/chain/models"
+	"github.com/bitcoin-sv/spv-wallet/engine/notifications"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/txmodels"
 	"github.com/rs/zerolog"
 )
 
 // Service is meant to handle the ARC callback and update the transaction status in the database.
+// It also tracks the confirmations of the mined transactions to handle chain reorganizations.
 type Service struct {
-	logger           zerolog.Logger
-	transactionsRepo TransactionsRepo
+	logger            zerolog.Logger
+	transactionsRepo  TransactionsRepo
+	chainService      ChainService
+	notifications     *notifications.Notifications
+	confirmationDepth uint32
 }
 
 // NewService creates a new transaction sync service.
-func NewService(logger zerolog.Logger, transactionsRepo TransactionsRepo) *Service {
+// The notifications are optional, if nil, no events about chain reorganizations are sent.
+func NewService(
+	logger zerolog.Logger,
+	transactionsRepo TransactionsRepo,
+	chainService ChainService,
+	notifications *notifications.Notifications,
+	confirmationDepth uint32,
+) *Service {
 	return &Service{
-		transactionsRepo: transactionsRepo,
-		logger:           logger,
+		transactionsRepo:  transactionsRepo,
+		logger:            logger,
+		chainService:      chainService,
+		notifications:     notifications,
+		confirmationDepth: confirmationDepth,
 	}
 }
 
@@ -42,9 +57,13 @@ func (s *Service) Handle(ctx context.Context, txInfo chainmodels.TXInfo) error {
 		return nil
 	}
 
+	return s.applyTXInfo(ctx, trackedTx, txInfo)
+}
+
+func (s *Service) applyTXInfo(ctx context.Context, trackedTx *txmodels.TrackedTransaction, txInfo chainmodels.TXInfo) error {
 	if txInfo.TXStatus.IsProblematic() {
 		trackedTx.TxStatus = txmodels.TxStatusProblematic
-		err = s.transactionsRepo.UpdateTransaction(ctx, trackedTx)
+		err := s.transactionsRepo.UpdateTransaction(ctx, trackedTx)
 		if err != nil {
 			return spverrors.Wrapf(err, "failed to set PROBLEMATIC status for transaction %s", txInfo.TxID)
 		}
@@ -65,10 +84,14 @@ func (s *Service) Handle(ctx context.Context, txInfo chainmodels.TXInfo) error {
 		return spverrors.Newf("Block height in BUMP doesn't match the block height in the callback")
 	}
 
+	var orphanedBlock *minedBlock
 	if trackedTx.BlockHash != nil && *trackedTx.BlockHash != txInfo.BlockHash {
-		s.logger.Info().
+		s.logger.Warn().
 			Str("TxID", txInfo.TxID).
-			Msg("Received callback for already MINED transaction with different BUMP. Reorg could happen")
+			Str("orphanedBlockHash", *trackedTx.BlockHash).
+			Str("blockHash", txInfo.BlockHash).
+			Msg("Received callback for already MINED transaction with different block. The transaction was moved by a reorg")
+		orphanedBlock = blockOf(trackedTx)
 	}
 
 	err = trackedTx.Mined(txInfo.BlockHash, bump)
@@ -81,6 +104,10 @@ func (s *Service) Handle(ctx context.Context, txInfo chainmodels.TXInfo) error {
 		return spverrors.Wrapf(err, "failed to set MINED status for transaction %s", txInfo.TxID)
 	}
 
+	if orphanedBlock != nil {
+		s.notifyReorg(trackedTx, orphanedBlock)
+	}
+
 	return nil
 }
 
@@ -2,9 +2,12 @@ package users
 
 import (
 	"context"
+	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/users/usersmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
 	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
 )
 
@@ -14,5 +17,11 @@ type UserRepo interface {
 	GetIDByPubKey(ctx context.Context, pubKey string) (string, error)
 	Get(ctx context.Context, userID string) (*usersmodels.User, error)
 	Create(ctx context.Context, newUser *usersmodels.NewUser) (*usersmodels.User, error)
+	Search(ctx context.Context, page filter.Page, conditions *filter.UserFilter) (*models.PagedResult[usersmodels.User], error)
+	SetDeactivatedAt(ctx context.Context, userID string, deactivatedAt *time.Time) error
 	GetBalance(ctx context.Context, userID string, name bucket.Name) (bsv.Satoshis, error)
+	GetConfirmedBalance(ctx context.Context, userID string, name bucket.Name, confirmations uint32) (bsv.Satoshis, error)
+	GetBalanceAt(ctx context.Context, userID string, at time.Time) (usersmodels.Balance, error)
+	GetBalanceAtBlockHeight(ctx context.Context, userID string, blockHeight int64) (usersmodels.Balance, error)
+	GetDailyBalanceChanges(ctx context.Context, userID string, from, to time.Time) ([]*usersmodels.BalanceChange, error)
 }
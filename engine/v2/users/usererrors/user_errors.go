@@ -0,0 +1,6 @@
+package usererrors
+
+import "github.com/bitcoin-sv/spv-wallet/models"
+
+// ErrUserNotFound is when the user with the given ID doesn't exist.
+var ErrUserNotFound = models.SPVError{Message: "user not found", StatusCode: 404, Code: "error-user-not-found"}
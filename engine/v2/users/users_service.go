@@ -2,13 +2,30 @@ package users
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	primitives "github.com/bitcoin-sv/go-sdk/primitives/ec"
 	"github.com/bitcoin-sv/spv-wallet/config"
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/users/usererrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/v2/users/usersmodels"
+	"github.com/bitcoin-sv/spv-wallet/models"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
+	"github.com/bitcoin-sv/spv-wallet/models/filter"
 	"github.com/bitcoin-sv/spv-wallet/models/transaction/bucket"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+const (
+	day = 24 * time.Hour
+
+	// maxDailyBalances is the maximal number of days in the balance history
+	maxDailyBalances = 366
+
+	// timestampPrecision is the precision of the timestamps stored in the database
+	timestampPrecision = time.Microsecond
 )
 
 // Service is a user domain service
@@ -63,7 +80,52 @@ func (s *Service) GetByID(ctx context.Context, userID string) (*usersmodels.User
 	return user, nil
 }
 
-// GetIDByPubKey returns the user ID selected by pubKey
+// Search returns a page of users matching the conditions
+func (s *Service) Search(ctx context.Context, page filter.Page, conditions *filter.UserFilter) (*models.PagedResult[usersmodels.User], error) {
+	users, err := s.usersRepo.Search(ctx, page, conditions)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to search users")
+	}
+	return users, nil
+}
+
+// Deactivate deactivates the user, so the user can no longer authenticate and the user's paymails are not resolved
+func (s *Service) Deactivate(ctx context.Context, userID string) (*usersmodels.User, error) {
+	return s.setActive(ctx, userID, false)
+}
+
+// Reactivate reactivates the deactivated user
+func (s *Service) Reactivate(ctx context.Context, userID string) (*usersmodels.User, error) {
+	return s.setActive(ctx, userID, true)
+}
+
+func (s *Service) setActive(ctx context.Context, userID string, active bool) (*usersmodels.User, error) {
+	user, err := s.usersRepo.Get(ctx, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, usererrors.ErrUserNotFound
+	} else if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get user")
+	}
+	if user.IsActive() == active {
+		return user, nil
+	}
+
+	var deactivatedAt *time.Time
+	if !active {
+		deactivatedAt = lo.ToPtr(time.Now())
+	}
+	if err = s.usersRepo.SetDeactivatedAt(ctx, userID, deactivatedAt); err != nil {
+		return nil, spverrors.Wrapf(err, "failed to change user's activity")
+	}
+
+	user, err = s.usersRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "failed to get user")
+	}
+	return user, nil
+}
+
+// GetIDByPubKey returns the ID of the active user selected by pubKey; deactivated users are not found
 func (s *Service) GetIDByPubKey(ctx context.Context, pubKey string) (string, error) {
 	userID, err := s.usersRepo.GetIDByPubKey(ctx, pubKey)
 	if err != nil {
@@ -95,3 +157,70 @@ func (s *Service) GetBalance(ctx context.Context, userID string) (bsv.Satoshis,
 	}
 	return balance, nil
 }
+
+// GetConfirmedBalance returns the balance for the user made of the transactions which reached the configured confirmation depth
+func (s *Service) GetConfirmedBalance(ctx context.Context, userID string) (bsv.Satoshis, error) {
+	balance, err := s.usersRepo.GetConfirmedBalance(ctx, userID, bucket.BSV, s.ConfirmationDepth())
+	if err != nil {
+		return 0, spverrors.Wrapf(err, "Cannot get user's confirmed balance")
+	}
+	return balance, nil
+}
+
+// GetBalanceAt returns the balance of the user as of the given time, split into the confirmed and unconfirmed part by the current status of the transactions
+func (s *Service) GetBalanceAt(ctx context.Context, userID string, at time.Time) (usersmodels.Balance, error) {
+	balance, err := s.usersRepo.GetBalanceAt(ctx, userID, at)
+	if err != nil {
+		return usersmodels.Balance{}, spverrors.Wrapf(err, "Cannot get user's balance at %s", at)
+	}
+	return balance, nil
+}
+
+// GetBalanceAtBlockHeight returns the balance of the user made of the transactions mined at or below the given block height
+func (s *Service) GetBalanceAtBlockHeight(ctx context.Context, userID string, blockHeight int64) (usersmodels.Balance, error) {
+	balance, err := s.usersRepo.GetBalanceAtBlockHeight(ctx, userID, blockHeight)
+	if err != nil {
+		return usersmodels.Balance{}, spverrors.Wrapf(err, "Cannot get user's balance at block height %d", blockHeight)
+	}
+	return balance, nil
+}
+
+// GetDailyBalances returns the balances of the user at the end of each day (UTC) from the day of "from" to the day of "to", inclusive
+func (s *Service) GetDailyBalances(ctx context.Context, userID string, from, to time.Time) ([]*usersmodels.DailyBalance, error) {
+	firstDay := from.UTC().Truncate(day)
+	lastDay := to.UTC().Truncate(day)
+	if lastDay.Before(firstDay) || lastDay.Sub(firstDay) >= maxDailyBalances*day {
+		return nil, spverrors.ErrBalanceHistoryRangeInvalid
+	}
+
+	// the moment just before the first day which can be stored in the database
+	beforeFirstDay := firstDay.Add(-timestampPrecision)
+
+	balance, err := s.usersRepo.GetBalanceAt(ctx, userID, beforeFirstDay)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "Cannot get user's opening balance")
+	}
+
+	changes, err := s.usersRepo.GetDailyBalanceChanges(ctx, userID, firstDay, lastDay.Add(day))
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "Cannot get user's balance changes")
+	}
+
+	var dailyBalances []*usersmodels.DailyBalance
+	for date := firstDay; !date.After(lastDay); date = date.Add(day) {
+		if len(changes) > 0 && changes[0].Date.Equal(date) {
+			balance.Add(changes[0].Balance)
+			changes = changes[1:]
+		}
+		dailyBalances = append(dailyBalances, &usersmodels.DailyBalance{
+			Date:    date,
+			Balance: balance,
+		})
+	}
+	return dailyBalances, nil
+}
+
+// ConfirmationDepth returns the number of confirmations required for the transaction to be counted into the confirmed balance
+func (s *Service) ConfirmationDepth() uint32 {
+	return s.config.BHS.ConfirmationDepth
+}
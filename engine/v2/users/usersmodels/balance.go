@@ -0,0 +1,35 @@
+package usersmodels
+
+import "time"
+
+// Balance is a balance of a user computed from the values of the user's operations,
+// split by the status of the underlying transactions.
+type Balance struct {
+	// Confirmed is the sum of values of the operations on mined transactions.
+	Confirmed int64
+	// Unconfirmed is the sum of values of the operations on transactions which are not mined (nor reverted).
+	Unconfirmed int64
+}
+
+// Total returns the sum of the confirmed and unconfirmed balance
+func (b Balance) Total() int64 {
+	return b.Confirmed + b.Unconfirmed
+}
+
+// Add adds the change to the balance
+func (b *Balance) Add(change Balance) {
+	b.Confirmed += change.Confirmed
+	b.Unconfirmed += change.Unconfirmed
+}
+
+// BalanceChange is a change of the user's balance made by the operations created within the day (UTC)
+type BalanceChange struct {
+	Date time.Time
+	Balance
+}
+
+// DailyBalance is the balance of a user at the end of the day
+type DailyBalance struct {
+	Date time.Time
+	Balance
+}
@@ -22,6 +22,13 @@ type User struct {
 
 	PublicKey string
 	Paymails  []*paymailsmodels.Paymail
+
+	DeactivatedAt *time.Time
+}
+
+// IsActive returns true if the user is not deactivated
+func (u *User) IsActive() bool {
+	return u.DeactivatedAt == nil
 }
 
 // PubKeyObj returns the go-sdk primitives.PublicKey object from the user's PubKey string
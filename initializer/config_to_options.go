@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/url"
+	"os"
 	"time"
 
 	"github.com/bitcoin-sv/spv-wallet/config"
@@ -15,6 +16,7 @@ import (
 	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
 	"github.com/bitcoin-sv/spv-wallet/engine/taskmanager"
 	"github.com/bitcoin-sv/spv-wallet/engine/utils"
+	"github.com/bitcoin-sv/spv-wallet/engine/v2/transaction/outlines"
 	"github.com/bitcoin-sv/spv-wallet/metrics"
 	"github.com/bitcoin-sv/spv-wallet/models/bsv"
 	"github.com/go-redis/redis/v8"
@@ -61,6 +63,12 @@ func ToEngineOptions(c *config.AppConfig, logger zerolog.Logger) (options []engi
 
 	options = addCustomFeeUnit(c, options)
 
+	options = addUTXOSelectionOpts(c, options)
+
+	if options, err = addStablecoinOpts(c, options); err != nil {
+		return nil, err
+	}
+
 	return options, nil
 }
 
@@ -91,6 +99,39 @@ func addCustomFeeUnit(c *config.AppConfig, options []engine.ClientOps) []engine.
 	return options
 }
 
+func addUTXOSelectionOpts(c *config.AppConfig, options []engine.ClientOps) []engine.ClientOps {
+	if c.UTXOSelection != nil {
+		options = append(options, engine.WithUTXOSelectionStrategy(outlines.UTXOSelectionStrategy(c.UTXOSelection.Strategy)))
+		options = append(options, engine.WithUTXOReservationTTL(c.UTXOSelection.ReservationTTL))
+	}
+	return options
+}
+
+func addStablecoinOpts(c *config.AppConfig, options []engine.ClientOps) ([]engine.ClientOps, error) {
+	if c.Stablecoin == nil || len(c.Stablecoin.Signers) == 0 {
+		return options, nil
+	}
+
+	keys := make(map[string]string, len(c.Stablecoin.Signers))
+	for _, signer := range c.Stablecoin.Signers {
+		key := signer.PrivateKey
+		if signer.PrivateKeyEnv != "" {
+			var ok bool
+			if key, ok = os.LookupEnv(signer.PrivateKeyEnv); !ok || key == "" {
+				return nil, spverrors.Newf("private key of the stablecoin signer %s is not set in env %s", signer.Paymail, signer.PrivateKeyEnv)
+			}
+		}
+		keys[signer.Paymail] = key
+	}
+
+	signer, err := engine.NewPaymailKeysSigner(keys)
+	if err != nil {
+		return nil, spverrors.Wrapf(err, "error while creating stablecoin signer")
+	}
+
+	return append(options, engine.WithStablecoinSigner(signer)), nil
+}
+
 func addUserAgentOpts(c *config.AppConfig, options []engine.ClientOps) []engine.ClientOps {
 	return append(options, engine.WithUserAgent(c.GetUserAgent()))
 }
@@ -0,0 +1,154 @@
+package initializer_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bitcoin-sv/spv-wallet/config"
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	paymailclient "github.com/bitcoin-sv/spv-wallet/engine/paymail"
+	"github.com/bitcoin-sv/spv-wallet/engine/testabilities"
+	"github.com/bitcoin-sv/spv-wallet/engine/tester/fixtures"
+	"github.com/bitcoin-sv/spv-wallet/initializer"
+	bsm "github.com/bsv-blockchain/go-sdk/compat/bsm"
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/jarcoal/httpmock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// intentRecorder stores the intent received by the mocked transfer intent endpoint
+type intentRecorder struct {
+	intent *engine.Intent
+}
+
+func (r intentRecorder) Responder() httpmock.Responder {
+	return func(request *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(request.Body).Decode(r.intent); err != nil {
+			return httpmock.NewStringResponse(http.StatusBadRequest, "invalid json"), nil
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, engine.ValidationResponse{Nonce: r.intent.Nonce})
+	}
+}
+
+func TestToEngineOptionsStablecoinSigner(t *testing.T) {
+	senderKey, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+
+	sender := fixtures.Sender.DefaultPaymail().Address()
+
+	newIntent := func(senderPaymail string) engine.Intent {
+		return engine.Intent{
+			SenderID:     senderPaymail,
+			ReceiverID:   "bob@" + fixtures.PaymailDomainExternal,
+			Nonce:        "1234567890abcdef",
+			StablecoinID: "stablecoin",
+			Amount:       100,
+		}
+	}
+
+	withSigner := func(c *config.AppConfig) {
+		c.Stablecoin.Signers = []*config.StablecoinSignerConfig{
+			{Paymail: sender, PrivateKey: senderKey.Wif()},
+		}
+	}
+
+	t.Run("sign transfer intent with the configured PKI key", func(t *testing.T) {
+		// given:
+		given := testabilities.Given(t)
+		walletEngine, cleanup := given.EngineWithConfiguration(withSigner)
+		defer cleanup()
+
+		var received engine.Intent
+		given.PaymailClient().WillRespondWithStablecoinCapabilities()
+		given.PaymailClient().WillRespondOnCapability(paymailclient.BRFCStablecoinTransferIntent).With(intentRecorder{intent: &received})
+
+		intent := newIntent(sender)
+
+		// when:
+		_, err := walletEngine.Engine.StablecoinTransferService().SendTransferIntent(context.Background(), walletEngine.Engine, intent)
+
+		// then:
+		require.NoError(t, err)
+
+		address, err := script.NewAddressFromPublicKey(senderKey.PubKey(), true)
+		require.NoError(t, err)
+		signature, err := base64.StdEncoding.DecodeString(received.Signature)
+		require.NoError(t, err)
+		require.NoError(t, bsm.VerifyMessage(address.AddressString, signature, intent.SigningMessage()))
+	})
+
+	t.Run("send unsigned transfer intent of paymail without signer", func(t *testing.T) {
+		// given:
+		given := testabilities.Given(t)
+		walletEngine, cleanup := given.EngineWithConfiguration(withSigner)
+		defer cleanup()
+
+		var received engine.Intent
+		given.PaymailClient().WillRespondWithStablecoinCapabilities()
+		given.PaymailClient().WillRespondOnCapability(paymailclient.BRFCStablecoinTransferIntent).With(intentRecorder{intent: &received})
+
+		// when:
+		_, err := walletEngine.Engine.StablecoinTransferService().SendTransferIntent(context.Background(), walletEngine.Engine, newIntent(fixtures.RecipientInternal.DefaultPaymail().Address()))
+
+		// then:
+		require.NoError(t, err)
+		require.Empty(t, received.Signature)
+	})
+
+	t.Run("sign transfer intent with the PKI key from env", func(t *testing.T) {
+		// given:
+		t.Setenv("TEST_SENDER_PKI_KEY", senderKey.Wif())
+
+		given := testabilities.Given(t)
+		walletEngine, cleanup := given.EngineWithConfiguration(func(c *config.AppConfig) {
+			c.Stablecoin.Signers = []*config.StablecoinSignerConfig{
+				{Paymail: sender, PrivateKeyEnv: "TEST_SENDER_PKI_KEY"},
+			}
+		})
+		defer cleanup()
+
+		var received engine.Intent
+		given.PaymailClient().WillRespondWithStablecoinCapabilities()
+		given.PaymailClient().WillRespondOnCapability(paymailclient.BRFCStablecoinTransferIntent).With(intentRecorder{intent: &received})
+
+		// when:
+		_, err := walletEngine.Engine.StablecoinTransferService().SendTransferIntent(context.Background(), walletEngine.Engine, newIntent(sender))
+
+		// then:
+		require.NoError(t, err)
+		require.NotEmpty(t, received.Signature)
+	})
+
+	t.Run("return error when signer private key env is not set", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+		cfg.Stablecoin.Signers = []*config.StablecoinSignerConfig{
+			{Paymail: sender, PrivateKeyEnv: "TEST_MISSING_PKI_KEY"},
+		}
+
+		// when:
+		_, err := initializer.ToEngineOptions(cfg, zerolog.Nop())
+
+		// then:
+		require.ErrorContains(t, err, "TEST_MISSING_PKI_KEY")
+	})
+
+	t.Run("return error on invalid signer private key", func(t *testing.T) {
+		// given:
+		cfg := config.GetDefaultAppConfig()
+		cfg.Stablecoin.Signers = []*config.StablecoinSignerConfig{
+			{Paymail: sender, PrivateKey: "invalid"},
+		}
+
+		// when:
+		_, err := initializer.ToEngineOptions(cfg, zerolog.Nop())
+
+		// then:
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,186 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var exampleRows = []*Row{
+	{
+		CreatedAt:    time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+		Owner:        "user1",
+		TxID:         "a1",
+		Type:         "incoming",
+		Counterparty: "alice@example.com",
+		Value:        1000,
+		Balance:      1000,
+		TxStatus:     "MINED",
+		BlockHeight:  ptr(uint64(885803)),
+	},
+	{
+		CreatedAt:    time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC),
+		Owner:        "user1",
+		TxID:         "b2",
+		Type:         "outgoing",
+		Counterparty: "bob@example.com",
+		Value:        -101,
+		Fee:          ptr(uint64(1)),
+		Balance:      899,
+		TxStatus:     "BROADCASTED",
+	},
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		expected Format
+	}{
+		"default format": {
+			name:     "",
+			expected: FormatCSV,
+		},
+		"csv": {
+			name:     "CSV",
+			expected: FormatCSV,
+		},
+		"json lines": {
+			name:     "jsonl",
+			expected: FormatJSONLines,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			format, err := ParseFormat(test.name)
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, format)
+		})
+	}
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := ParseFormat("xml")
+
+		require.Error(t, err)
+	})
+}
+
+func TestWriter(t *testing.T) {
+	t.Run("write CSV", func(t *testing.T) {
+		// given:
+		var buf bytes.Buffer
+		writer := NewWriter(&buf, FormatCSV)
+
+		// when:
+		for _, row := range exampleRows {
+			require.NoError(t, writer.Write(row))
+		}
+		require.NoError(t, writer.Flush())
+
+		// then:
+		require.Equal(t, "created_at,owner,tx_id,type,counterparty,value,fee,balance,tx_status,block_height\n"+
+			"2024-02-01T10:00:00Z,user1,a1,incoming,alice@example.com,1000,,1000,MINED,885803\n"+
+			"2024-02-02T10:00:00Z,user1,b2,outgoing,bob@example.com,-101,1,899,BROADCASTED,\n",
+			buf.String())
+	})
+
+	t.Run("write empty CSV", func(t *testing.T) {
+		// given:
+		var buf bytes.Buffer
+		writer := NewWriter(&buf, FormatCSV)
+
+		// when:
+		require.NoError(t, writer.Flush())
+
+		// then:
+		require.Equal(t, "created_at,owner,tx_id,type,counterparty,value,fee,balance,tx_status,block_height\n", buf.String())
+	})
+
+	t.Run("write JSON lines", func(t *testing.T) {
+		// given:
+		var buf bytes.Buffer
+		writer := NewWriter(&buf, FormatJSONLines)
+
+		// when:
+		for _, row := range exampleRows {
+			require.NoError(t, writer.Write(row))
+		}
+		require.NoError(t, writer.Flush())
+
+		// then:
+		require.Equal(t, `{"createdAt":"2024-02-01T10:00:00Z","owner":"user1","txID":"a1","type":"incoming","counterparty":"alice@example.com","value":1000,"balance":1000,"txStatus":"MINED","blockHeight":885803}`+"\n"+
+			`{"createdAt":"2024-02-02T10:00:00Z","owner":"user1","txID":"b2","type":"outgoing","counterparty":"bob@example.com","value":-101,"fee":1,"balance":899,"txStatus":"BROADCASTED"}`+"\n",
+			buf.String())
+	})
+}
+
+func TestStream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger := zerolog.Nop()
+
+	t.Run("stream rows as attachment", func(t *testing.T) {
+		// given:
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+
+		// when:
+		Stream(c, FormatJSONLines, "history", rowsOf(exampleRows, nil), &logger)
+
+		// then:
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, "application/x-ndjson", recorder.Header().Get("Content-Type"))
+		require.Equal(t, `attachment; filename="history.jsonl"`, recorder.Header().Get("Content-Disposition"))
+		require.Equal(t, 2, bytes.Count(recorder.Body.Bytes(), []byte("\n")))
+	})
+
+	t.Run("respond with error when nothing was written", func(t *testing.T) {
+		// given:
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+
+		// when:
+		Stream(c, FormatCSV, "history", rowsOf(nil, errors.New("db is down")), &logger)
+
+		// then:
+		require.Equal(t, http.StatusInternalServerError, recorder.Code)
+		require.Empty(t, recorder.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("cut the response short on error after the first row", func(t *testing.T) {
+		// given:
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+
+		// when:
+		Stream(c, FormatCSV, "history", rowsOf(exampleRows[:1], errors.New("db is down")), &logger)
+
+		// then:
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+	})
+}
+
+func rowsOf(rows []*Row, failure error) iter.Seq2[*Row, error] {
+	return func(yield func(*Row, error) bool) {
+		for _, row := range rows {
+			if !yield(row, nil) {
+				return
+			}
+		}
+		if failure != nil {
+			yield(nil, failure)
+		}
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
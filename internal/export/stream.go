@@ -0,0 +1,113 @@
+package export
+
+import (
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+
+	"github.com/bitcoin-sv/spv-wallet/engine/spverrors"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+const flushEveryRows = 100
+
+// Params are the common query parameters of the history export endpoints
+type Params struct {
+	Format string     `form:"format"`
+	From   *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To     *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// ParseParams binds the export query parameters of the request and validates them
+func ParseParams(c *gin.Context) (*Params, Format, error) {
+	var params Params
+	if err := c.ShouldBindQuery(&params); err != nil {
+		return nil, "", spverrors.ErrCannotParseQueryParams.WithTrace(err)
+	}
+
+	format, err := ParseFormat(params.Format)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = ValidateTimeRange(params.From, params.To); err != nil {
+		return nil, "", err
+	}
+	return &params, format, nil
+}
+
+// ValidateTimeRange checks that the start of the time range is not after its end
+func ValidateTimeRange(from, to *time.Time) error {
+	if from != nil && to != nil && from.After(*to) {
+		return spverrors.ErrExportTimeRangeInvalid
+	}
+	return nil
+}
+
+// Stream writes the rows to the response as an attachment in the given format.
+// The rows are written (and flushed) as they come, so the whole export is never kept in memory.
+// An error which occurs before the first row is responded as usual,
+// later errors can only cut the response short, so they are logged.
+func Stream(c *gin.Context, format Format, filename string, rows iter.Seq2[*Row, error], logger *zerolog.Logger) {
+	writer := NewWriter(c.Writer, format)
+	written := 0
+
+	for row, err := range rows {
+		if err != nil {
+			if written == 0 {
+				spverrors.ErrorResponse(c, err, logger)
+				return
+			}
+			logger.Error().Err(err).Int("rows", written).Msg("History export interrupted")
+			return
+		}
+
+		if written == 0 {
+			writeHeaders(c, format, filename)
+		}
+		if err = writer.Write(row); err != nil {
+			logger.Error().Err(err).Int("rows", written).Msg("History export interrupted")
+			return
+		}
+
+		written++
+		if written%flushEveryRows == 0 {
+			if err = writer.Flush(); err != nil {
+				logger.Error().Err(err).Int("rows", written).Msg("History export interrupted")
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+
+	if written == 0 {
+		writeHeaders(c, format, filename)
+	}
+	if err := writer.Flush(); err != nil {
+		logger.Error().Err(err).Int("rows", written).Msg("Failed to finish history export")
+		return
+	}
+	c.Writer.Flush()
+}
+
+func writeHeaders(c *gin.Context, format Format, filename string) {
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, filename, format))
+	c.Status(http.StatusOK)
+}
+
+// Rows maps the iterated history entries to the rows of the export
+func Rows[T any](entries iter.Seq2[T, error], mapper func(T) *Row) iter.Seq2[*Row, error] {
+	return func(yield func(*Row, error) bool) {
+		for entry, err := range entries {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(mapper(entry), nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package mappings
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	"github.com/bitcoin-sv/spv-wallet/mappings/common"
+	"github.com/bitcoin-sv/spv-wallet/models/response"
+)
+
+// MapToStablecoinBanknoteContract will map the stablecoin banknote from spv-wallet to the spv-wallet-models contract
+func MapToStablecoinBanknoteContract(b *engine.StablecoinBanknote) *response.StablecoinBanknote {
+	if b == nil {
+		return nil
+	}
+
+	return &response.StablecoinBanknote{
+		Serial:       b.Serial,
+		Amount:       b.Amount,
+		StablecoinID: b.StablecoinID,
+		Outpoint:     *MapToUtxoPointer(&b.UtxoPointer),
+		CreatedAt:    b.CreatedAt,
+	}
+}
+
+// MapToStablecoinBalanceContract will map the stablecoin balance from spv-wallet to the spv-wallet-models contract
+func MapToStablecoinBalanceContract(b *engine.StablecoinBalance) *response.StablecoinBalance {
+	if b == nil {
+		return nil
+	}
+
+	return &response.StablecoinBalance{
+		StablecoinID: b.StablecoinID,
+		Amount:       b.Amount,
+		Banknotes:    b.Banknotes,
+	}
+}
+
+// MapToTokenTransferContract will map the token transfer from spv-wallet to the spv-wallet-models contract
+func MapToTokenTransferContract(tt *engine.TokenTransfer) *response.TokenTransfer {
+	if tt == nil {
+		return nil
+	}
+
+	return &response.TokenTransfer{
+		StablecoinID: tt.StablecoinID,
+		Amount:       tt.Amount,
+		To:           tt.To,
+	}
+}
+
+// MapTokenTransferModelToEngine will map the token transfer from spv-wallet-models to the spv-wallet contract
+func MapTokenTransferModelToEngine(tt *response.TokenTransfer) *engine.TokenTransfer {
+	if tt == nil {
+		return nil
+	}
+
+	return &engine.TokenTransfer{
+		StablecoinID: tt.StablecoinID,
+		Amount:       tt.Amount,
+		To:           tt.To,
+	}
+}
+
+// MapToStablecoinOperationContract will map the stablecoin operation from spv-wallet to the spv-wallet-models contract
+func MapToStablecoinOperationContract(o *engine.StablecoinOperation) *response.StablecoinOperation {
+	if o == nil {
+		return nil
+	}
+
+	return &response.StablecoinOperation{
+		Model:        *common.MapToContract(&o.Model),
+		ID:           o.ID,
+		Operation:    string(o.Type),
+		Status:       string(o.Status),
+		StablecoinID: o.StablecoinID,
+		Amount:       o.Amount,
+		SenderID:     o.SenderID,
+		ReceiverID:   o.ReceiverID,
+		TxID:         o.TxID,
+		Error:        o.Error,
+	}
+}
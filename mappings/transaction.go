@@ -133,6 +133,7 @@ func MapTransactionConfigEngineToModel(tx *response.TransactionConfig) *engine.T
 		Outputs:                    mapToEngineOutputs(tx),
 		SendAllTo:                  MapTransactionOutputModelToEngine(tx.SendAllTo),
 		Sync:                       MapSyncConfigModelToEngine(tx.Sync),
+		TokenTransfer:              MapTokenTransferModelToEngine(tx.TokenTransfer),
 	}
 }
 
@@ -216,6 +217,7 @@ func MapToTransactionConfigContract(tx *engine.TransactionConfig) *response.Tran
 		Outputs:                    mapToContractOutputs(tx),
 		SendAllTo:                  MapToTransactionOutputContract(tx.SendAllTo),
 		Sync:                       MapToSyncConfigContract(tx.Sync),
+		TokenTransfer:              MapToTokenTransferContract(tx.TokenTransfer),
 	}
 }
 
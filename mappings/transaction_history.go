@@ -0,0 +1,31 @@
+package mappings
+
+import (
+	"github.com/bitcoin-sv/spv-wallet/engine"
+	"github.com/bitcoin-sv/spv-wallet/internal/export"
+)
+
+// MapToTransactionHistoryRow will map the transaction history entry to the row of the history export
+func MapToTransactionHistoryRow(entry *engine.TransactionHistoryEntry) *export.Row {
+	tx := entry.Transaction
+
+	row := &export.Row{
+		CreatedAt:    tx.CreatedAt,
+		Owner:        entry.XPubID,
+		TxID:         tx.ID,
+		Type:         entry.Direction.String(),
+		Counterparty: entry.Counterparty,
+		Value:        entry.Value,
+		Balance:      entry.Balance,
+		TxStatus:     tx.TxStatus.String(),
+	}
+	if entry.Direction == engine.TransactionDirectionOut {
+		fee := tx.Fee
+		row.Fee = &fee
+	}
+	if tx.BlockHeight > 0 {
+		blockHeight := tx.BlockHeight
+		row.BlockHeight = &blockHeight
+	}
+	return row
+}
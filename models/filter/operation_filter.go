@@ -0,0 +1,40 @@
+package filter
+
+import "errors"
+
+// OperationFilter is a struct for handling request parameters for operations search requests
+type OperationFilter struct {
+	Type         *string    `json:"type,omitempty" enums:"incoming,outgoing,data"`
+	Counterparty *string    `json:"counterparty,omitempty" example:"alice@example.com"` // Counterparty is matched exactly, but case-insensitively.
+	MinValue     *int64     `json:"minValue,omitempty" example:"-1000"`                 // MinValue is the lowest (signed) value of the operation, outgoing operations have negative values.
+	MaxValue     *int64     `json:"maxValue,omitempty" example:"1000"`                  // MaxValue is the highest (signed) value of the operation, outgoing operations have negative values.
+	CreatedRange *TimeRange `json:"createdRange,omitempty"`                             // CreatedRange specifies the time range when the operation was created.
+	TxStatus     *string    `json:"txStatus,omitempty" enums:"CREATED,BROADCASTED,MINED,REVERTED,PROBLEMATIC"`
+}
+
+var (
+	validOperationTypes      = getEnumValues[OperationFilter]("Type")
+	validOperationTxStatuses = getEnumValues[OperationFilter]("TxStatus")
+)
+
+// Validate checks the filter options and normalizes the enum values (type, txStatus) to their canonical form
+func (d *OperationFilter) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if err := normalizeStrOption(d.Type, validOperationTypes...); err != nil {
+		return err
+	}
+	if err := normalizeStrOption(d.TxStatus, validOperationTxStatuses...); err != nil {
+		return err
+	}
+	if d.MinValue != nil && d.MaxValue != nil && *d.MinValue > *d.MaxValue {
+		return errors.New("minValue cannot be greater than maxValue")
+	}
+	if d.CreatedRange != nil && d.CreatedRange.hasFrom() && d.CreatedRange.hasTo() && d.CreatedRange.From.After(*d.CreatedRange.To) {
+		return errors.New("createdRange.from cannot be after createdRange.to")
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty filter", func(t *testing.T) {
+		filter := OperationFilter{}
+		err := filter.Validate()
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil filter", func(t *testing.T) {
+		var filter *OperationFilter
+		err := filter.Validate()
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("normalize type and txStatus", func(t *testing.T) {
+		filter := fromJSON[OperationFilter](`{
+			"type": "INCOMING",
+			"txStatus": "mined"
+		}`)
+		err := filter.Validate()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "incoming", *filter.Type)
+		assert.Equal(t, "MINED", *filter.TxStatus)
+	})
+
+	t.Run("with full filter", func(t *testing.T) {
+		filter := fromJSON[OperationFilter](`{
+			"type": "outgoing",
+			"counterparty": "alice@example.com",
+			"minValue": -1000,
+			"maxValue": -1,
+			"createdRange": {
+				"from": "2024-02-26T11:01:28Z",
+				"to": "2024-03-26T11:01:28Z"
+			},
+			"txStatus": "BROADCASTED"
+		}`)
+		err := filter.Validate()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "outgoing", *filter.Type)
+		assert.Equal(t, "alice@example.com", *filter.Counterparty)
+		assert.Equal(t, int64(-1000), *filter.MinValue)
+		assert.Equal(t, int64(-1), *filter.MaxValue)
+	})
+
+	t.Run("with wrong type", func(t *testing.T) {
+		filter := fromJSON[OperationFilter](`{
+			"type": "wrong_type"
+		}`)
+		err := filter.Validate()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("with wrong txStatus", func(t *testing.T) {
+		filter := fromJSON[OperationFilter](`{
+			"txStatus": "CONFIRMED"
+		}`)
+		err := filter.Validate()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("with minValue greater than maxValue", func(t *testing.T) {
+		filter := OperationFilter{
+			MinValue: ptr(int64(10)),
+			MaxValue: ptr(int64(1)),
+		}
+		err := filter.Validate()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("with createdRange from after to", func(t *testing.T) {
+		filter := fromJSON[OperationFilter](`{
+			"createdRange": {
+				"from": "2024-03-26T11:01:28Z",
+				"to": "2024-02-26T11:01:28Z"
+			}
+		}`)
+		err := filter.Validate()
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,6 @@
+package filter
+
+// StablecoinFilter is a struct for handling request parameters for stablecoin banknotes and balances requests
+type StablecoinFilter struct {
+	StablecoinID *string `json:"stablecoinId,omitempty" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"`
+}
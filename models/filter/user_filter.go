@@ -0,0 +1,24 @@
+package filter
+
+import "errors"
+
+// UserFilter is a struct for handling request parameters for admin users search requests
+type UserFilter struct {
+	PublicKey    *string    `json:"publicKey,omitempty" example:"034252e5359a1de3b8ec08e6c29b80594e88fb47e6ae9ce65ee5a94f0d371d2cde"`
+	Paymail      *string    `json:"paymail,omitempty" example:"alice@example.com"` // Paymail is the address of any (not removed) paymail of the user.
+	CreatedRange *TimeRange `json:"createdRange,omitempty"`                        // CreatedRange specifies the time range when the user was created.
+	Active       *bool      `json:"active,omitempty" example:"true"`               // Active selects only active (true) or only deactivated (false) users.
+}
+
+// Validate checks the filter options
+func (d *UserFilter) Validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if d.CreatedRange != nil && d.CreatedRange.hasFrom() && d.CreatedRange.hasTo() && d.CreatedRange.From.After(*d.CreatedRange.To) {
+		return errors.New("createdRange.from cannot be after createdRange.to")
+	}
+
+	return nil
+}
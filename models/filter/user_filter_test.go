@@ -0,0 +1,54 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty filter", func(t *testing.T) {
+		filter := UserFilter{}
+		err := filter.Validate()
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil filter", func(t *testing.T) {
+		var filter *UserFilter
+		err := filter.Validate()
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("with full filter", func(t *testing.T) {
+		filter := fromJSON[UserFilter](`{
+			"publicKey": "034252e5359a1de3b8ec08e6c29b80594e88fb47e6ae9ce65ee5a94f0d371d2cde",
+			"paymail": "alice@example.com",
+			"createdRange": {
+				"from": "2024-02-26T11:01:28Z",
+				"to": "2024-03-26T11:01:28Z"
+			},
+			"active": false
+		}`)
+		err := filter.Validate()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "alice@example.com", *filter.Paymail)
+		assert.False(t, *filter.Active)
+	})
+
+	t.Run("with createdRange from after to", func(t *testing.T) {
+		filter := fromJSON[UserFilter](`{
+			"createdRange": {
+				"from": "2024-03-26T11:01:28Z",
+				"to": "2024-02-26T11:01:28Z"
+			}
+		}`)
+		err := filter.Validate()
+
+		assert.Error(t, err)
+	})
+}
@@ -40,6 +40,19 @@ func checkAndApplyStrOption(conditions map[string]interface{}, columnName string
 	return nil
 }
 
+// normalizeStrOption checks (case-insensitive) if value is in options and replaces it with the matching option
+func normalizeStrOption(value *string, options ...string) error {
+	if value == nil {
+		return nil
+	}
+	opt, err := checkStrOption(*value, options...)
+	if err != nil {
+		return err
+	}
+	*value = opt
+	return nil
+}
+
 // getEnumValues gets the tag "enums" of a field by fieldName of a provided struct
 func getEnumValues[T any](fieldName string) []string {
 	t := reflect.TypeOf(*new(T))
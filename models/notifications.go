@@ -39,9 +39,29 @@ type TransactionEvent struct {
 	XpubOutputValue map[string]int64 `json:"xpubOutputValue"`
 }
 
+// StablecoinOperationEvent - event for status changes of the stablecoin issue and redeem operations
+type StablecoinOperationEvent struct {
+	UserEvent `json:",inline"`
+
+	OperationID   string `json:"operationId"`
+	Operation     string `json:"operation"`
+	Status        string `json:"status"`
+	StablecoinID  string `json:"stablecoinId"`
+	Amount        uint64 `json:"amount"`
+	TransactionID string `json:"transactionId,omitempty"`
+}
+
+// TransactionReorgEvent - event for transactions which block is no longer part of the longest chain
+type TransactionReorgEvent struct {
+	TransactionID       string `json:"transactionId"`
+	Status              string `json:"status"`
+	OrphanedBlockHash   string `json:"orphanedBlockHash"`
+	OrphanedBlockHeight int64  `json:"orphanedBlockHeight"`
+}
+
 // NOTICE: If you add a new event type, you must also update the Events interface
 
 // Events - interface for all supported events
 type Events interface {
-	StringEvent | TransactionEvent
+	StringEvent | TransactionEvent | StablecoinOperationEvent | TransactionReorgEvent
 }
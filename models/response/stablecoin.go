@@ -0,0 +1,53 @@
+package response
+
+import "time"
+
+// StablecoinBanknote is a model that represents an unspent stablecoin banknote (BSV-21 token output).
+type StablecoinBanknote struct {
+	// Serial is the serial number (token ID) of the banknote.
+	Serial string `json:"serial" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"`
+	// Amount is the amount of tokens in the banknote.
+	Amount uint64 `json:"amount" example:"1000000"`
+	// StablecoinID is the identifier of the stablecoin the banknote belongs to.
+	StablecoinID string `json:"stablecoinId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"`
+	// Outpoint is a pointer to the output holding the banknote.
+	Outpoint UtxoPointer `json:"outpoint"`
+	// CreatedAt is the time when the banknote was received.
+	CreatedAt time.Time `json:"createdAt" example:"2024-02-26T11:00:28.069911Z"`
+}
+
+// StablecoinBalance is a model that represents the balance of a stablecoin.
+type StablecoinBalance struct {
+	// StablecoinID is the identifier of the stablecoin.
+	StablecoinID string `json:"stablecoinId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"`
+	// Amount is the sum of the unspent banknotes of the stablecoin.
+	Amount uint64 `json:"amount" example:"1000000"`
+	// Banknotes is the number of the unspent banknotes of the stablecoin.
+	Banknotes int `json:"banknotes" example:"3"`
+}
+
+// StablecoinOperation is a model that represents the issue or redeem of a stablecoin.
+type StablecoinOperation struct {
+	// Model is a common model that contains common fields for all models.
+	Model
+	// ID is the ID of the draft transaction of the sent operation or the reference ID of the received one.
+	ID string `json:"id" example:"b356f7fa00cd3f20cce6c21d704cd13e871d28d714a5ebd0532f5a0e0cde63f7"`
+	// Operation is the type of the operation (issue or redeem).
+	Operation string `json:"operation" example:"issue"`
+	// Status is the status of the operation (pending, completed or failed).
+	Status string `json:"status" example:"pending"`
+	// StablecoinID is the identifier of the stablecoin.
+	StablecoinID string `json:"stablecoinId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"`
+	// Amount is the amount of the stablecoin.
+	Amount uint64 `json:"amount" example:"1000000"`
+	// SenderID is the paymail of the sender.
+	SenderID string `json:"senderId" example:"emitter@example.com"`
+	// ReceiverID is the paymail of the receiver.
+	ReceiverID string `json:"receiverId" example:"test@example.com"`
+	// TxID is the ID of the transaction of the operation.
+	TxID string `json:"txId,omitempty" example:"01d0d0067652f684c6acb3683763f353fce55f6496521c7d99e71e1d27e53f5c"`
+	// Error is the reason of the failure of the operation.
+	Error string `json:"error,omitempty" example:"transfer rejected"`
+	// DraftTransaction is the draft transaction to be signed and recorded, returned only when the operation is created.
+	DraftTransaction *DraftTransaction `json:"draftTransaction,omitempty"`
+}
@@ -32,6 +32,18 @@ type TransactionConfig struct {
 	SendAllTo *TransactionOutput `json:"sendAllTo"`
 	// Sync contains sync configuration.
 	Sync *SyncConfig `json:"sync"`
+	// TokenTransfer is a pointer to a stablecoin transfer with automatically selected banknotes.
+	TokenTransfer *TokenTransfer `json:"tokenTransfer,omitempty"`
+}
+
+// TokenTransfer is a model that represents a stablecoin transfer with automatically selected banknotes.
+type TokenTransfer struct {
+	// StablecoinID is an id of the stablecoin to send.
+	StablecoinID string `json:"stablecoinId" example:"0761072ea3519adcbf4c2b9061bf64cb52243533f72d1cec47280a6eabfb3ad5_0"`
+	// Amount is an amount of the stablecoin to send.
+	Amount uint64 `json:"amount" example:"100"`
+	// To is a paymail of the receiver.
+	To string `json:"to" example:"bob@example.com"`
 }
 
 // TransactionInput is a model that represents a transaction input.
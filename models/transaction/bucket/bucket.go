@@ -8,6 +8,8 @@ const (
 	Data Name = "data"
 	// BSV represents the bucket for the BSV outputs.
 	BSV Name = "bsv"
+	// Token represents the bucket for the BSV-21 token outputs, which are not used to fund transactions.
+	Token Name = "token"
 )
 
 func (b Name) String() string {
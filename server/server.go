@@ -9,6 +9,7 @@ import (
 
 	"github.com/bitcoin-sv/spv-wallet/actions"
 	"github.com/bitcoin-sv/spv-wallet/actions/paymailserver"
+	"github.com/bitcoin-sv/spv-wallet/actions/stablecoins"
 	v2 "github.com/bitcoin-sv/spv-wallet/actions/v2"
 	"github.com/bitcoin-sv/spv-wallet/api"
 	"github.com/bitcoin-sv/spv-wallet/config"
@@ -110,6 +111,7 @@ func (s *Server) Handlers() *gin.Engine {
 func setupServerRoutes(appConfig *config.AppConfig, spvWalletEngine engine.ClientInterface, ginEngine *gin.Engine, log *zerolog.Logger) {
 	handlersManager := handlers.NewManager(ginEngine, appConfig)
 	actions.Register(handlersManager)
+	stablecoins.RegisterPaymailCapabilities(spvWalletEngine.GetPaymailConfig().Configuration)
 	paymailserver.Register(spvWalletEngine.GetPaymailConfig().Configuration, ginEngine)
 
 	if appConfig.ExperimentalFeatures.V2 {